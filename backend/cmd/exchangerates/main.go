@@ -0,0 +1,96 @@
+// Command exchangerates loads an exchange rate file (JSON or CSV) into the database configured in
+// config.yaml, the same way PUT /api/v1/admin/exchange-rates does.
+//
+//	exchangerates -file rates.csv
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/chayutK/hotel-property-service/internal/adapter"
+	"github.com/chayutK/hotel-property-service/internal/config"
+	"github.com/chayutK/hotel-property-service/internal/domain"
+	"github.com/chayutK/hotel-property-service/internal/infra/database"
+	"github.com/chayutK/hotel-property-service/internal/service"
+	"github.com/chayutK/hotel-property-service/internal/transport/http"
+	"github.com/chayutK/hotel-property-service/internal/transport/http/dto/exchangeratedto"
+	"github.com/chayutK/hotel-property-service/internal/transport/http/dto/mapperdto"
+)
+
+func main() {
+	logger := slog.NewJSONHandler(os.Stderr, nil)
+	slog.SetDefault(slog.New(logger))
+	validate := http.NewValidator()
+
+	file := flag.String("file", "", "exchange rate file to load")
+	format := flag.String("format", "", "json or csv, taken from the file extension when empty")
+	actor := flag.String("actor", "exchangerates", "recorded in the audit log as the author of the changes")
+	flag.Parse()
+
+	if *file == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+	if *format == "" {
+		*format = strings.TrimPrefix(strings.ToLower(filepath.Ext(*file)), ".")
+	}
+
+	cfg, err := config.Load()
+	if err != nil {
+		slog.Error("[MAIN]", "message", "error while loading config", "error", err.Error())
+		os.Exit(1)
+	}
+
+	db, err := database.New(cfg.Database.DSN, cfg.Database.Migration, false)
+	if err != nil {
+		slog.Error("[MAIN]", "message", "error while connecting database", "error", err.Error())
+		os.Exit(1)
+	}
+
+	f, err := os.Open(*file)
+	if err != nil {
+		slog.Error("[MAIN]", "message", "error while opening file", "error", err.Error())
+		os.Exit(1)
+	}
+	defer f.Close()
+
+	var req exchangeratedto.SetExchangeRatesRequest
+	switch *format {
+	case "csv":
+		parsed, err := exchangeratedto.ParseCSV(f)
+		if err != nil {
+			slog.Error("[MAIN]", "message", "error while parsing csv", "error", err.Error())
+			os.Exit(1)
+		}
+		req = *parsed
+	case "json":
+		if err := json.NewDecoder(f).Decode(&req); err != nil {
+			slog.Error("[MAIN]", "message", "error while parsing json", "error", err.Error())
+			os.Exit(1)
+		}
+	default:
+		slog.Error("[MAIN]", "message", "unknown format "+*format)
+		os.Exit(2)
+	}
+
+	if err := validate.Struct(&req); err != nil {
+		slog.Error("[MAIN]", "message", "invalid exchange rates", "error", err.Error())
+		os.Exit(1)
+	}
+
+	exchangeRateSvc := service.NewExchangeRateService(adapter.NewExchangeRateRepository(db), adapter.NewAuditRepository(db), adapter.NewTransactor(db))
+	rates, err := exchangeRateSvc.SetExchangeRates(domain.WithActor(context.Background(), *actor), mapperdto.SetExchangeRatesRequestToDomain(&req))
+	if err != nil {
+		os.Exit(1)
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	encoder.Encode(exchangeratedto.ExchangeRatesResponse{Rates: mapperdto.ToExchangeRatesDTO(rates)})
+}
@@ -0,0 +1,108 @@
+// Command importer loads a catalog file (JSON or CSV) into the database configured in
+// config.yaml, the same way POST /api/v1/admin/import does.
+//
+//	importer -file catalog.csv -dry-run
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/chayutK/hotel-property-service/internal/adapter"
+	"github.com/chayutK/hotel-property-service/internal/config"
+	"github.com/chayutK/hotel-property-service/internal/domain"
+	"github.com/chayutK/hotel-property-service/internal/infra/database"
+	"github.com/chayutK/hotel-property-service/internal/service"
+	"github.com/chayutK/hotel-property-service/internal/transport/http"
+	"github.com/chayutK/hotel-property-service/internal/transport/http/dto/catalogdto"
+	"github.com/chayutK/hotel-property-service/internal/transport/http/dto/mapperdto"
+)
+
+func main() {
+	logger := slog.NewJSONHandler(os.Stderr, nil)
+	slog.SetDefault(slog.New(logger))
+	validate := http.NewValidator()
+
+	file := flag.String("file", "", "catalog file to import")
+	format := flag.String("format", "", "json or csv, taken from the file extension when empty")
+	dryRun := flag.Bool("dry-run", false, "only print the changes")
+	actor := flag.String("actor", "importer", "recorded in the audit log as the author of the changes")
+	flag.Parse()
+
+	if *file == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+	if *format == "" {
+		*format = strings.TrimPrefix(strings.ToLower(filepath.Ext(*file)), ".")
+	}
+
+	cfg, err := config.Load()
+	if err != nil {
+		slog.Error("[MAIN]", "message", "error while loading config", "error", err.Error())
+		os.Exit(1)
+	}
+
+	db, err := database.New(cfg.Database.DSN, cfg.Database.Migration, false)
+	if err != nil {
+		slog.Error("[MAIN]", "message", "error while connecting database", "error", err.Error())
+		os.Exit(1)
+	}
+
+	f, err := os.Open(*file)
+	if err != nil {
+		slog.Error("[MAIN]", "message", "error while opening file", "error", err.Error())
+		os.Exit(1)
+	}
+	defer f.Close()
+
+	var doc catalogdto.CatalogDocument
+	switch *format {
+	case "csv":
+		parsed, err := catalogdto.ParseCSV(f)
+		if err != nil {
+			slog.Error("[MAIN]", "message", "error while parsing csv", "error", err.Error())
+			os.Exit(1)
+		}
+		doc = *parsed
+	case "json":
+		if err := json.NewDecoder(f).Decode(&doc); err != nil {
+			slog.Error("[MAIN]", "message", "error while parsing json", "error", err.Error())
+			os.Exit(1)
+		}
+	default:
+		slog.Error("[MAIN]", "message", "unknown format "+*format)
+		os.Exit(2)
+	}
+
+	if err := validate.Struct(&doc); err != nil {
+		slog.Error("[MAIN]", "message", "invalid catalog", "error", err.Error())
+		os.Exit(1)
+	}
+
+	catalogSvc := service.NewCatalogService(adapter.NewCatalogRepository(db), adapter.NewAuditRepository(db), adapter.NewPriceHistoryRepository(db), adapter.NewTransactor(db), adapter.NewRatePlanRepository(db))
+	changes, err := catalogSvc.ImportCatalog(domain.WithActor(context.Background(), *actor), mapperdto.ToDomainCatalogRecords(&doc), *dryRun)
+	if err != nil {
+		var invalid *service.CatalogValidationError
+		if errors.As(err, &invalid) {
+			for _, problem := range invalid.Problems {
+				slog.Error("[MAIN]", "message", "invalid catalog", "problem", problem)
+			}
+		}
+		os.Exit(1)
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	encoder.Encode(catalogdto.ImportCatalogResponse{
+		DryRun:  *dryRun,
+		Summary: mapperdto.ToCatalogChangeSummary(changes),
+		Changes: mapperdto.ToCatalogChangesDTO(changes),
+	})
+}
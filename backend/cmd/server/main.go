@@ -12,6 +12,7 @@
 package main
 
 import (
+	"crypto/rand"
 	"fmt"
 	"log/slog"
 	"os"
@@ -23,7 +24,6 @@ import (
 	"github.com/chayutK/hotel-property-service/internal/service"
 	"github.com/chayutK/hotel-property-service/internal/transport/http"
 	"github.com/chayutK/hotel-property-service/internal/transport/http/handler"
-	"github.com/go-playground/validator/v10"
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
 	echoSwagger "github.com/swaggo/echo-swagger"
@@ -32,7 +32,7 @@ import (
 func main() {
 	logger := slog.NewJSONHandler(os.Stdout, nil)
 	slog.SetDefault(slog.New(logger))
-	validate := validator.New()
+	validate := http.NewValidator()
 
 	cfg, err := config.Load()
 	if err != nil {
@@ -40,6 +40,12 @@ func main() {
 		panic(err)
 	}
 
+	secret, err := quoteSecret(cfg)
+	if err != nil {
+		slog.Error("[MAIN]", "message", "error while loading quote secret", "error", err.Error())
+		panic(err)
+	}
+
 	db, err := database.New(cfg.Database.DSN, cfg.Database.Migration, cfg.Database.Seeding)
 	if err != nil {
 		slog.Error("[MAIN]", "message", "error while connecting database", "error", err.Error())
@@ -50,20 +56,64 @@ func main() {
 	app.Use(
 		middleware.RequestLogger(),
 		middleware.Recover(),
+		http.ActorMiddleware(),
 	)
 
 	hotelRepo := adapter.NewHotelRepository(db)
 	roomRepo := adapter.NewRoomRepository(db)
+	facilityRepo := adapter.NewFacilityRepository(db)
+	benefitRepo := adapter.NewBenefitRepository(db)
+	physicalRoomRepo := adapter.NewPhysicalRoomRepository(db)
+	catalogRepo := adapter.NewCatalogRepository(db)
+	auditRepo := adapter.NewAuditRepository(db)
+	roomRateRepo := adapter.NewRoomRateRepository(db)
+	pricingRuleRepo := adapter.NewPricingRuleRepository(db)
+	cancellationPolicyRepo := adapter.NewCancellationPolicyRepository(db)
+	taxFeeRepo := adapter.NewTaxFeeRepository(db)
+	exchangeRateRepo := adapter.NewExchangeRateRepository(db)
+	promotionRepo := adapter.NewPromotionRepository(db)
+	stayRuleRepo := adapter.NewStayRuleRepository(db)
+	priceHistoryRepo := adapter.NewPriceHistoryRepository(db)
+	ratePlanRepo := adapter.NewRatePlanRepository(db)
+	transactor := adapter.NewTransactor(db)
 
-	hotelSvc := service.NewHotelService(hotelRepo)
-	roomSvc := service.NewRoomService(roomRepo)
-	priceSvc := service.NewPricingService(hotelRepo, roomRepo)
+	hotelSvc := service.NewHotelService(hotelRepo, auditRepo, transactor, ratePlanRepo)
+	roomSvc := service.NewRoomService(hotelRepo, roomRepo, physicalRoomRepo, cancellationPolicyRepo, auditRepo, priceHistoryRepo, ratePlanRepo, transactor, roomRateRepo)
+	priceSvc := service.NewPricingService(hotelRepo, roomRepo, roomRateRepo, pricingRuleRepo, cancellationPolicyRepo, taxFeeRepo, exchangeRateRepo, promotionRepo, stayRuleRepo, ratePlanRepo, service.NewQuoteSigner(secret, cfg.Quote.TTL))
+	facilitySvc := service.NewFacilityService(hotelRepo, facilityRepo, auditRepo, transactor)
+	benefitSvc := service.NewBenefitService(physicalRoomRepo, benefitRepo, auditRepo, transactor)
+	physicalRoomSvc := service.NewPhysicalRoomService(hotelRepo, physicalRoomRepo, auditRepo, transactor)
+	catalogSvc := service.NewCatalogService(catalogRepo, auditRepo, priceHistoryRepo, transactor, ratePlanRepo)
+	auditSvc := service.NewAuditService(auditRepo)
+	roomRateSvc := service.NewRoomRateService(roomRepo, roomRateRepo, auditRepo, priceHistoryRepo, transactor)
+	pricingRuleSvc := service.NewPricingRuleService(hotelRepo, roomRepo, pricingRuleRepo, auditRepo, transactor)
+	cancellationPolicySvc := service.NewCancellationPolicyService(cancellationPolicyRepo, auditRepo, transactor)
+	taxFeeSvc := service.NewTaxFeeService(hotelRepo, taxFeeRepo, auditRepo, transactor, roomRepo)
+	exchangeRateSvc := service.NewExchangeRateService(exchangeRateRepo, auditRepo, transactor)
+	promotionSvc := service.NewPromotionService(promotionRepo, auditRepo, transactor)
+	stayRuleSvc := service.NewStayRuleService(hotelRepo, roomRepo, stayRuleRepo, auditRepo, transactor)
+	priceHistorySvc := service.NewPriceHistoryService(roomRepo, priceHistoryRepo)
+	ratePlanSvc := service.NewRatePlanService(hotelRepo, roomRepo, cancellationPolicyRepo, ratePlanRepo, auditRepo, transactor)
 
 	hotelHandler := handler.NewHotelHandler(hotelSvc, validate)
 	roomHandler := handler.NewRoomHandler(roomSvc, validate)
 	pricingHandler := handler.NewPricingHandler(priceSvc, validate)
+	facilityHandler := handler.NewFacilityHandler(facilitySvc, validate)
+	benefitHandler := handler.NewBenefitHandler(benefitSvc, validate)
+	physicalRoomHandler := handler.NewPhysicalRoomHandler(physicalRoomSvc, validate)
+	catalogHandler := handler.NewCatalogHandler(catalogSvc, validate)
+	auditHandler := handler.NewAuditHandler(auditSvc, validate)
+	roomRateHandler := handler.NewRoomRateHandler(roomRateSvc, validate)
+	pricingRuleHandler := handler.NewPricingRuleHandler(pricingRuleSvc, validate)
+	cancellationPolicyHandler := handler.NewCancellationPolicyHandler(cancellationPolicySvc, validate)
+	taxFeeHandler := handler.NewTaxFeeHandler(taxFeeSvc, validate)
+	exchangeRateHandler := handler.NewExchangeRateHandler(exchangeRateSvc, validate)
+	promotionHandler := handler.NewPromotionHandler(promotionSvc, validate)
+	stayRuleHandler := handler.NewStayRuleHandler(stayRuleSvc, validate)
+	priceHistoryHandler := handler.NewPriceHistoryHandler(priceHistorySvc, validate)
+	ratePlanHandler := handler.NewRatePlanHandler(ratePlanSvc, validate)
 
-	http.RegisterRoutes(app, hotelHandler, roomHandler, pricingHandler)
+	http.RegisterRoutes(app, hotelHandler, roomHandler, pricingHandler, facilityHandler, benefitHandler, physicalRoomHandler, catalogHandler, auditHandler, roomRateHandler, pricingRuleHandler, cancellationPolicyHandler, taxFeeHandler, exchangeRateHandler, promotionHandler, stayRuleHandler, priceHistoryHandler, ratePlanHandler)
 
 	// Set Swagger host to use configured server port and base path prefix
 	docs.SwaggerInfo.Host = fmt.Sprintf("localhost:%d", cfg.Server.Port)
@@ -72,3 +122,19 @@ func main() {
 
 	app.Start(fmt.Sprintf(":%d", cfg.Server.Port))
 }
+
+// quoteSecret is the configured quote signing secret. Outside of development one is required, in
+// development a random secret is used without one and quotes signed before a restart then no
+// longer verify.
+func quoteSecret(cfg *config.Config) ([]byte, error) {
+	if cfg.Quote.Secret != "" {
+		return []byte(cfg.Quote.Secret), nil
+	}
+
+	if cfg.Server.Env != "dev" {
+		return nil, fmt.Errorf("quote.secret or QUOTE_SECRET is required when server.env is %q", cfg.Server.Env)
+	}
+
+	slog.Warn("[MAIN]", "message", "no quote secret configured, quotes are signed with a random secret")
+	return []byte(rand.Text()), nil
+}
@@ -0,0 +1,146 @@
+// Command snapshot exports the catalog of the database configured in config.yaml as a
+// versioned JSON document, or restores such a document into it.
+//
+//	snapshot export -out catalog.json
+//	snapshot restore -file catalog.json -dry-run
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"time"
+
+	"github.com/chayutK/hotel-property-service/internal/adapter"
+	"github.com/chayutK/hotel-property-service/internal/config"
+	"github.com/chayutK/hotel-property-service/internal/domain"
+	"github.com/chayutK/hotel-property-service/internal/infra/database"
+	"github.com/chayutK/hotel-property-service/internal/service"
+	"github.com/chayutK/hotel-property-service/internal/transport/http"
+	"github.com/chayutK/hotel-property-service/internal/transport/http/dto/catalogdto"
+	"github.com/chayutK/hotel-property-service/internal/transport/http/dto/mapperdto"
+)
+
+func main() {
+	logger := slog.NewJSONHandler(os.Stderr, nil)
+	slog.SetDefault(slog.New(logger))
+
+	if len(os.Args) < 2 {
+		fmt.Fprintln(os.Stderr, "usage: snapshot export|restore [flags]")
+		os.Exit(2)
+	}
+
+	cfg, err := config.Load()
+	if err != nil {
+		slog.Error("[MAIN]", "message", "error while loading config", "error", err.Error())
+		os.Exit(1)
+	}
+
+	db, err := database.New(cfg.Database.DSN, cfg.Database.Migration, false)
+	if err != nil {
+		slog.Error("[MAIN]", "message", "error while connecting database", "error", err.Error())
+		os.Exit(1)
+	}
+
+	catalogSvc := service.NewCatalogService(adapter.NewCatalogRepository(db), adapter.NewAuditRepository(db), adapter.NewPriceHistoryRepository(db), adapter.NewTransactor(db), adapter.NewRatePlanRepository(db))
+
+	switch os.Args[1] {
+	case "export":
+		err = export(catalogSvc, os.Args[2:])
+	case "restore":
+		err = restore(catalogSvc, os.Args[2:])
+	default:
+		fmt.Fprintln(os.Stderr, "usage: snapshot export|restore [flags]")
+		os.Exit(2)
+	}
+
+	if err != nil {
+		slog.Error("[MAIN]", "message", "error while running "+os.Args[1], "error", err.Error())
+		os.Exit(1)
+	}
+}
+
+func export(catalogSvc *service.CatalogService, args []string) error {
+	flags := flag.NewFlagSet("export", flag.ExitOnError)
+	out := flags.String("out", "", "file to write, stdout when empty")
+	flags.Parse(args)
+
+	records, err := catalogSvc.ExportCatalog(context.Background())
+	if err != nil {
+		return err
+	}
+
+	var w io.Writer = os.Stdout
+	if *out != "" {
+		f, err := os.Create(*out)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		w = f
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(catalogdto.CatalogSnapshot{
+		Version:         catalogdto.SnapshotVersion,
+		ExportedAt:      time.Now().UTC(),
+		CatalogDocument: *mapperdto.ToCatalogDocument(records),
+	})
+}
+
+func restore(catalogSvc *service.CatalogService, args []string) error {
+	flags := flag.NewFlagSet("restore", flag.ExitOnError)
+	file := flags.String("file", "", "snapshot to restore")
+	dryRun := flags.Bool("dry-run", false, "only print the changes")
+	actor := flags.String("actor", "snapshot", "recorded in the audit log as the author of the changes")
+	flags.Parse(args)
+
+	if *file == "" {
+		flags.Usage()
+		os.Exit(2)
+	}
+
+	f, err := os.Open(*file)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	var snapshot catalogdto.CatalogSnapshot
+	if err := json.NewDecoder(f).Decode(&snapshot); err != nil {
+		return err
+	}
+
+	if !catalogdto.IsSupportedVersion(snapshot.Version) {
+		return fmt.Errorf("snapshot version %d is not supported", snapshot.Version)
+	}
+
+	if err := http.NewValidator().Struct(&snapshot); err != nil {
+		return err
+	}
+
+	changes, err := catalogSvc.ImportCatalog(domain.WithActor(context.Background(), *actor), mapperdto.ToDomainCatalogRecords(&snapshot.CatalogDocument), *dryRun)
+	if err != nil {
+		var invalid *service.CatalogValidationError
+		if errors.As(err, &invalid) {
+			for _, problem := range invalid.Problems {
+				slog.Error("[MAIN]", "message", "invalid snapshot", "problem", problem)
+			}
+		}
+		return err
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(catalogdto.ImportCatalogResponse{
+		DryRun:  *dryRun,
+		Summary: mapperdto.ToCatalogChangeSummary(changes),
+		Changes: mapperdto.ToCatalogChangesDTO(changes),
+	})
+}
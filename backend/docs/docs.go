@@ -25,123 +25,232 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/hotel/{hotel_id}": {
+        "/admin/audit": {
             "get": {
-                "description": "Get hotel details by hotel id",
+                "description": "List recorded catalog changes, newest first, with who made them and a field-level before/after diff",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "hotels"
+                    "admin"
                 ],
-                "summary": "Get hotel by id",
+                "summary": "Query catalog audit log",
                 "parameters": [
                     {
+                        "enum": [
+                            "facility_catalog",
+                            "hotel",
+                            "facility",
+                            "physical_room",
+                            "room",
+                            "benefit"
+                        ],
                         "type": "string",
-                        "description": "Hotel ID",
-                        "name": "hotel_id",
-                        "in": "path",
-                        "required": true
+                        "description": "Entity",
+                        "name": "entity",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Entity ID, the code for facility catalog entries",
+                        "name": "id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Actor",
+                        "name": "actor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only changes of this field, e.g. basePrice",
+                        "name": "field",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Maximum number of entries, 100 by default",
+                        "name": "limit",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/hoteldto.InquiryHotelResponse"
+                            "$ref": "#/definitions/auditdto.InquiryAuditResponse"
                         }
                     }
                 }
             }
         },
-        "/hotels": {
+        "/admin/exchange-rates": {
             "get": {
-                "description": "Get a list of hotels",
+                "description": "Get the exchange rates quotes are converted at, every effective date included",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "hotels"
+                    "admin"
+                ],
+                "summary": "List exchange rates",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Currency converted from",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Currency converted to",
+                        "name": "to",
+                        "in": "query"
+                    }
                 ],
-                "summary": "List hotels",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/hoteldto.InquiryHotelsResponse"
+                            "$ref": "#/definitions/exchangeratedto.ExchangeRatesResponse"
                         }
                     }
                 }
-            }
-        },
-        "/hotels/{hotelID}/rooms": {
-            "get": {
-                "description": "Get rooms for a given hotel",
+            },
+            "put": {
+                "description": "Create or replace exchange rates by currency pair and effective date, other rates are left unchanged.\nAccepts the JSON document or a CSV with from, to, rate and effective_date columns.",
+                "consumes": [
+                    "application/json",
+                    "text/csv"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "rooms"
+                    "admin"
                 ],
-                "summary": "List rooms by hotel",
+                "summary": "Set exchange rates",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Hotel ID",
-                        "name": "hotelID",
-                        "in": "path",
-                        "required": true
+                        "description": "Exchange rates",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/exchangeratedto.SetExchangeRatesRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/roomdto.InquiryRoomsResponse"
+                            "$ref": "#/definitions/exchangeratedto.ExchangeRatesResponse"
                         }
                     }
                 }
             }
         },
-        "/hotels/{hotelID}/rooms/{roomID}": {
-            "get": {
-                "description": "Get room details by hotel id and room id",
-                "produces": [
-                    "application/json"
-                ],
+        "/admin/exchange-rates/{from}/{to}/{date}": {
+            "delete": {
+                "description": "Remove the rate of a currency pair effective from a date, the rate effective before it applies again",
                 "tags": [
-                    "rooms"
+                    "admin"
                 ],
-                "summary": "Get room by id",
+                "summary": "Delete exchange rate",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Hotel ID",
-                        "name": "hotelID",
+                        "description": "Currency converted from",
+                        "name": "from",
                         "in": "path",
                         "required": true
                     },
                     {
                         "type": "string",
-                        "description": "Room ID",
-                        "name": "roomID",
+                        "description": "Currency converted to",
+                        "name": "to",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Effective date, YYYY-MM-DD",
+                        "name": "date",
                         "in": "path",
                         "required": true
                     }
                 ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
+            }
+        },
+        "/admin/import": {
+            "post": {
+                "description": "Validate and upsert hotels with their facilities, physical rooms, offers and benefits in one transaction.\nEvery listed hotel is complete, anything under it that is not listed is deactivated.\nAccepts the JSON document or a CSV with an entity column. With dry_run only the diff is returned.",
+                "consumes": [
+                    "application/json",
+                    "text/csv"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "Import catalog",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "Only report the changes",
+                        "name": "dry_run",
+                        "in": "query"
+                    },
+                    {
+                        "description": "Catalog document",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/catalogdto.CatalogDocument"
+                        }
+                    }
+                ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/roomdto.InquiryRoomResponse"
+                            "$ref": "#/definitions/catalogdto.ImportCatalogResponse"
                         }
                     }
                 }
             }
         },
-        "/price": {
+        "/admin/snapshot": {
+            "get": {
+                "description": "Dump the whole catalog, inactive rows included, as a versioned document that can be restored elsewhere",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "Export catalog snapshot",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/catalogdto.CatalogSnapshot"
+                        }
+                    }
+                }
+            },
             "post": {
-                "description": "Calculate total price for requested hotel room and nights",
+                "description": "Load a snapshot into an empty or existing database. Hotels in the snapshot end up exactly as exported,\nhotels that are not in it are left untouched. With dry_run only the diff is returned.",
                 "consumes": [
                     "application/json"
                 ],
@@ -149,17 +258,23 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "pricing"
+                    "admin"
                 ],
-                "summary": "Calculate room price",
+                "summary": "Restore catalog snapshot",
                 "parameters": [
                     {
-                        "description": "Pricing request",
+                        "type": "boolean",
+                        "description": "Only report the changes",
+                        "name": "dry_run",
+                        "in": "query"
+                    },
+                    {
+                        "description": "Catalog snapshot",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/pricingdto.CalculatePricingRequest"
+                            "$ref": "#/definitions/catalogdto.CatalogSnapshot"
                         }
                     }
                 ],
@@ -167,160 +282,5633 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/pricingdto.CalculatePricingResponse"
+                            "$ref": "#/definitions/catalogdto.ImportCatalogResponse"
                         }
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "hoteldto.FacilityDTO": {
-            "type": "object",
-            "properties": {
-                "description": {
-                    "type": "string"
-                },
-                "facility_id": {
-                    "type": "string"
-                },
-                "name": {
-                    "type": "string"
-                }
-            }
         },
-        "hoteldto.HotelDTO": {
-            "type": "object",
-            "properties": {
-                "address": {
-                    "type": "string"
-                },
-                "facility": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/hoteldto.FacilityDTO"
+        "/cancellation-policies": {
+            "get": {
+                "description": "Get the active cancellation policies rooms can be sold with",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "cancellation-policies"
+                ],
+                "summary": "List cancellation policies",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/cancellationpolicydto.InquiryCancellationPoliciesResponse"
+                        }
                     }
-                },
-                "hotel_id": {
-                    "type": "string"
-                },
-                "name": {
-                    "type": "string"
                 }
-            }
-        },
-        "hoteldto.InquiryHotelResponse": {
-            "type": "object",
-            "properties": {
-                "hotel": {
-                    "$ref": "#/definitions/hoteldto.HotelDTO"
+            },
+            "post": {
+                "description": "Create a cancellation policy with a free cancellation deadline, penalty tiers and a price surcharge",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "cancellation-policies"
+                ],
+                "summary": "Create cancellation policy",
+                "parameters": [
+                    {
+                        "description": "Cancellation policy",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/cancellationpolicydto.CreateCancellationPolicyRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/cancellationpolicydto.CancellationPolicyResponse"
+                        }
+                    }
                 }
             }
         },
-        "hoteldto.InquiryHotelsResponse": {
-            "type": "object",
-            "properties": {
-                "hotels": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/hoteldto.HotelDTO"
+        "/cancellation-policies/{policyID}": {
+            "get": {
+                "description": "Get an active cancellation policy by ID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "cancellation-policies"
+                ],
+                "summary": "Get cancellation policy",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Cancellation policy ID",
+                        "name": "policyID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/cancellationpolicydto.CancellationPolicyResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Replace every field of a cancellation policy, rooms using it are priced with the new terms",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "cancellation-policies"
+                ],
+                "summary": "Update cancellation policy",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Cancellation policy ID",
+                        "name": "policyID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Cancellation policy",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/cancellationpolicydto.UpdateCancellationPolicyRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/cancellationpolicydto.CancellationPolicyResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Soft-delete a cancellation policy that no active room or rate plan uses",
+                "tags": [
+                    "cancellation-policies"
+                ],
+                "summary": "Deactivate cancellation policy",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Cancellation policy ID",
+                        "name": "policyID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
                     }
                 }
             }
         },
-        "pricingdto.CalculatePricingRequest": {
-            "type": "object",
-            "required": [
-                "hotelID",
-                "nights",
+        "/facilities": {
+            "get": {
+                "description": "Get the master facility catalog shared by all hotels",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "facilities"
+                ],
+                "summary": "List facility catalog",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/facilitydto.InquiryCatalogResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Add a facility with a stable code to the master catalog",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "facilities"
+                ],
+                "summary": "Create facility catalog entry",
+                "parameters": [
+                    {
+                        "description": "Catalog entry",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/facilitydto.CreateCatalogEntryRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/facilitydto.CatalogEntryResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/facilities/{code}": {
+            "put": {
+                "description": "Rename or re-describe a facility for every hotel that offers it",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "facilities"
+                ],
+                "summary": "Update facility catalog entry",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Facility code",
+                        "name": "code",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Catalog entry",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/facilitydto.UpdateCatalogEntryRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/facilitydto.CatalogEntryResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/hotel/{hotel_id}": {
+            "get": {
+                "description": "Get hotel details by hotel id\nMissing, inactive and not yet or no longer live ones are 404.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "hotels"
+                ],
+                "summary": "Get hotel by id",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Hotel ID",
+                        "name": "hotel_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/hoteldto.InquiryHotelResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Replace the editable fields of an active hotel",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "hotels"
+                ],
+                "summary": "Update hotel",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Hotel ID",
+                        "name": "hotel_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the version being replaced",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Hotel",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/hoteldto.UpdateHotelRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/hoteldto.UpdateHotelResponse"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Soft-delete a hotel by marking it inactive",
+                "tags": [
+                    "hotels"
+                ],
+                "summary": "Deactivate hotel",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Hotel ID",
+                        "name": "hotel_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the version being deactivated",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "description": "Update only the provided fields of an active hotel. Also accepts application/merge-patch+json,\nwhere null removes a field.",
+                "consumes": [
+                    "application/json",
+                    "application/merge-patch+json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "hotels"
+                ],
+                "summary": "Partially update hotel",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Hotel ID",
+                        "name": "hotel_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the version being patched",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Hotel fields",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/hoteldto.PatchHotelRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/hoteldto.UpdateHotelResponse"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/hotel/{hotel_id}/facilities": {
+            "post": {
+                "description": "Offer a catalog facility at a hotel, optionally with a hotel specific description",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "facilities"
+                ],
+                "summary": "Attach facility to hotel",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Hotel ID",
+                        "name": "hotel_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Facility",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/facilitydto.AttachFacilityRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/hoteldto.UpdateHotelResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/hotel/{hotel_id}/facilities/{code}": {
+            "put": {
+                "description": "Set the hotel specific description of an attached facility; empty falls back to the catalog description",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "facilities"
+                ],
+                "summary": "Describe hotel facility",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Hotel ID",
+                        "name": "hotel_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Facility code",
+                        "name": "code",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Description",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/facilitydto.DescribeFacilityRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/hoteldto.UpdateHotelResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Stop offering a facility at a hotel",
+                "tags": [
+                    "facilities"
+                ],
+                "summary": "Detach facility from hotel",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Hotel ID",
+                        "name": "hotel_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Facility code",
+                        "name": "code",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
+            }
+        },
+        "/hotels": {
+            "get": {
+                "description": "Get a list of hotels",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "hotels"
+                ],
+                "summary": "List hotels",
+                "parameters": [
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "Facility codes every returned hotel must offer",
+                        "name": "facility",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/hoteldto.InquiryHotelsResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create a new active hotel, it starts with a BAR best available rate plan",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "hotels"
+                ],
+                "summary": "Create hotel",
+                "parameters": [
+                    {
+                        "description": "Hotel",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/hoteldto.CreateHotelRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/hoteldto.CreateHotelResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/hotels/{hotelID}/physical-rooms": {
+            "get": {
+                "description": "Get the physical rooms of a hotel, each with the offers sold on it",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "physical-rooms"
+                ],
+                "summary": "List physical rooms by hotel",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Hotel ID",
+                        "name": "hotelID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/physicalroomdto.InquiryPhysicalRoomsResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create a physical room type that offers can be sold on",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "physical-rooms"
+                ],
+                "summary": "Create physical room",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Hotel ID",
+                        "name": "hotelID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Physical room",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/physicalroomdto.CreatePhysicalRoomRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/physicalroomdto.PhysicalRoomResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/hotels/{hotelID}/physical-rooms/{physicalRoomID}": {
+            "get": {
+                "description": "Get a physical room with its benefits and offers",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "physical-rooms"
+                ],
+                "summary": "Get physical room by id",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Hotel ID",
+                        "name": "hotelID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Physical room ID",
+                        "name": "physicalRoomID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/physicalroomdto.PhysicalRoomResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Replace the editable fields of a physical room",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "physical-rooms"
+                ],
+                "summary": "Update physical room",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Hotel ID",
+                        "name": "hotelID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Physical room ID",
+                        "name": "physicalRoomID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Physical room",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/physicalroomdto.UpdatePhysicalRoomRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/physicalroomdto.PhysicalRoomResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Soft-delete a physical room together with all offers sold on it",
+                "tags": [
+                    "physical-rooms"
+                ],
+                "summary": "Delete physical room",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Hotel ID",
+                        "name": "hotelID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Physical room ID",
+                        "name": "physicalRoomID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
+            }
+        },
+        "/hotels/{hotelID}/physical-rooms/{physicalRoomID}/benefits": {
+            "get": {
+                "description": "Get the benefits of a physical room and the room offers showing each of them",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "benefits"
+                ],
+                "summary": "List physical room benefits",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Hotel ID",
+                        "name": "hotelID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Physical room ID",
+                        "name": "physicalRoomID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/benefitdto.InquiryBenefitsResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Add a benefit shown by every room offer sharing the physical room",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "benefits"
+                ],
+                "summary": "Add physical room benefit",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Hotel ID",
+                        "name": "hotelID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Physical room ID",
+                        "name": "physicalRoomID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Benefit",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/benefitdto.CreateBenefitRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/benefitdto.BenefitResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/hotels/{hotelID}/physical-rooms/{physicalRoomID}/benefits/{benefitID}": {
+            "put": {
+                "description": "Edit a benefit; the change shows on every room offer listed in roomIDs",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "benefits"
+                ],
+                "summary": "Edit physical room benefit",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Hotel ID",
+                        "name": "hotelID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Physical room ID",
+                        "name": "physicalRoomID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Benefit ID",
+                        "name": "benefitID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Benefit",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/benefitdto.UpdateBenefitRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/benefitdto.BenefitResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Stop showing a benefit on the room offers sharing the physical room",
+                "tags": [
+                    "benefits"
+                ],
+                "summary": "Retire physical room benefit",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Hotel ID",
+                        "name": "hotelID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Physical room ID",
+                        "name": "physicalRoomID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Benefit ID",
+                        "name": "benefitID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
+            }
+        },
+        "/hotels/{hotelID}/price-simulations": {
+            "post": {
+                "description": "Preview draft pricing rules, base prices and rates of a hotel before publishing them, nothing is stored.\nReturns a room by night grid of the current and the simulated nightly price, from up to to with at most 366 nights.\nPrices are the rooms' own nightly prices, before rate plans, occupancy charges, discounts, taxes and fees.\nA draft pricing rooms of another hotel or in another currency than the room's, or referencing rules of another hotel, is refused with 422.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "pricing"
+                ],
+                "summary": "Simulate prices",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Hotel ID",
+                        "name": "hotelID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Price draft",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pricingdto.SimulatePricesRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/pricingdto.SimulatePricesResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/hotels/{hotelID}/pricing-rules": {
+            "get": {
+                "description": "Get the active pricing rules of a hotel, highest priority first",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "pricing-rules"
+                ],
+                "summary": "List pricing rules",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Hotel ID",
+                        "name": "hotelID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/pricingruledto.InquiryPricingRulesResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create a rule multiplying the price of the nights it matches, for the hotel, a room type or one room",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "pricing-rules"
+                ],
+                "summary": "Create pricing rule",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Hotel ID",
+                        "name": "hotelID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Pricing rule",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pricingruledto.CreatePricingRuleRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/pricingruledto.PricingRuleResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/hotels/{hotelID}/pricing-rules/{ruleID}": {
+            "put": {
+                "description": "Replace every field of a pricing rule",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "pricing-rules"
+                ],
+                "summary": "Update pricing rule",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Hotel ID",
+                        "name": "hotelID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Pricing rule ID",
+                        "name": "ruleID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Pricing rule",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pricingruledto.UpdatePricingRuleRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/pricingruledto.PricingRuleResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Soft-delete a pricing rule, prices stop using it immediately",
+                "tags": [
+                    "pricing-rules"
+                ],
+                "summary": "Deactivate pricing rule",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Hotel ID",
+                        "name": "hotelID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Pricing rule ID",
+                        "name": "ruleID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
+            }
+        },
+        "/hotels/{hotelID}/rate-plans": {
+            "get": {
+                "description": "Get the active rate plans of a hotel, access codes included",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "rate-plans"
+                ],
+                "summary": "List rate plans",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Hotel ID",
+                        "name": "hotelID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/rateplandto.InquiryRatePlansResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create a rate plan with its price multiplier, cancellation policy, inclusions and eligibility conditions. 409 when another active plan of the hotel has the code.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "rate-plans"
+                ],
+                "summary": "Create rate plan",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Hotel ID",
+                        "name": "hotelID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Rate plan",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/rateplandto.CreateRatePlanRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/rateplandto.RatePlanResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/hotels/{hotelID}/rate-plans/{ratePlanID}": {
+            "put": {
+                "description": "Replace every field of a rate plan. 409 when another active plan of the hotel has the code.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "rate-plans"
+                ],
+                "summary": "Update rate plan",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Hotel ID",
+                        "name": "hotelID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Rate plan ID",
+                        "name": "ratePlanID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Rate plan",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/rateplandto.UpdateRatePlanRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/rateplandto.RatePlanResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Soft-delete a rate plan, rooms stop being sold on it immediately",
+                "tags": [
+                    "rate-plans"
+                ],
+                "summary": "Deactivate rate plan",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Hotel ID",
+                        "name": "hotelID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Rate plan ID",
+                        "name": "ratePlanID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
+            }
+        },
+        "/hotels/{hotelID}/rooms": {
+            "get": {
+                "description": "Get rooms for a given hotel",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "rooms"
+                ],
+                "summary": "List rooms by hotel",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Hotel ID",
+                        "name": "hotelID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/roomdto.InquiryRoomsResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create a sellable room offer for a hotel",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "rooms"
+                ],
+                "summary": "Create room offer",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Hotel ID",
+                        "name": "hotelID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Room offer",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/roomdto.CreateRoomRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/roomdto.CreateRoomResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/hotels/{hotelID}/rooms/{roomID}": {
+            "get": {
+                "description": "Get room details by hotel id and room id\nMissing, inactive and not yet or no longer live ones are 404.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "rooms"
+                ],
+                "summary": "Get room by id",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Hotel ID",
+                        "name": "hotelID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Room ID",
+                        "name": "roomID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/roomdto.InquiryRoomResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Replace the editable fields of a room offer\nA room with rate calendar prices cannot change its currency, 422 until they are deleted.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "rooms"
+                ],
+                "summary": "Update room offer",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Hotel ID",
+                        "name": "hotelID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Room ID",
+                        "name": "roomID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the version being replaced",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Room offer",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/roomdto.UpdateRoomRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/roomdto.UpdateRoomResponse"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Soft-delete a room offer by marking it inactive",
+                "tags": [
+                    "rooms"
+                ],
+                "summary": "Delete room offer",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Hotel ID",
+                        "name": "hotelID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Room ID",
+                        "name": "roomID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the version being deleted",
+                        "name": "If-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "patch": {
+                "description": "Update only the provided fields of a room offer. Also accepts application/merge-patch+json,\nwhere null removes a field.\nA room with rate calendar prices cannot change its currency, 422 until they are deleted.",
+                "consumes": [
+                    "application/json",
+                    "application/merge-patch+json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "rooms"
+                ],
+                "summary": "Partially update room offer",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Hotel ID",
+                        "name": "hotelID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Room ID",
+                        "name": "roomID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the version being patched",
+                        "name": "If-Match",
+                        "in": "header"
+                    },
+                    {
+                        "description": "Room offer fields",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/roomdto.PatchRoomRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/roomdto.UpdateRoomResponse"
+                        }
+                    },
+                    "412": {
+                        "description": "Precondition Failed",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/hotels/{hotelID}/rooms/{roomID}/price-history": {
+            "get": {
+                "description": "List every change to the base price and rate calendar of a room, oldest first, with when it took effect and who made it.\nWith night and at, priceAt is the price of that night at that time before pricing rules: its rate then, or else the base price.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "room-rates"
+                ],
+                "summary": "Get room price history",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Hotel ID",
+                        "name": "hotelID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Room ID",
+                        "name": "roomID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "base_price",
+                            "rate_calendar"
+                        ],
+                        "type": "string",
+                        "description": "Only base prices or rates",
+                        "name": "source",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only base prices and the rates of this night, YYYY-MM-DD",
+                        "name": "night",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Changes from this time, RFC 3339",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Changes before this time, RFC 3339",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Time to give the price of the night at, RFC 3339, requires night",
+                        "name": "at",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/pricehistorydto.PriceHistoryResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/hotels/{hotelID}/rooms/{roomID}/rates": {
+            "get": {
+                "description": "Get the per-night price overrides of a room, nights without one cost the base price",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "room-rates"
+                ],
+                "summary": "List room rates",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Hotel ID",
+                        "name": "hotelID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Room ID",
+                        "name": "roomID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "First night, YYYY-MM-DD",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Night after the last one, YYYY-MM-DD",
+                        "name": "to",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/roomratedto.RoomRatesResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Create or replace the price of the listed nights, other nights are left unchanged",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "room-rates"
+                ],
+                "summary": "Set room rates",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Hotel ID",
+                        "name": "hotelID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Room ID",
+                        "name": "roomID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Nightly rates",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/roomratedto.SetRoomRatesRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/roomratedto.RoomRatesResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/hotels/{hotelID}/rooms/{roomID}/rates/{date}": {
+            "delete": {
+                "description": "Remove the price override of one night, the night goes back to the base price",
+                "tags": [
+                    "room-rates"
+                ],
+                "summary": "Delete room rate",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Hotel ID",
+                        "name": "hotelID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Room ID",
+                        "name": "roomID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Night, YYYY-MM-DD",
+                        "name": "date",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
+            }
+        },
+        "/hotels/{hotelID}/stay-rules": {
+            "get": {
+                "description": "Get the active length of stay restrictions and discounts of a hotel",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "stay-rules"
+                ],
+                "summary": "List stay rules",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Hotel ID",
+                        "name": "hotelID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/stayruledto.InquiryStayRulesResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create a minimum and maximum stay restriction or a length of stay discount tier, for the hotel or one room",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "stay-rules"
+                ],
+                "summary": "Create stay rule",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Hotel ID",
+                        "name": "hotelID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Stay rule",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/stayruledto.CreateStayRuleRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/stayruledto.StayRuleResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/hotels/{hotelID}/stay-rules/{ruleID}": {
+            "put": {
+                "description": "Replace every field of a stay rule",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "stay-rules"
+                ],
+                "summary": "Update stay rule",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Hotel ID",
+                        "name": "hotelID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Stay rule ID",
+                        "name": "ruleID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Stay rule",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/stayruledto.UpdateStayRuleRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/stayruledto.StayRuleResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Soft-delete a stay rule, prices stop using it immediately",
+                "tags": [
+                    "stay-rules"
+                ],
+                "summary": "Deactivate stay rule",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Hotel ID",
+                        "name": "hotelID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Stay rule ID",
+                        "name": "ruleID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
+            }
+        },
+        "/hotels/{hotelID}/taxes-fees": {
+            "get": {
+                "description": "Get the active taxes and fees of a hotel in the order they are applied",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "taxes-fees"
+                ],
+                "summary": "List taxes and fees",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Hotel ID",
+                        "name": "hotelID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/taxfeedto.InquiryTaxesAndFeesResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create a percentage or fixed tax or fee the hotel charges on top of its rooms\nA fixed amount has to be in a currency a room of the hotel is sold in, 422 otherwise.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "taxes-fees"
+                ],
+                "summary": "Create tax or fee",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Hotel ID",
+                        "name": "hotelID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Tax or fee",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/taxfeedto.CreateTaxFeeRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/taxfeedto.TaxFeeResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/hotels/{hotelID}/taxes-fees/{taxFeeID}": {
+            "put": {
+                "description": "Replace every field of a tax or fee\nA fixed amount has to be in a currency a room of the hotel is sold in, 422 otherwise.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "taxes-fees"
+                ],
+                "summary": "Update tax or fee",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Hotel ID",
+                        "name": "hotelID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Tax or fee ID",
+                        "name": "taxFeeID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Tax or fee",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/taxfeedto.UpdateTaxFeeRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/taxfeedto.TaxFeeResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Soft-delete a tax or fee, prices stop including it immediately",
+                "tags": [
+                    "taxes-fees"
+                ],
+                "summary": "Deactivate tax or fee",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Hotel ID",
+                        "name": "hotelID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Tax or fee ID",
+                        "name": "taxFeeID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
+            }
+        },
+        "/price": {
+            "post": {
+                "description": "Calculate the itemized price of a stay: the rate of every night, the cancellation policy surcharge, discounts, taxes, fees and the total.\nStays of more than 90 nights are refused with 400, a room not for sale is 404.\nWith a currency the total is also converted at the exchange rate effective today, 422 when there is none.\nStays breaking a minimum or maximum stay restriction are refused with 422 and the reason, length of stay discounts apply automatically.\nEligible campaigns are discounted automatically, a promo code is applied when valid and the promotion block explains why it was not.\nWith a ratePlanID the nights are priced on that rate plan and sold with its cancellation policy, a stay that cannot be booked on it is refused with 422 and the reason.\nThe signedQuote block lets booking flows honour the total until it expires, see POST /price/verify.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "pricing"
+                ],
+                "summary": "Calculate room price",
+                "parameters": [
+                    {
+                        "description": "Pricing request",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pricingdto.CalculatePricingRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/pricingdto.CalculatePricingResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/price/verify": {
+            "post": {
+                "description": "Confirm a signedQuote from POST /price is authentic and unexpired, so its total can be honoured without pricing the stay again.\nA quote that was altered or signed elsewhere has reason invalid_signature, an expired one expired.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "pricing"
+                ],
+                "summary": "Verify signed quote",
+                "parameters": [
+                    {
+                        "description": "Signed quote",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pricingdto.VerifyQuoteRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/pricingdto.VerifyQuoteResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/prices:batch": {
+            "post": {
+                "description": "Price up to 50 stays at once, every item is priced like POST /price.\nEach result holds the quote of its item or its error: 400 for an invalid item, 404 for a room not for sale, 422 like /price.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "pricing"
+                ],
+                "summary": "Calculate room prices in batch",
+                "parameters": [
+                    {
+                        "description": "Pricing requests",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pricingdto.BatchPricingRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/pricingdto.BatchPricingResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/promotions": {
+            "get": {
+                "description": "Get the active promo codes and campaigns",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "promotions"
+                ],
+                "summary": "List promotions",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/promotiondto.InquiryPromotionsResponse"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create a percentage or fixed discount with eligibility conditions. With a code guests apply it by entering the code,\nwithout one it is a campaign every eligible quote gets. 409 when another active promotion has the code.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "promotions"
+                ],
+                "summary": "Create promotion",
+                "parameters": [
+                    {
+                        "description": "Promotion",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/promotiondto.CreatePromotionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/promotiondto.PromotionResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/promotions/{promotionID}": {
+            "get": {
+                "description": "Get an active promotion by ID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "promotions"
+                ],
+                "summary": "Get promotion",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Promotion ID",
+                        "name": "promotionID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/promotiondto.PromotionResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Replace every field of a promotion, the use count is kept. 409 when another active promotion has the code.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "promotions"
+                ],
+                "summary": "Update promotion",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Promotion ID",
+                        "name": "promotionID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Promotion",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/promotiondto.UpdatePromotionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/promotiondto.PromotionResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Soft-delete a promotion, its code is no longer accepted",
+                "tags": [
+                    "promotions"
+                ],
+                "summary": "Deactivate promotion",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Promotion ID",
+                        "name": "promotionID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    }
+                }
+            }
+        },
+        "/promotions/{promotionID}/redeem": {
+            "post": {
+                "description": "Count a booking made with the promotion towards its usage cap, 422 once the cap is reached",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "promotions"
+                ],
+                "summary": "Redeem promotion",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Promotion ID",
+                        "name": "promotionID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/promotiondto.PromotionResponse"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "auditdto.AuditEntryDTO": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "type": "string"
+                },
+                "actor": {
+                    "type": "string"
+                },
+                "at": {
+                    "type": "string"
+                },
+                "auditID": {
+                    "type": "string"
+                },
+                "entity": {
+                    "type": "string"
+                },
+                "fields": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/catalogdto.FieldChangeDTO"
+                    }
+                },
+                "id": {
+                    "type": "string"
+                }
+            }
+        },
+        "auditdto.InquiryAuditResponse": {
+            "type": "object",
+            "properties": {
+                "entries": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/auditdto.AuditEntryDTO"
+                    }
+                }
+            }
+        },
+        "benefitdto.BenefitDTO": {
+            "type": "object",
+            "properties": {
+                "activeFrom": {
+                    "type": "string"
+                },
+                "activeUntil": {
+                    "type": "string"
+                },
+                "benefitID": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "physicalRoomID": {
+                    "type": "string"
+                },
+                "roomIDs": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "benefitdto.BenefitResponse": {
+            "type": "object",
+            "properties": {
+                "benefit": {
+                    "$ref": "#/definitions/benefitdto.BenefitDTO"
+                }
+            }
+        },
+        "benefitdto.CreateBenefitRequest": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "activeFrom": {
+                    "type": "string"
+                },
+                "activeUntil": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string",
+                    "maxLength": 1000
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 255
+                }
+            }
+        },
+        "benefitdto.InquiryBenefitsResponse": {
+            "type": "object",
+            "properties": {
+                "benefits": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/benefitdto.BenefitDTO"
+                    }
+                }
+            }
+        },
+        "benefitdto.UpdateBenefitRequest": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "activeFrom": {
+                    "type": "string"
+                },
+                "activeUntil": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string",
+                    "maxLength": 1000
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 255
+                }
+            }
+        },
+        "cancellationpolicydto.CancellationPolicyDTO": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "type": "string"
+                },
+                "freeCancellationHours": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "penaltyTiers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/cancellationpolicydto.PenaltyTierDTO"
+                    }
+                },
+                "policyID": {
+                    "type": "string"
+                },
+                "surchargePercent": {
+                    "type": "number"
+                }
+            }
+        },
+        "cancellationpolicydto.CancellationPolicyResponse": {
+            "type": "object",
+            "properties": {
+                "policy": {
+                    "$ref": "#/definitions/cancellationpolicydto.CancellationPolicyDTO"
+                }
+            }
+        },
+        "cancellationpolicydto.CreateCancellationPolicyRequest": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "description": {
+                    "type": "string",
+                    "maxLength": 1000
+                },
+                "freeCancellationHours": {
+                    "type": "integer",
+                    "maximum": 8760,
+                    "minimum": 0
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "penaltyTiers": {
+                    "type": "array",
+                    "maxItems": 10,
+                    "uniqueItems": true,
+                    "items": {
+                        "$ref": "#/definitions/cancellationpolicydto.PenaltyTierDTO"
+                    }
+                },
+                "surchargePercent": {
+                    "type": "number",
+                    "maximum": 100,
+                    "minimum": 0
+                }
+            }
+        },
+        "cancellationpolicydto.InquiryCancellationPoliciesResponse": {
+            "type": "object",
+            "properties": {
+                "policies": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/cancellationpolicydto.CancellationPolicyDTO"
+                    }
+                }
+            }
+        },
+        "cancellationpolicydto.PenaltyTierDTO": {
+            "type": "object",
+            "properties": {
+                "hoursBeforeArrival": {
+                    "type": "integer",
+                    "maximum": 8760,
+                    "minimum": 0
+                },
+                "penaltyPercent": {
+                    "type": "number",
+                    "maximum": 100,
+                    "minimum": 0
+                }
+            }
+        },
+        "cancellationpolicydto.UpdateCancellationPolicyRequest": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "description": {
+                    "type": "string",
+                    "maxLength": 1000
+                },
+                "freeCancellationHours": {
+                    "type": "integer",
+                    "maximum": 8760,
+                    "minimum": 0
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "penaltyTiers": {
+                    "type": "array",
+                    "maxItems": 10,
+                    "uniqueItems": true,
+                    "items": {
+                        "$ref": "#/definitions/cancellationpolicydto.PenaltyTierDTO"
+                    }
+                },
+                "surchargePercent": {
+                    "type": "number",
+                    "maximum": 100,
+                    "minimum": 0
+                }
+            }
+        },
+        "catalogdto.BenefitDTO": {
+            "type": "object",
+            "required": [
+                "benefitID",
+                "name"
+            ],
+            "properties": {
+                "activeFrom": {
+                    "type": "string"
+                },
+                "activeUntil": {
+                    "type": "string"
+                },
+                "benefitID": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string",
+                    "maxLength": 1000
+                },
+                "isActive": {
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 255
+                }
+            }
+        },
+        "catalogdto.CancellationPolicyDTO": {
+            "type": "object",
+            "required": [
+                "name",
+                "policyID"
+            ],
+            "properties": {
+                "description": {
+                    "type": "string",
+                    "maxLength": 1000
+                },
+                "freeCancellationHours": {
+                    "type": "integer",
+                    "maximum": 8760,
+                    "minimum": 0
+                },
+                "isActive": {
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "penaltyTiers": {
+                    "type": "array",
+                    "maxItems": 10,
+                    "uniqueItems": true,
+                    "items": {
+                        "$ref": "#/definitions/catalogdto.PenaltyTierDTO"
+                    }
+                },
+                "policyID": {
+                    "type": "string",
+                    "maxLength": 64
+                },
+                "surchargePercent": {
+                    "type": "number",
+                    "maximum": 100,
+                    "minimum": 0
+                }
+            }
+        },
+        "catalogdto.CatalogChangeDTO": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "type": "string"
+                },
+                "entity": {
+                    "type": "string"
+                },
+                "fields": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/catalogdto.FieldChangeDTO"
+                    }
+                },
+                "id": {
+                    "type": "string"
+                }
+            }
+        },
+        "catalogdto.CatalogDocument": {
+            "type": "object",
+            "properties": {
+                "cancellationPolicies": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/catalogdto.CancellationPolicyDTO"
+                    }
+                },
+                "facilityCatalog": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/catalogdto.CatalogEntryDTO"
+                    }
+                },
+                "hotels": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/catalogdto.HotelDTO"
+                    }
+                }
+            }
+        },
+        "catalogdto.CatalogEntryDTO": {
+            "type": "object",
+            "required": [
+                "code",
+                "name"
+            ],
+            "properties": {
+                "code": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string",
+                    "maxLength": 1000
+                },
+                "isActive": {
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 255
+                }
+            }
+        },
+        "catalogdto.CatalogSnapshot": {
+            "type": "object",
+            "required": [
+                "version"
+            ],
+            "properties": {
+                "cancellationPolicies": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/catalogdto.CancellationPolicyDTO"
+                    }
+                },
+                "exportedAt": {
+                    "type": "string"
+                },
+                "facilityCatalog": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/catalogdto.CatalogEntryDTO"
+                    }
+                },
+                "hotels": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/catalogdto.HotelDTO"
+                    }
+                },
+                "version": {
+                    "type": "integer"
+                }
+            }
+        },
+        "catalogdto.ChildChargeDTO": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "number",
+                    "minimum": 0
+                },
+                "maxAge": {
+                    "type": "integer",
+                    "maximum": 17,
+                    "minimum": 0
+                }
+            }
+        },
+        "catalogdto.FacilityDTO": {
+            "type": "object",
+            "required": [
+                "code"
+            ],
+            "properties": {
+                "activeFrom": {
+                    "type": "string"
+                },
+                "activeUntil": {
+                    "type": "string"
+                },
+                "code": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string",
+                    "maxLength": 1000
+                },
+                "facilityID": {
+                    "type": "string"
+                },
+                "isActive": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "catalogdto.FieldChangeDTO": {
+            "type": "object",
+            "properties": {
+                "after": {},
+                "before": {},
+                "field": {
+                    "type": "string"
+                }
+            }
+        },
+        "catalogdto.HotelDTO": {
+            "type": "object",
+            "required": [
+                "address",
+                "hotelID",
+                "name"
+            ],
+            "properties": {
+                "activeFrom": {
+                    "type": "string"
+                },
+                "activeUntil": {
+                    "type": "string"
+                },
+                "address": {
+                    "type": "string",
+                    "maxLength": 500
+                },
+                "facilities": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/catalogdto.FacilityDTO"
+                    }
+                },
+                "hotelID": {
+                    "type": "string"
+                },
+                "isActive": {
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "physicalRooms": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/catalogdto.PhysicalRoomDTO"
+                    }
+                }
+            }
+        },
+        "catalogdto.ImportCatalogResponse": {
+            "type": "object",
+            "properties": {
+                "changes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/catalogdto.CatalogChangeDTO"
+                    }
+                },
+                "dryRun": {
+                    "type": "boolean"
+                },
+                "summary": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "object",
+                        "additionalProperties": {
+                            "type": "integer"
+                        }
+                    }
+                }
+            }
+        },
+        "catalogdto.OfferDTO": {
+            "type": "object",
+            "required": [
+                "basePrice",
+                "cancellationPolicy",
+                "currency",
+                "name",
+                "roomID",
+                "type"
+            ],
+            "properties": {
+                "activeFrom": {
+                    "type": "string"
+                },
+                "activeUntil": {
+                    "type": "string"
+                },
+                "baseOccupancy": {
+                    "description": "the occupancy defaults to that of the room type when left out",
+                    "type": "integer",
+                    "maximum": 20,
+                    "minimum": 1
+                },
+                "basePrice": {
+                    "type": "number"
+                },
+                "cancellationPolicy": {
+                    "type": "string",
+                    "maxLength": 64
+                },
+                "childCharges": {
+                    "type": "array",
+                    "maxItems": 5,
+                    "uniqueItems": true,
+                    "items": {
+                        "$ref": "#/definitions/catalogdto.ChildChargeDTO"
+                    }
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string",
+                    "maxLength": 1000
+                },
+                "extraAdultCharge": {
+                    "type": "number",
+                    "minimum": 0
+                },
+                "isActive": {
+                    "type": "boolean"
+                },
+                "maxOccupancy": {
+                    "type": "integer",
+                    "maximum": 20,
+                    "minimum": 1
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "roomID": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "catalogdto.PenaltyTierDTO": {
+            "type": "object",
+            "properties": {
+                "hoursBeforeArrival": {
+                    "type": "integer",
+                    "maximum": 8760,
+                    "minimum": 0
+                },
+                "penaltyPercent": {
+                    "type": "number",
+                    "maximum": 100,
+                    "minimum": 0
+                }
+            }
+        },
+        "catalogdto.PhysicalRoomDTO": {
+            "type": "object",
+            "required": [
+                "bedCount",
+                "name",
+                "physicalRoomID",
+                "type",
+                "unitCount"
+            ],
+            "properties": {
+                "bedCount": {
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "bedType": {
+                    "type": "string",
+                    "maxLength": 64
+                },
+                "benefits": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/catalogdto.BenefitDTO"
+                    }
+                },
+                "description": {
+                    "type": "string",
+                    "maxLength": 1000
+                },
+                "isActive": {
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "offers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/catalogdto.OfferDTO"
+                    }
+                },
+                "physicalRoomID": {
+                    "type": "string"
+                },
+                "sizeSqm": {
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "type": {
+                    "type": "string"
+                },
+                "unitCount": {
+                    "type": "integer",
+                    "minimum": 1
+                }
+            }
+        },
+        "exchangeratedto.ExchangeRateDTO": {
+            "type": "object",
+            "properties": {
+                "effectiveDate": {
+                    "type": "string"
+                },
+                "from": {
+                    "type": "string"
+                },
+                "rate": {
+                    "type": "number"
+                },
+                "to": {
+                    "type": "string"
+                }
+            }
+        },
+        "exchangeratedto.ExchangeRateRequest": {
+            "type": "object",
+            "required": [
+                "effectiveDate",
+                "from",
+                "rate",
+                "to"
+            ],
+            "properties": {
+                "effectiveDate": {
+                    "type": "string"
+                },
+                "from": {
+                    "type": "string"
+                },
+                "rate": {
+                    "type": "number"
+                },
+                "to": {
+                    "type": "string"
+                }
+            }
+        },
+        "exchangeratedto.ExchangeRatesResponse": {
+            "type": "object",
+            "properties": {
+                "rates": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/exchangeratedto.ExchangeRateDTO"
+                    }
+                }
+            }
+        },
+        "exchangeratedto.SetExchangeRatesRequest": {
+            "type": "object",
+            "required": [
+                "rates"
+            ],
+            "properties": {
+                "rates": {
+                    "type": "array",
+                    "maxItems": 1000,
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/exchangeratedto.ExchangeRateRequest"
+                    }
+                }
+            }
+        },
+        "facilitydto.AttachFacilityRequest": {
+            "type": "object",
+            "required": [
+                "code"
+            ],
+            "properties": {
+                "active_from": {
+                    "type": "string"
+                },
+                "active_until": {
+                    "type": "string"
+                },
+                "code": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string",
+                    "maxLength": 1000
+                }
+            }
+        },
+        "facilitydto.CatalogEntryDTO": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "facilitydto.CatalogEntryResponse": {
+            "type": "object",
+            "properties": {
+                "facility": {
+                    "$ref": "#/definitions/facilitydto.CatalogEntryDTO"
+                }
+            }
+        },
+        "facilitydto.CreateCatalogEntryRequest": {
+            "type": "object",
+            "required": [
+                "code",
+                "name"
+            ],
+            "properties": {
+                "code": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string",
+                    "maxLength": 1000
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 255
+                }
+            }
+        },
+        "facilitydto.DescribeFacilityRequest": {
+            "type": "object",
+            "properties": {
+                "active_from": {
+                    "type": "string"
+                },
+                "active_until": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string",
+                    "maxLength": 1000
+                }
+            }
+        },
+        "facilitydto.InquiryCatalogResponse": {
+            "type": "object",
+            "properties": {
+                "facilities": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/facilitydto.CatalogEntryDTO"
+                    }
+                }
+            }
+        },
+        "facilitydto.UpdateCatalogEntryRequest": {
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "description": {
+                    "type": "string",
+                    "maxLength": 1000
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 255
+                }
+            }
+        },
+        "hoteldto.CreateHotelRequest": {
+            "type": "object",
+            "required": [
+                "address",
+                "name"
+            ],
+            "properties": {
+                "active_from": {
+                    "type": "string"
+                },
+                "active_until": {
+                    "type": "string"
+                },
+                "address": {
+                    "type": "string",
+                    "maxLength": 500
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 255
+                }
+            }
+        },
+        "hoteldto.CreateHotelResponse": {
+            "type": "object",
+            "properties": {
+                "hotel": {
+                    "$ref": "#/definitions/hoteldto.HotelDTO"
+                }
+            }
+        },
+        "hoteldto.FacilityDTO": {
+            "type": "object",
+            "properties": {
+                "active_from": {
+                    "type": "string"
+                },
+                "active_until": {
+                    "type": "string"
+                },
+                "code": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "facility_id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "hoteldto.HotelDTO": {
+            "type": "object",
+            "properties": {
+                "active_from": {
+                    "type": "string"
+                },
+                "active_until": {
+                    "type": "string"
+                },
+                "address": {
+                    "type": "string"
+                },
+                "facility": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/hoteldto.FacilityDTO"
+                    }
+                },
+                "hotel_id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "hoteldto.InquiryHotelResponse": {
+            "type": "object",
+            "properties": {
+                "hotel": {
+                    "$ref": "#/definitions/hoteldto.HotelDTO"
+                }
+            }
+        },
+        "hoteldto.InquiryHotelsResponse": {
+            "type": "object",
+            "properties": {
+                "hotels": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/hoteldto.HotelDTO"
+                    }
+                }
+            }
+        },
+        "hoteldto.PatchHotelRequest": {
+            "type": "object",
+            "properties": {
+                "active_from": {
+                    "type": "string"
+                },
+                "active_until": {
+                    "type": "string"
+                },
+                "address": {
+                    "type": "string",
+                    "maxLength": 500,
+                    "minLength": 1
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "minLength": 1
+                }
+            }
+        },
+        "hoteldto.UpdateHotelRequest": {
+            "type": "object",
+            "required": [
+                "address",
+                "name"
+            ],
+            "properties": {
+                "active_from": {
+                    "type": "string"
+                },
+                "active_until": {
+                    "type": "string"
+                },
+                "address": {
+                    "type": "string",
+                    "maxLength": 500
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 255
+                }
+            }
+        },
+        "hoteldto.UpdateHotelResponse": {
+            "type": "object",
+            "properties": {
+                "hotel": {
+                    "$ref": "#/definitions/hoteldto.HotelDTO"
+                }
+            }
+        },
+        "physicalroomdto.CreatePhysicalRoomRequest": {
+            "type": "object",
+            "required": [
+                "bedCount",
+                "name",
+                "type",
+                "unitCount"
+            ],
+            "properties": {
+                "bedCount": {
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "bedType": {
+                    "type": "string",
+                    "maxLength": 64
+                },
+                "description": {
+                    "type": "string",
+                    "maxLength": 1000
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "sizeSqm": {
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "type": {
+                    "type": "string"
+                },
+                "unitCount": {
+                    "type": "integer",
+                    "minimum": 1
+                }
+            }
+        },
+        "physicalroomdto.InquiryPhysicalRoomsResponse": {
+            "type": "object",
+            "properties": {
+                "physicalRooms": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/physicalroomdto.PhysicalRoomDTO"
+                    }
+                }
+            }
+        },
+        "physicalroomdto.OfferDTO": {
+            "type": "object",
+            "properties": {
+                "basePrice": {
+                    "type": "number",
+                    "example": 1234.5
+                },
+                "cancellationPolicy": {
+                    "type": "string"
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "maxOccupancy": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "roomID": {
+                    "type": "string"
+                }
+            }
+        },
+        "physicalroomdto.PhysicalRoomDTO": {
+            "type": "object",
+            "properties": {
+                "bedCount": {
+                    "type": "integer"
+                },
+                "bedType": {
+                    "type": "string"
+                },
+                "benefit": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/roomdto.BenefitDTO"
+                    }
+                },
+                "description": {
+                    "type": "string"
+                },
+                "hotelID": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "offers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/physicalroomdto.OfferDTO"
+                    }
+                },
+                "physicalRoomID": {
+                    "type": "string"
+                },
+                "sizeSqm": {
+                    "type": "integer"
+                },
+                "type": {
+                    "type": "string"
+                },
+                "unitCount": {
+                    "type": "integer"
+                }
+            }
+        },
+        "physicalroomdto.PhysicalRoomResponse": {
+            "type": "object",
+            "properties": {
+                "physicalRoom": {
+                    "$ref": "#/definitions/physicalroomdto.PhysicalRoomDTO"
+                }
+            }
+        },
+        "physicalroomdto.UpdatePhysicalRoomRequest": {
+            "type": "object",
+            "required": [
+                "bedCount",
+                "name",
+                "type",
+                "unitCount"
+            ],
+            "properties": {
+                "bedCount": {
+                    "type": "integer",
+                    "minimum": 1
+                },
+                "bedType": {
+                    "type": "string",
+                    "maxLength": 64
+                },
+                "description": {
+                    "type": "string",
+                    "maxLength": 1000
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "sizeSqm": {
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "type": {
+                    "type": "string"
+                },
+                "unitCount": {
+                    "type": "integer",
+                    "minimum": 1
+                }
+            }
+        },
+        "pricehistorydto.PriceHistoryResponse": {
+            "type": "object",
+            "properties": {
+                "history": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pricehistorydto.PricePointDTO"
+                    }
+                },
+                "priceAt": {
+                    "description": "PriceAt is the price of the night at the requested time, before pricing rules",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/pricehistorydto.PricePointDTO"
+                        }
+                    ]
+                }
+            }
+        },
+        "pricehistorydto.PricePointDTO": {
+            "type": "object",
+            "properties": {
+                "actor": {
+                    "type": "string"
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "date": {
+                    "type": "string"
+                },
+                "effectiveAt": {
+                    "type": "string"
+                },
+                "price": {
+                    "type": "number",
+                    "example": 1234.5
+                },
+                "source": {
+                    "type": "string",
+                    "example": "rate_calendar"
+                }
+            }
+        },
+        "pricingdto.AppliedRuleDTO": {
+            "type": "object",
+            "properties": {
+                "multiplier": {
+                    "type": "number"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "ruleID": {
+                    "type": "string"
+                }
+            }
+        },
+        "pricingdto.BatchErrorDTO": {
+            "type": "object",
+            "properties": {
+                "errors": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "message": {
+                    "type": "string",
+                    "example": "Unprocessable entity"
+                },
+                "ratePlanID": {
+                    "type": "string"
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "ruleID": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "integer",
+                    "example": 422
+                }
+            }
+        },
+        "pricingdto.BatchPricingRequest": {
+            "type": "object",
+            "required": [
+                "items"
+            ],
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "maxItems": 50,
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/pricingdto.CalculatePricingRequest"
+                    }
+                }
+            }
+        },
+        "pricingdto.BatchPricingResponse": {
+            "type": "object",
+            "properties": {
+                "results": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pricingdto.BatchResultDTO"
+                    }
+                }
+            }
+        },
+        "pricingdto.BatchResultDTO": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "$ref": "#/definitions/pricingdto.BatchErrorDTO"
+                },
+                "index": {
+                    "type": "integer"
+                },
+                "quote": {
+                    "$ref": "#/definitions/pricingdto.CalculatePricingResponse"
+                }
+            }
+        },
+        "pricingdto.CalculatePricingRequest": {
+            "type": "object",
+            "required": [
+                "checkIn",
+                "checkOut",
+                "hotelID",
+                "roomID"
+            ],
+            "properties": {
+                "accessCode": {
+                    "type": "string",
+                    "maxLength": 64
+                },
+                "adults": {
+                    "type": "integer",
+                    "maximum": 20,
+                    "minimum": 1
+                },
+                "checkIn": {
+                    "type": "string"
+                },
+                "checkOut": {
+                    "type": "string"
+                },
+                "children": {
+                    "type": "array",
+                    "maxItems": 10,
+                    "items": {
+                        "$ref": "#/definitions/pricingdto.ChildDTO"
+                    }
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "hotelID": {
+                    "type": "string"
+                },
+                "member": {
+                    "type": "boolean"
+                },
+                "promoCode": {
+                    "type": "string",
+                    "maxLength": 64
+                },
+                "ratePlanID": {
+                    "type": "string"
+                },
+                "roomID": {
+                    "type": "string"
+                }
+            }
+        },
+        "pricingdto.CalculatePricingResponse": {
+            "type": "object",
+            "properties": {
+                "cancellationSurcharge": {
+                    "$ref": "#/definitions/pricingdto.SurchargeDTO"
+                },
+                "conversion": {
+                    "$ref": "#/definitions/pricingdto.ConversionDTO"
+                },
+                "discounts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pricingdto.LineItemDTO"
+                    }
+                },
+                "fees": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pricingdto.LineItemDTO"
+                    }
+                },
+                "nightlyRates": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pricingdto.NightlyRateDTO"
+                    }
+                },
+                "nights": {
+                    "type": "integer"
+                },
+                "occupancy": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pricingdto.LineItemDTO"
+                    }
+                },
+                "promotion": {
+                    "$ref": "#/definitions/pricingdto.PromoCodeDTO"
+                },
+                "ratePlan": {
+                    "$ref": "#/definitions/pricingdto.RatePlanDTO"
+                },
+                "signedQuote": {
+                    "$ref": "#/definitions/pricingdto.SignedQuoteDTO"
+                },
+                "subtotal": {
+                    "$ref": "#/definitions/pricingdto.MoneyDTO"
+                },
+                "taxes": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pricingdto.LineItemDTO"
+                    }
+                },
+                "total": {
+                    "$ref": "#/definitions/pricingdto.MoneyDTO"
+                }
+            }
+        },
+        "pricingdto.ChildDTO": {
+            "type": "object",
+            "properties": {
+                "age": {
+                    "type": "integer",
+                    "maximum": 17,
+                    "minimum": 0
+                }
+            }
+        },
+        "pricingdto.ConversionDTO": {
+            "type": "object",
+            "properties": {
+                "rate": {
+                    "$ref": "#/definitions/pricingdto.ExchangeRateDTO"
+                },
+                "total": {
+                    "$ref": "#/definitions/pricingdto.MoneyDTO"
+                }
+            }
+        },
+        "pricingdto.DraftBasePriceRequest": {
+            "type": "object",
+            "required": [
+                "currency",
+                "price",
+                "roomID"
+            ],
+            "properties": {
+                "currency": {
+                    "type": "string"
+                },
+                "price": {
+                    "type": "number"
+                },
+                "roomID": {
+                    "type": "string"
+                }
+            }
+        },
+        "pricingdto.DraftPricingRuleRequest": {
+            "type": "object",
+            "required": [
+                "multiplier",
+                "name"
+            ],
+            "properties": {
+                "dateFrom": {
+                    "type": "string"
+                },
+                "dateUntil": {
+                    "type": "string"
+                },
+                "multiplier": {
+                    "type": "number",
+                    "maximum": 10
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "priority": {
+                    "type": "integer",
+                    "maximum": 1000,
+                    "minimum": 0
+                },
+                "roomID": {
+                    "type": "string"
+                },
+                "roomType": {
+                    "type": "string"
+                },
+                "ruleID": {
+                    "type": "string"
+                },
+                "stackable": {
+                    "type": "boolean"
+                },
+                "weekdays": {
+                    "type": "array",
+                    "maxItems": 7,
+                    "uniqueItems": true,
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "pricingdto.DraftRateRequest": {
+            "type": "object",
+            "required": [
+                "currency",
+                "date",
+                "price",
                 "roomID"
             ],
             "properties": {
+                "currency": {
+                    "type": "string"
+                },
+                "date": {
+                    "type": "string"
+                },
+                "price": {
+                    "type": "number"
+                },
+                "roomID": {
+                    "type": "string"
+                }
+            }
+        },
+        "pricingdto.ExchangeRateDTO": {
+            "type": "object",
+            "properties": {
+                "effectiveDate": {
+                    "type": "string"
+                },
+                "from": {
+                    "type": "string"
+                },
+                "rate": {
+                    "type": "number"
+                },
+                "to": {
+                    "type": "string"
+                }
+            }
+        },
+        "pricingdto.LineItemDTO": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "$ref": "#/definitions/pricingdto.MoneyDTO"
+                },
+                "inclusive": {
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "promotionID": {
+                    "type": "string"
+                },
+                "stayRuleID": {
+                    "type": "string"
+                }
+            }
+        },
+        "pricingdto.MoneyDTO": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "number",
+                    "example": 1234.5
+                },
+                "currency": {
+                    "type": "string",
+                    "example": "THB"
+                }
+            }
+        },
+        "pricingdto.NightlyRateDTO": {
+            "type": "object",
+            "properties": {
+                "baseRate": {
+                    "$ref": "#/definitions/pricingdto.MoneyDTO"
+                },
+                "date": {
+                    "type": "string"
+                },
+                "rate": {
+                    "$ref": "#/definitions/pricingdto.MoneyDTO"
+                },
+                "rules": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pricingdto.AppliedRuleDTO"
+                    }
+                },
+                "source": {
+                    "type": "string"
+                }
+            }
+        },
+        "pricingdto.PromoCodeDTO": {
+            "type": "object",
+            "properties": {
+                "applied": {
+                    "type": "boolean"
+                },
+                "code": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "the code applies to stays of at least 3 nights"
+                },
+                "reason": {
+                    "type": "string",
+                    "example": "min_nights"
+                }
+            }
+        },
+        "pricingdto.RatePlanDTO": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "string",
+                    "example": "MEMBER"
+                },
+                "inclusions": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "multiplier": {
+                    "type": "number",
+                    "example": 0.9
+                },
+                "name": {
+                    "type": "string"
+                },
+                "ratePlanID": {
+                    "type": "string"
+                }
+            }
+        },
+        "pricingdto.SignedQuoteDTO": {
+            "type": "object",
+            "properties": {
+                "adults": {
+                    "type": "integer"
+                },
+                "checkIn": {
+                    "type": "string"
+                },
+                "checkOut": {
+                    "type": "string"
+                },
+                "children": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pricingdto.ChildDTO"
+                    }
+                },
+                "expiresAt": {
+                    "type": "string"
+                },
+                "hotelID": {
+                    "type": "string"
+                },
+                "quoteID": {
+                    "type": "string"
+                },
+                "ratePlanID": {
+                    "type": "string"
+                },
+                "roomID": {
+                    "type": "string"
+                },
+                "signature": {
+                    "type": "string"
+                },
+                "total": {
+                    "$ref": "#/definitions/pricingdto.MoneyDTO"
+                }
+            }
+        },
+        "pricingdto.SimulatePricesRequest": {
+            "type": "object",
+            "required": [
+                "from",
+                "to"
+            ],
+            "properties": {
+                "basePrices": {
+                    "type": "array",
+                    "maxItems": 100,
+                    "uniqueItems": true,
+                    "items": {
+                        "$ref": "#/definitions/pricingdto.DraftBasePriceRequest"
+                    }
+                },
+                "from": {
+                    "type": "string"
+                },
+                "pricingRules": {
+                    "type": "array",
+                    "maxItems": 50,
+                    "items": {
+                        "$ref": "#/definitions/pricingdto.DraftPricingRuleRequest"
+                    }
+                },
+                "rates": {
+                    "type": "array",
+                    "maxItems": 1000,
+                    "items": {
+                        "$ref": "#/definitions/pricingdto.DraftRateRequest"
+                    }
+                },
+                "removedRuleIDs": {
+                    "type": "array",
+                    "maxItems": 50,
+                    "uniqueItems": true,
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "roomIDs": {
+                    "type": "array",
+                    "maxItems": 100,
+                    "uniqueItems": true,
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "to": {
+                    "type": "string"
+                }
+            }
+        },
+        "pricingdto.SimulatePricesResponse": {
+            "type": "object",
+            "properties": {
+                "from": {
+                    "type": "string"
+                },
+                "hotelID": {
+                    "type": "string"
+                },
+                "rooms": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pricingdto.SimulatedRoomDTO"
+                    }
+                },
+                "to": {
+                    "type": "string"
+                }
+            }
+        },
+        "pricingdto.SimulatedNightDTO": {
+            "type": "object",
+            "properties": {
+                "change": {
+                    "$ref": "#/definitions/pricingdto.MoneyDTO"
+                },
+                "changePercent": {
+                    "type": "number",
+                    "example": 15
+                },
+                "current": {
+                    "$ref": "#/definitions/pricingdto.SimulatedPriceDTO"
+                },
+                "date": {
+                    "type": "string"
+                },
+                "simulated": {
+                    "$ref": "#/definitions/pricingdto.SimulatedPriceDTO"
+                }
+            }
+        },
+        "pricingdto.SimulatedPriceDTO": {
+            "type": "object",
+            "properties": {
+                "rate": {
+                    "$ref": "#/definitions/pricingdto.MoneyDTO"
+                },
+                "rules": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pricingdto.AppliedRuleDTO"
+                    }
+                },
+                "source": {
+                    "type": "string"
+                }
+            }
+        },
+        "pricingdto.SimulatedRoomDTO": {
+            "type": "object",
+            "properties": {
+                "change": {
+                    "$ref": "#/definitions/pricingdto.MoneyDTO"
+                },
+                "currentTotal": {
+                    "$ref": "#/definitions/pricingdto.MoneyDTO"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "nights": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pricingdto.SimulatedNightDTO"
+                    }
+                },
+                "roomID": {
+                    "type": "string"
+                },
+                "simulatedTotal": {
+                    "$ref": "#/definitions/pricingdto.MoneyDTO"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "pricingdto.SurchargeDTO": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "$ref": "#/definitions/pricingdto.MoneyDTO"
+                },
+                "percent": {
+                    "type": "number"
+                },
+                "policyID": {
+                    "type": "string"
+                }
+            }
+        },
+        "pricingdto.VerifyQuoteRequest": {
+            "type": "object",
+            "required": [
+                "checkIn",
+                "checkOut",
+                "expiresAt",
+                "hotelID",
+                "quoteID",
+                "roomID",
+                "signature"
+            ],
+            "properties": {
+                "adults": {
+                    "type": "integer",
+                    "maximum": 20,
+                    "minimum": 1
+                },
+                "checkIn": {
+                    "type": "string"
+                },
+                "checkOut": {
+                    "type": "string"
+                },
+                "children": {
+                    "type": "array",
+                    "maxItems": 10,
+                    "items": {
+                        "$ref": "#/definitions/pricingdto.ChildDTO"
+                    }
+                },
+                "expiresAt": {
+                    "type": "string"
+                },
+                "hotelID": {
+                    "type": "string"
+                },
+                "quoteID": {
+                    "type": "string"
+                },
+                "ratePlanID": {
+                    "type": "string"
+                },
+                "roomID": {
+                    "type": "string"
+                },
+                "signature": {
+                    "type": "string",
+                    "maxLength": 128
+                },
+                "total": {
+                    "$ref": "#/definitions/pricingdto.MoneyDTO"
+                }
+            }
+        },
+        "pricingdto.VerifyQuoteResponse": {
+            "type": "object",
+            "properties": {
+                "expiresAt": {
+                    "type": "string"
+                },
+                "quoteID": {
+                    "type": "string"
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "total": {
+                    "$ref": "#/definitions/pricingdto.MoneyDTO"
+                },
+                "valid": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "pricingruledto.CreatePricingRuleRequest": {
+            "type": "object",
+            "required": [
+                "multiplier",
+                "name"
+            ],
+            "properties": {
+                "dateFrom": {
+                    "type": "string"
+                },
+                "dateUntil": {
+                    "type": "string"
+                },
+                "multiplier": {
+                    "type": "number",
+                    "maximum": 10
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "priority": {
+                    "type": "integer",
+                    "maximum": 1000,
+                    "minimum": 0
+                },
+                "roomID": {
+                    "type": "string"
+                },
+                "roomType": {
+                    "type": "string"
+                },
+                "stackable": {
+                    "type": "boolean"
+                },
+                "weekdays": {
+                    "type": "array",
+                    "maxItems": 7,
+                    "uniqueItems": true,
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "pricingruledto.InquiryPricingRulesResponse": {
+            "type": "object",
+            "properties": {
+                "rules": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/pricingruledto.PricingRuleDTO"
+                    }
+                }
+            }
+        },
+        "pricingruledto.PricingRuleDTO": {
+            "type": "object",
+            "properties": {
+                "dateFrom": {
+                    "type": "string"
+                },
+                "dateUntil": {
+                    "type": "string"
+                },
+                "hotelID": {
+                    "type": "string"
+                },
+                "multiplier": {
+                    "type": "number"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "priority": {
+                    "type": "integer"
+                },
+                "roomID": {
+                    "type": "string"
+                },
+                "roomType": {
+                    "type": "string"
+                },
+                "ruleID": {
+                    "type": "string"
+                },
+                "scope": {
+                    "type": "string"
+                },
+                "stackable": {
+                    "type": "boolean"
+                },
+                "weekdays": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "pricingruledto.PricingRuleResponse": {
+            "type": "object",
+            "properties": {
+                "rule": {
+                    "$ref": "#/definitions/pricingruledto.PricingRuleDTO"
+                }
+            }
+        },
+        "pricingruledto.UpdatePricingRuleRequest": {
+            "type": "object",
+            "required": [
+                "multiplier",
+                "name"
+            ],
+            "properties": {
+                "dateFrom": {
+                    "type": "string"
+                },
+                "dateUntil": {
+                    "type": "string"
+                },
+                "multiplier": {
+                    "type": "number",
+                    "maximum": 10
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "priority": {
+                    "type": "integer",
+                    "maximum": 1000,
+                    "minimum": 0
+                },
+                "roomID": {
+                    "type": "string"
+                },
+                "roomType": {
+                    "type": "string"
+                },
+                "stackable": {
+                    "type": "boolean"
+                },
+                "weekdays": {
+                    "type": "array",
+                    "maxItems": 7,
+                    "uniqueItems": true,
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "promotiondto.CreatePromotionRequest": {
+            "type": "object",
+            "required": [
+                "calculation",
+                "name"
+            ],
+            "properties": {
+                "activeFrom": {
+                    "type": "string"
+                },
+                "activeUntil": {
+                    "type": "string"
+                },
+                "amount": {
+                    "type": "number",
+                    "minimum": 0
+                },
+                "calculation": {
+                    "type": "string",
+                    "enum": [
+                        "percent",
+                        "fixed"
+                    ]
+                },
+                "code": {
+                    "type": "string"
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "hotelIDs": {
+                    "type": "array",
+                    "maxItems": 100,
+                    "uniqueItems": true,
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "maxDaysBeforeArrival": {
+                    "type": "integer",
+                    "maximum": 730,
+                    "minimum": 0
+                },
+                "maxUses": {
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "minDaysBeforeArrival": {
+                    "type": "integer",
+                    "maximum": 730,
+                    "minimum": 0
+                },
+                "minNights": {
+                    "type": "integer",
+                    "maximum": 365,
+                    "minimum": 0
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "percent": {
+                    "type": "number",
+                    "maximum": 100,
+                    "minimum": 0
+                },
+                "roomIDs": {
+                    "type": "array",
+                    "maxItems": 100,
+                    "uniqueItems": true,
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "stackable": {
+                    "type": "boolean"
+                },
+                "stayFrom": {
+                    "type": "string"
+                },
+                "stayUntil": {
+                    "type": "string"
+                }
+            }
+        },
+        "promotiondto.InquiryPromotionsResponse": {
+            "type": "object",
+            "properties": {
+                "promotions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/promotiondto.PromotionDTO"
+                    }
+                }
+            }
+        },
+        "promotiondto.PromotionDTO": {
+            "type": "object",
+            "properties": {
+                "activeFrom": {
+                    "type": "string"
+                },
+                "activeUntil": {
+                    "type": "string"
+                },
+                "amount": {
+                    "type": "number",
+                    "example": 500
+                },
+                "calculation": {
+                    "type": "string"
+                },
+                "code": {
+                    "type": "string",
+                    "example": "EARLYBIRD"
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "hotelIDs": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "maxDaysBeforeArrival": {
+                    "type": "integer"
+                },
+                "maxUses": {
+                    "type": "integer"
+                },
+                "minDaysBeforeArrival": {
+                    "type": "integer"
+                },
+                "minNights": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "percent": {
+                    "type": "number"
+                },
+                "promotionID": {
+                    "type": "string"
+                },
+                "roomIDs": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "stackable": {
+                    "type": "boolean"
+                },
+                "stayFrom": {
+                    "type": "string"
+                },
+                "stayUntil": {
+                    "type": "string"
+                },
+                "uses": {
+                    "type": "integer"
+                }
+            }
+        },
+        "promotiondto.PromotionResponse": {
+            "type": "object",
+            "properties": {
+                "promotion": {
+                    "$ref": "#/definitions/promotiondto.PromotionDTO"
+                }
+            }
+        },
+        "promotiondto.UpdatePromotionRequest": {
+            "type": "object",
+            "required": [
+                "calculation",
+                "name"
+            ],
+            "properties": {
+                "activeFrom": {
+                    "type": "string"
+                },
+                "activeUntil": {
+                    "type": "string"
+                },
+                "amount": {
+                    "type": "number",
+                    "minimum": 0
+                },
+                "calculation": {
+                    "type": "string",
+                    "enum": [
+                        "percent",
+                        "fixed"
+                    ]
+                },
+                "code": {
+                    "type": "string"
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "hotelIDs": {
+                    "type": "array",
+                    "maxItems": 100,
+                    "uniqueItems": true,
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "maxDaysBeforeArrival": {
+                    "type": "integer",
+                    "maximum": 730,
+                    "minimum": 0
+                },
+                "maxUses": {
+                    "type": "integer",
+                    "minimum": 0
+                },
+                "minDaysBeforeArrival": {
+                    "type": "integer",
+                    "maximum": 730,
+                    "minimum": 0
+                },
+                "minNights": {
+                    "type": "integer",
+                    "maximum": 365,
+                    "minimum": 0
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "percent": {
+                    "type": "number",
+                    "maximum": 100,
+                    "minimum": 0
+                },
+                "roomIDs": {
+                    "type": "array",
+                    "maxItems": 100,
+                    "uniqueItems": true,
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "stackable": {
+                    "type": "boolean"
+                },
+                "stayFrom": {
+                    "type": "string"
+                },
+                "stayUntil": {
+                    "type": "string"
+                }
+            }
+        },
+        "rateplandto.CreateRatePlanRequest": {
+            "type": "object",
+            "required": [
+                "code",
+                "inclusions",
+                "kind",
+                "multiplier",
+                "name"
+            ],
+            "properties": {
+                "accessCode": {
+                    "type": "string"
+                },
+                "cancellationPolicy": {
+                    "type": "string",
+                    "maxLength": 64
+                },
+                "code": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string",
+                    "maxLength": 1000
+                },
+                "inclusions": {
+                    "type": "array",
+                    "maxItems": 20,
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "kind": {
+                    "type": "string",
+                    "enum": [
+                        "bar",
+                        "member",
+                        "corporate",
+                        "advance_purchase"
+                    ]
+                },
+                "membersOnly": {
+                    "type": "boolean"
+                },
+                "minDaysBeforeArrival": {
+                    "type": "integer",
+                    "maximum": 730,
+                    "minimum": 0
+                },
+                "minNights": {
+                    "type": "integer",
+                    "maximum": 365,
+                    "minimum": 0
+                },
+                "multiplier": {
+                    "type": "number",
+                    "maximum": 10
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "roomIDs": {
+                    "type": "array",
+                    "maxItems": 100,
+                    "uniqueItems": true,
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "roomTypes": {
+                    "type": "array",
+                    "maxItems": 10,
+                    "uniqueItems": true,
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "rateplandto.InquiryRatePlansResponse": {
+            "type": "object",
+            "properties": {
+                "ratePlans": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/rateplandto.RatePlanDTO"
+                    }
+                }
+            }
+        },
+        "rateplandto.RatePlanDTO": {
+            "type": "object",
+            "properties": {
+                "accessCodeRequired": {
+                    "type": "boolean"
+                },
+                "cancellationPolicy": {
+                    "type": "string"
+                },
+                "code": {
+                    "type": "string",
+                    "example": "MEMBER"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "hotelID": {
+                    "type": "string"
+                },
+                "inclusions": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "kind": {
+                    "type": "string",
+                    "example": "member"
+                },
+                "membersOnly": {
+                    "type": "boolean"
+                },
+                "minDaysBeforeArrival": {
+                    "type": "integer"
+                },
+                "minNights": {
+                    "type": "integer"
+                },
+                "multiplier": {
+                    "type": "number",
+                    "example": 0.9
+                },
+                "name": {
+                    "type": "string"
+                },
+                "ratePlanID": {
+                    "type": "string"
+                },
+                "roomIDs": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "roomTypes": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "rateplandto.RatePlanResponse": {
+            "type": "object",
+            "properties": {
+                "ratePlan": {
+                    "$ref": "#/definitions/rateplandto.RatePlanDTO"
+                }
+            }
+        },
+        "rateplandto.UpdateRatePlanRequest": {
+            "type": "object",
+            "required": [
+                "code",
+                "inclusions",
+                "kind",
+                "multiplier",
+                "name"
+            ],
+            "properties": {
+                "accessCode": {
+                    "type": "string"
+                },
+                "cancellationPolicy": {
+                    "type": "string",
+                    "maxLength": 64
+                },
+                "code": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string",
+                    "maxLength": 1000
+                },
+                "inclusions": {
+                    "type": "array",
+                    "maxItems": 20,
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "kind": {
+                    "type": "string",
+                    "enum": [
+                        "bar",
+                        "member",
+                        "corporate",
+                        "advance_purchase"
+                    ]
+                },
+                "membersOnly": {
+                    "type": "boolean"
+                },
+                "minDaysBeforeArrival": {
+                    "type": "integer",
+                    "maximum": 730,
+                    "minimum": 0
+                },
+                "minNights": {
+                    "type": "integer",
+                    "maximum": 365,
+                    "minimum": 0
+                },
+                "multiplier": {
+                    "type": "number",
+                    "maximum": 10
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "roomIDs": {
+                    "type": "array",
+                    "maxItems": 100,
+                    "uniqueItems": true,
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "roomTypes": {
+                    "type": "array",
+                    "maxItems": 10,
+                    "uniqueItems": true,
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "roomdto.BenefitDTO": {
+            "type": "object",
+            "properties": {
+                "activeFrom": {
+                    "type": "string"
+                },
+                "activeUntil": {
+                    "type": "string"
+                },
+                "benefitID": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "physicalRoomID": {
+                    "type": "string"
+                }
+            }
+        },
+        "roomdto.ChildChargeDTO": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "number",
+                    "example": 400
+                },
+                "maxAge": {
+                    "type": "integer"
+                }
+            }
+        },
+        "roomdto.ChildChargeRequest": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "number",
+                    "minimum": 0
+                },
+                "maxAge": {
+                    "type": "integer",
+                    "maximum": 17,
+                    "minimum": 0
+                }
+            }
+        },
+        "roomdto.CreateRoomRequest": {
+            "type": "object",
+            "required": [
+                "basePrice",
+                "cancellationPolicy",
+                "currency",
+                "name",
+                "physicalRoomID",
+                "type"
+            ],
+            "properties": {
+                "activeFrom": {
+                    "type": "string"
+                },
+                "activeUntil": {
+                    "type": "string"
+                },
+                "baseOccupancy": {
+                    "type": "integer",
+                    "maximum": 20,
+                    "minimum": 1
+                },
+                "basePrice": {
+                    "type": "number"
+                },
+                "cancellationPolicy": {
+                    "type": "string",
+                    "maxLength": 64
+                },
+                "childCharges": {
+                    "type": "array",
+                    "maxItems": 5,
+                    "uniqueItems": true,
+                    "items": {
+                        "$ref": "#/definitions/roomdto.ChildChargeRequest"
+                    }
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string",
+                    "maxLength": 1000
+                },
+                "extraAdultCharge": {
+                    "type": "number",
+                    "minimum": 0
+                },
+                "maxOccupancy": {
+                    "type": "integer",
+                    "maximum": 20,
+                    "minimum": 1
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "physicalRoomID": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "roomdto.CreateRoomResponse": {
+            "type": "object",
+            "properties": {
+                "room": {
+                    "$ref": "#/definitions/roomdto.RoomDTO"
+                }
+            }
+        },
+        "roomdto.InquiryRoomResponse": {
+            "type": "object",
+            "properties": {
+                "room": {
+                    "$ref": "#/definitions/roomdto.RoomDTO"
+                }
+            }
+        },
+        "roomdto.InquiryRoomsResponse": {
+            "type": "object",
+            "properties": {
+                "rooms": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/roomdto.RoomDTO"
+                    }
+                }
+            }
+        },
+        "roomdto.PatchRoomRequest": {
+            "type": "object",
+            "properties": {
+                "activeFrom": {
+                    "type": "string"
+                },
+                "activeUntil": {
+                    "type": "string"
+                },
+                "baseOccupancy": {
+                    "type": "integer",
+                    "maximum": 20,
+                    "minimum": 1
+                },
+                "basePrice": {
+                    "type": "number"
+                },
+                "cancellationPolicy": {
+                    "type": "string",
+                    "maxLength": 64,
+                    "minLength": 1
+                },
+                "childCharges": {
+                    "type": "array",
+                    "maxItems": 5,
+                    "uniqueItems": true,
+                    "items": {
+                        "$ref": "#/definitions/roomdto.ChildChargeRequest"
+                    }
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string",
+                    "maxLength": 1000
+                },
+                "extraAdultCharge": {
+                    "type": "number",
+                    "minimum": 0
+                },
+                "maxOccupancy": {
+                    "type": "integer",
+                    "maximum": 20,
+                    "minimum": 1
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 255,
+                    "minLength": 1
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "roomdto.RatePlanDTO": {
+            "type": "object",
+            "properties": {
+                "accessCodeRequired": {
+                    "type": "boolean"
+                },
+                "basePrice": {
+                    "type": "number",
+                    "example": 1111.05
+                },
+                "cancellationPolicy": {
+                    "type": "string"
+                },
+                "code": {
+                    "type": "string",
+                    "example": "MEMBER"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "inclusions": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "kind": {
+                    "type": "string",
+                    "example": "member"
+                },
+                "membersOnly": {
+                    "type": "boolean"
+                },
+                "minDaysBeforeArrival": {
+                    "type": "integer"
+                },
+                "minNights": {
+                    "type": "integer"
+                },
+                "multiplier": {
+                    "type": "number",
+                    "example": 0.9
+                },
+                "name": {
+                    "type": "string"
+                },
+                "ratePlanID": {
+                    "type": "string"
+                }
+            }
+        },
+        "roomdto.RoomDTO": {
+            "type": "object",
+            "properties": {
+                "activeFrom": {
+                    "type": "string"
+                },
+                "activeUntil": {
+                    "type": "string"
+                },
+                "baseOccupancy": {
+                    "type": "integer"
+                },
+                "basePrice": {
+                    "type": "number",
+                    "example": 1234.5
+                },
+                "benefit": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/roomdto.BenefitDTO"
+                    }
+                },
+                "cancellationPolicy": {
+                    "type": "string"
+                },
+                "childCharges": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/roomdto.ChildChargeDTO"
+                    }
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "extraAdultCharge": {
+                    "type": "number",
+                    "example": 800
+                },
                 "hotelID": {
                     "type": "string"
                 },
-                "nights": {
-                    "type": "integer",
-                    "minimum": 1
+                "maxOccupancy": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "physicalRoomID": {
+                    "type": "string"
+                },
+                "ratePlans": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/roomdto.RatePlanDTO"
+                    }
                 },
                 "roomID": {
                     "type": "string"
+                },
+                "type": {
+                    "type": "string"
                 }
             }
         },
-        "pricingdto.CalculatePricingResponse": {
+        "roomdto.UpdateRoomRequest": {
             "type": "object",
+            "required": [
+                "basePrice",
+                "cancellationPolicy",
+                "currency",
+                "name",
+                "type"
+            ],
             "properties": {
-                "totalPrice": {
+                "activeFrom": {
+                    "type": "string"
+                },
+                "activeUntil": {
+                    "type": "string"
+                },
+                "baseOccupancy": {
+                    "type": "integer",
+                    "maximum": 20,
+                    "minimum": 1
+                },
+                "basePrice": {
                     "type": "number"
+                },
+                "cancellationPolicy": {
+                    "type": "string",
+                    "maxLength": 64
+                },
+                "childCharges": {
+                    "type": "array",
+                    "maxItems": 5,
+                    "uniqueItems": true,
+                    "items": {
+                        "$ref": "#/definitions/roomdto.ChildChargeRequest"
+                    }
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string",
+                    "maxLength": 1000
+                },
+                "extraAdultCharge": {
+                    "type": "number",
+                    "minimum": 0
+                },
+                "maxOccupancy": {
+                    "type": "integer",
+                    "maximum": 20,
+                    "minimum": 1
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "type": {
+                    "type": "string"
                 }
             }
         },
-        "roomdto.BenefitDTO": {
+        "roomdto.UpdateRoomResponse": {
             "type": "object",
             "properties": {
-                "benefitID": {
+                "room": {
+                    "$ref": "#/definitions/roomdto.RoomDTO"
+                }
+            }
+        },
+        "roomratedto.RoomRateDTO": {
+            "type": "object",
+            "properties": {
+                "currency": {
                     "type": "string"
                 },
-                "description": {
+                "date": {
                     "type": "string"
                 },
-                "physicalRoomID": {
+                "price": {
+                    "type": "number",
+                    "example": 1234.5
+                }
+            }
+        },
+        "roomratedto.RoomRateRequest": {
+            "type": "object",
+            "required": [
+                "date",
+                "price"
+            ],
+            "properties": {
+                "date": {
                     "type": "string"
+                },
+                "price": {
+                    "type": "number"
                 }
             }
         },
-        "roomdto.InquiryRoomResponse": {
+        "roomratedto.RoomRatesResponse": {
             "type": "object",
             "properties": {
-                "room": {
-                    "$ref": "#/definitions/roomdto.RoomDTO"
+                "rates": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/roomratedto.RoomRateDTO"
+                    }
                 }
             }
         },
-        "roomdto.InquiryRoomsResponse": {
+        "roomratedto.SetRoomRatesRequest": {
             "type": "object",
+            "required": [
+                "currency",
+                "rates"
+            ],
             "properties": {
-                "rooms": {
+                "currency": {
+                    "type": "string"
+                },
+                "rates": {
                     "type": "array",
+                    "maxItems": 366,
+                    "minItems": 1,
+                    "uniqueItems": true,
                     "items": {
-                        "$ref": "#/definitions/roomdto.RoomDTO"
+                        "$ref": "#/definitions/roomratedto.RoomRateRequest"
                     }
                 }
             }
         },
-        "roomdto.RoomDTO": {
+        "stayruledto.CreateStayRuleRequest": {
             "type": "object",
+            "required": [
+                "kind",
+                "name"
+            ],
             "properties": {
-                "basePrice": {
-                    "type": "number"
+                "dateFrom": {
+                    "type": "string"
                 },
-                "benefit": {
+                "dateUntil": {
+                    "type": "string"
+                },
+                "kind": {
+                    "type": "string",
+                    "enum": [
+                        "restriction",
+                        "discount"
+                    ]
+                },
+                "maxNights": {
+                    "type": "integer",
+                    "maximum": 365
+                },
+                "minNights": {
+                    "type": "integer",
+                    "maximum": 365,
+                    "minimum": 1
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "percent": {
+                    "type": "number",
+                    "maximum": 100,
+                    "minimum": 0
+                },
+                "roomID": {
+                    "type": "string"
+                }
+            }
+        },
+        "stayruledto.InquiryStayRulesResponse": {
+            "type": "object",
+            "properties": {
+                "rules": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/roomdto.BenefitDTO"
+                        "$ref": "#/definitions/stayruledto.StayRuleDTO"
                     }
+                }
+            }
+        },
+        "stayruledto.StayRuleDTO": {
+            "type": "object",
+            "properties": {
+                "dateFrom": {
+                    "type": "string"
                 },
-                "cancellationPolicy": {
+                "dateUntil": {
+                    "type": "string"
+                },
+                "hotelID": {
+                    "type": "string"
+                },
+                "kind": {
+                    "type": "string"
+                },
+                "maxNights": {
+                    "type": "integer"
+                },
+                "minNights": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "percent": {
+                    "type": "number"
+                },
+                "roomID": {
+                    "type": "string"
+                },
+                "ruleID": {
+                    "type": "string"
+                }
+            }
+        },
+        "stayruledto.StayRuleResponse": {
+            "type": "object",
+            "properties": {
+                "rule": {
+                    "$ref": "#/definitions/stayruledto.StayRuleDTO"
+                }
+            }
+        },
+        "stayruledto.UpdateStayRuleRequest": {
+            "type": "object",
+            "required": [
+                "kind",
+                "name"
+            ],
+            "properties": {
+                "dateFrom": {
+                    "type": "string"
+                },
+                "dateUntil": {
                     "type": "string"
                 },
+                "kind": {
+                    "type": "string",
+                    "enum": [
+                        "restriction",
+                        "discount"
+                    ]
+                },
+                "maxNights": {
+                    "type": "integer",
+                    "maximum": 365
+                },
+                "minNights": {
+                    "type": "integer",
+                    "maximum": 365,
+                    "minimum": 1
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "percent": {
+                    "type": "number",
+                    "maximum": 100,
+                    "minimum": 0
+                },
+                "roomID": {
+                    "type": "string"
+                }
+            }
+        },
+        "taxfeedto.CreateTaxFeeRequest": {
+            "type": "object",
+            "required": [
+                "calculation",
+                "kind",
+                "name"
+            ],
+            "properties": {
+                "amount": {
+                    "type": "number",
+                    "minimum": 0
+                },
+                "basis": {
+                    "type": "string",
+                    "enum": [
+                        "per_night",
+                        "per_stay",
+                        "per_person"
+                    ]
+                },
+                "calculation": {
+                    "type": "string",
+                    "enum": [
+                        "percent",
+                        "fixed"
+                    ]
+                },
+                "compound": {
+                    "type": "boolean"
+                },
                 "currency": {
                     "type": "string"
                 },
-                "description": {
+                "inclusive": {
+                    "type": "boolean"
+                },
+                "kind": {
+                    "type": "string",
+                    "enum": [
+                        "tax",
+                        "fee"
+                    ]
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "percent": {
+                    "type": "number",
+                    "maximum": 100,
+                    "minimum": 0
+                },
+                "sequence": {
+                    "type": "integer",
+                    "maximum": 100,
+                    "minimum": 0
+                }
+            }
+        },
+        "taxfeedto.InquiryTaxesAndFeesResponse": {
+            "type": "object",
+            "properties": {
+                "taxesAndFees": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/taxfeedto.TaxFeeDTO"
+                    }
+                }
+            }
+        },
+        "taxfeedto.TaxFeeDTO": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "number",
+                    "example": 50
+                },
+                "basis": {
+                    "type": "string"
+                },
+                "calculation": {
+                    "type": "string"
+                },
+                "compound": {
+                    "type": "boolean"
+                },
+                "currency": {
                     "type": "string"
                 },
                 "hotelID": {
                     "type": "string"
                 },
+                "inclusive": {
+                    "type": "boolean"
+                },
+                "kind": {
+                    "type": "string"
+                },
                 "name": {
                     "type": "string"
                 },
-                "roomID": {
+                "percent": {
+                    "type": "number"
+                },
+                "sequence": {
+                    "type": "integer"
+                },
+                "taxFeeID": {
                     "type": "string"
+                }
+            }
+        },
+        "taxfeedto.TaxFeeResponse": {
+            "type": "object",
+            "properties": {
+                "taxFee": {
+                    "$ref": "#/definitions/taxfeedto.TaxFeeDTO"
+                }
+            }
+        },
+        "taxfeedto.UpdateTaxFeeRequest": {
+            "type": "object",
+            "required": [
+                "calculation",
+                "kind",
+                "name"
+            ],
+            "properties": {
+                "amount": {
+                    "type": "number",
+                    "minimum": 0
                 },
-                "type": {
+                "basis": {
+                    "type": "string",
+                    "enum": [
+                        "per_night",
+                        "per_stay",
+                        "per_person"
+                    ]
+                },
+                "calculation": {
+                    "type": "string",
+                    "enum": [
+                        "percent",
+                        "fixed"
+                    ]
+                },
+                "compound": {
+                    "type": "boolean"
+                },
+                "currency": {
                     "type": "string"
+                },
+                "inclusive": {
+                    "type": "boolean"
+                },
+                "kind": {
+                    "type": "string",
+                    "enum": [
+                        "tax",
+                        "fee"
+                    ]
+                },
+                "name": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "percent": {
+                    "type": "number",
+                    "maximum": 100,
+                    "minimum": 0
+                },
+                "sequence": {
+                    "type": "integer",
+                    "maximum": 100,
+                    "minimum": 0
                 }
             }
         }
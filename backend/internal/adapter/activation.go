@@ -0,0 +1,15 @@
+package adapter
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// liveAt keeps active rows whose activation window contains t, active_until is exclusive
+func liveAt(t time.Time) func(*gorm.DB) *gorm.DB {
+	now := t.Unix()
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Where("is_active = ? AND (active_from IS NULL OR active_from <= ?) AND (active_until IS NULL OR active_until > ?)", true, now, now)
+	}
+}
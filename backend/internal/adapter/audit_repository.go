@@ -0,0 +1,74 @@
+package adapter
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/chayutK/hotel-property-service/internal/adapter/entity"
+	"github.com/chayutK/hotel-property-service/internal/adapter/mapper"
+	"github.com/chayutK/hotel-property-service/internal/domain"
+	"github.com/chayutK/hotel-property-service/internal/port"
+	"gorm.io/gorm"
+)
+
+type auditRepository struct {
+	db *gorm.DB
+}
+
+func NewAuditRepository(db *gorm.DB) port.AuditPort {
+	return &auditRepository{db: db}
+}
+
+func (r *auditRepository) Record(ctx context.Context, entries []domain.AuditEntry) error {
+	if len(entries) == 0 {
+		return nil
+	}
+
+	gormLogs := make([]entity.AuditLog, len(entries))
+	for i := range entries {
+		gormLog, err := mapper.ToEntityAuditLog(&entries[i])
+		if err != nil {
+			slog.Error("[ADAPTER]", "message", "error while encoding audit log", "entity_id", entries[i].EntityID, "error", err.Error())
+			return err
+		}
+		gormLogs[i] = *gormLog
+	}
+
+	if err := conn(ctx, r.db).Create(&gormLogs).Error; err != nil {
+		slog.Error("[ADAPTER]", "message", "error while creating audit logs", "error", err.Error())
+		return err
+	}
+
+	return nil
+}
+
+func (r *auditRepository) Find(ctx context.Context, filter domain.AuditFilter) ([]domain.AuditEntry, error) {
+	var gormLogs []entity.AuditLog
+
+	query := conn(ctx, r.db).Order("created_at DESC").Limit(filter.Limit)
+	if filter.Entity != "" {
+		query = query.Where("entity = ?", filter.Entity)
+	}
+	if filter.EntityID != "" {
+		query = query.Where("entity_id = ?", filter.EntityID)
+	}
+	if filter.Actor != "" {
+		query = query.Where("actor = ?", filter.Actor)
+	}
+	if filter.Field != "" {
+		query = query.Where("changes LIKE ?", `%"field":"`+filter.Field+`"%`)
+	}
+
+	if err := query.Find(&gormLogs).Error; err != nil {
+		slog.Error("[ADAPTER]", "message", "error while inquiry audit logs", "entity", filter.Entity, "entity_id", filter.EntityID, "error", err.Error())
+		return nil, err
+	}
+
+	entries, err := mapper.ToDomainAuditEntries(gormLogs)
+	if err != nil {
+		slog.Error("[ADAPTER]", "message", "error while decoding audit logs", "error", err.Error())
+		return nil, err
+	}
+
+	return entries, nil
+}
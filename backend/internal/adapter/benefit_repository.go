@@ -0,0 +1,88 @@
+package adapter
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/chayutK/hotel-property-service/internal/adapter/entity"
+	"github.com/chayutK/hotel-property-service/internal/adapter/mapper"
+	"github.com/chayutK/hotel-property-service/internal/domain"
+	"github.com/chayutK/hotel-property-service/internal/port"
+	"gorm.io/gorm"
+)
+
+type benefitRepository struct {
+	db *gorm.DB
+}
+
+func NewBenefitRepository(db *gorm.DB) port.BenefitPort {
+	return &benefitRepository{db: db}
+}
+
+func (r *benefitRepository) FindByPhysicalRoomID(ctx context.Context, physicalRoomID string) ([]domain.Benefit, error) {
+	var gormBenefits []entity.Benefit
+
+	if err := conn(ctx, r.db).Where("physical_room_id = ? AND is_active = ?", physicalRoomID, true).Find(&gormBenefits).Error; err != nil {
+		slog.Error("[ADAPTER]", "message", "error while inquiry benefits by physical room id", "physical_room_id", physicalRoomID, "error", err.Error())
+		return nil, err
+	}
+
+	return mapper.ToDomainBenefits(gormBenefits), nil
+}
+
+func (r *benefitRepository) FindByID(ctx context.Context, benefitID string) (*domain.Benefit, error) {
+	var gormBenefit entity.Benefit
+
+	if err := conn(ctx, r.db).First(&gormBenefit, "benefit_id = ? AND is_active = ?", benefitID, true).Error; err != nil {
+		slog.Error("[ADAPTER]", "message", "error while inquiry benefit by id", "benefit_id", benefitID, "error", err.Error())
+		return nil, err
+	}
+
+	return mapper.ToDomainBenefit(&gormBenefit), nil
+}
+
+func (r *benefitRepository) Create(ctx context.Context, benefit *domain.Benefit) error {
+	if err := conn(ctx, r.db).Create(mapper.ToEntityBenefit(benefit)).Error; err != nil {
+		slog.Error("[ADAPTER]", "message", "error while creating benefit", "benefit_id", benefit.ID, "error", err.Error())
+		return err
+	}
+
+	return nil
+}
+
+func (r *benefitRepository) Update(ctx context.Context, benefit *domain.Benefit) error {
+	gormBenefit := mapper.ToEntityBenefit(benefit)
+
+	result := conn(ctx, r.db).Model(&entity.Benefit{}).Where("benefit_id = ?", benefit.ID).Updates(map[string]any{
+		"name":         gormBenefit.Name,
+		"description":  gormBenefit.Description,
+		"active_from":  gormBenefit.ActiveFrom,
+		"active_until": gormBenefit.ActiveUntil,
+	})
+	if result.Error != nil {
+		slog.Error("[ADAPTER]", "message", "error while updating benefit", "benefit_id", benefit.ID, "error", result.Error.Error())
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		slog.Error("[ADAPTER]", "message", "benefit not found while updating", "benefit_id", benefit.ID)
+		return gorm.ErrRecordNotFound
+	}
+
+	return nil
+}
+
+func (r *benefitRepository) Deactivate(ctx context.Context, benefitID string) error {
+	result := conn(ctx, r.db).Model(&entity.Benefit{}).Where("benefit_id = ? AND is_active = ?", benefitID, true).Update("is_active", false)
+	if result.Error != nil {
+		slog.Error("[ADAPTER]", "message", "error while deactivating benefit", "benefit_id", benefitID, "error", result.Error.Error())
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		slog.Error("[ADAPTER]", "message", "benefit not found while deactivating", "benefit_id", benefitID)
+		return gorm.ErrRecordNotFound
+	}
+
+	return nil
+}
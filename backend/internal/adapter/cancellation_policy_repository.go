@@ -0,0 +1,109 @@
+package adapter
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/chayutK/hotel-property-service/internal/adapter/entity"
+	"github.com/chayutK/hotel-property-service/internal/adapter/mapper"
+	"github.com/chayutK/hotel-property-service/internal/domain"
+	"github.com/chayutK/hotel-property-service/internal/port"
+	"gorm.io/gorm"
+)
+
+type cancellationPolicyRepository struct {
+	db *gorm.DB
+}
+
+func NewCancellationPolicyRepository(db *gorm.DB) port.CancellationPolicyPort {
+	return &cancellationPolicyRepository{db: db}
+}
+
+func (r *cancellationPolicyRepository) FindAll(ctx context.Context) ([]domain.CancellationPolicy, error) {
+	var gormPolicies []entity.CancellationPolicy
+
+	if err := conn(ctx, r.db).Where("is_active = ?", true).Order("name").Find(&gormPolicies).Error; err != nil {
+		slog.Error("[ADAPTER]", "message", "error while inquiry cancellation policies", "error", err.Error())
+		return nil, err
+	}
+
+	return mapper.ToDomainCancellationPolicies(gormPolicies), nil
+}
+
+func (r *cancellationPolicyRepository) FindByID(ctx context.Context, policyID string) (*domain.CancellationPolicy, error) {
+	var gormPolicy entity.CancellationPolicy
+
+	if err := conn(ctx, r.db).First(&gormPolicy, "policy_id = ? AND is_active = ?", policyID, true).Error; err != nil {
+		slog.Error("[ADAPTER]", "message", "error while inquiry cancellation policy by id", "policy_id", policyID, "error", err.Error())
+		return nil, err
+	}
+
+	return mapper.ToDomainCancellationPolicy(&gormPolicy), nil
+}
+
+func (r *cancellationPolicyRepository) Create(ctx context.Context, policy *domain.CancellationPolicy) error {
+	if err := conn(ctx, r.db).Create(mapper.ToEntityCancellationPolicy(policy)).Error; err != nil {
+		slog.Error("[ADAPTER]", "message", "error while creating cancellation policy", "policy_id", policy.ID, "error", err.Error())
+		return err
+	}
+
+	return nil
+}
+
+func (r *cancellationPolicyRepository) Update(ctx context.Context, policy *domain.CancellationPolicy) error {
+	gormPolicy := mapper.ToEntityCancellationPolicy(policy)
+
+	result := conn(ctx, r.db).Model(&entity.CancellationPolicy{}).Where("policy_id = ? AND is_active = ?", policy.ID, true).Updates(map[string]any{
+		"name":                    gormPolicy.Name,
+		"description":             gormPolicy.Description,
+		"free_cancellation_hours": gormPolicy.FreeCancellationHours,
+		"penalty_tiers":           gormPolicy.PenaltyTiers,
+		"surcharge_percent":       gormPolicy.SurchargePercent,
+	})
+	if result.Error != nil {
+		slog.Error("[ADAPTER]", "message", "error while updating cancellation policy", "policy_id", policy.ID, "error", result.Error.Error())
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		slog.Error("[ADAPTER]", "message", "cancellation policy not found while updating", "policy_id", policy.ID)
+		return gorm.ErrRecordNotFound
+	}
+
+	return nil
+}
+
+func (r *cancellationPolicyRepository) Deactivate(ctx context.Context, policyID string) error {
+	result := conn(ctx, r.db).Model(&entity.CancellationPolicy{}).Where("policy_id = ? AND is_active = ?", policyID, true).Update("is_active", false)
+	if result.Error != nil {
+		slog.Error("[ADAPTER]", "message", "error while deactivating cancellation policy", "policy_id", policyID, "error", result.Error.Error())
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		slog.Error("[ADAPTER]", "message", "cancellation policy not found while deactivating", "policy_id", policyID)
+		return gorm.ErrRecordNotFound
+	}
+
+	return nil
+}
+
+func (r *cancellationPolicyRepository) IsInUse(ctx context.Context, policyID string) (bool, error) {
+	var count int64
+
+	if err := conn(ctx, r.db).Model(&entity.Room{}).Where("cancellation_policy_id = ? AND is_active = ?", policyID, true).Count(&count).Error; err != nil {
+		slog.Error("[ADAPTER]", "message", "error while counting rooms of cancellation policy", "policy_id", policyID, "error", err.Error())
+		return false, err
+	}
+
+	if count > 0 {
+		return true, nil
+	}
+
+	if err := conn(ctx, r.db).Model(&entity.RatePlan{}).Where("cancellation_policy_id = ? AND is_active = ?", policyID, true).Count(&count).Error; err != nil {
+		slog.Error("[ADAPTER]", "message", "error while counting rate plans of cancellation policy", "policy_id", policyID, "error", err.Error())
+		return false, err
+	}
+
+	return count > 0, nil
+}
@@ -0,0 +1,190 @@
+package adapter
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/chayutK/hotel-property-service/internal/adapter/entity"
+	"github.com/chayutK/hotel-property-service/internal/adapter/mapper"
+	"github.com/chayutK/hotel-property-service/internal/domain"
+	"github.com/chayutK/hotel-property-service/internal/port"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type catalogRepository struct {
+	db *gorm.DB
+}
+
+func NewCatalogRepository(db *gorm.DB) port.CatalogPort {
+	return &catalogRepository{db: db}
+}
+
+func (r *catalogRepository) Load(ctx context.Context, hotelIDs []string) (*domain.CatalogRecords, error) {
+	var (
+		gormCatalog       []entity.FacilityCatalog
+		gormPolicies      []entity.CancellationPolicy
+		gormHotels        []entity.Hotel
+		gormFacilities    []entity.Facility
+		gormPhysicalRooms []entity.PhysicalRoom
+		gormRooms         []entity.Room
+		gormBenefits      []entity.Benefit
+	)
+
+	db := conn(ctx, r.db)
+	scoped := func(column string) *gorm.DB {
+		if hotelIDs == nil {
+			return db
+		}
+		return db.Where(column+" IN ?", hotelIDs)
+	}
+
+	benefitQuery := db
+	if hotelIDs != nil {
+		benefitQuery = db.Where("physical_room_id IN (?)", db.Model(&entity.PhysicalRoom{}).Select("physical_room_id").Where("hotel_id IN ?", hotelIDs))
+	}
+
+	for _, q := range []struct {
+		query *gorm.DB
+		dest  any
+	}{
+		{db, &gormCatalog},
+		{db, &gormPolicies},
+		{scoped("hotel_id"), &gormHotels},
+		{scoped("hotel_id"), &gormFacilities},
+		{scoped("hotel_id"), &gormPhysicalRooms},
+		{scoped("hotel_id"), &gormRooms},
+		{benefitQuery, &gormBenefits},
+	} {
+		if err := q.query.Find(q.dest).Error; err != nil {
+			slog.Error("[ADAPTER]", "message", "error while loading catalog", "error", err.Error())
+			return nil, err
+		}
+	}
+
+	facilities := make([]domain.Facility, len(gormFacilities))
+	for i, f := range gormFacilities {
+		facilities[i] = *mapper.ToDomainFacility(&f)
+	}
+
+	return &domain.CatalogRecords{
+		FacilityCatalog:      mapper.ToDomainFacilityCatalogEntries(gormCatalog),
+		CancellationPolicies: mapper.ToDomainCancellationPolicies(gormPolicies),
+		Hotels:               mapper.ToDomainHotels(gormHotels),
+		Facilities:           facilities,
+		PhysicalRooms:        mapper.ToDomainPhysicalRooms(gormPhysicalRooms),
+		Rooms:                mapper.ToDomainRooms(gormRooms),
+		Benefits:             mapper.ToDomainBenefits(gormBenefits),
+	}, nil
+}
+
+func (r *catalogRepository) LoadByIDs(ctx context.Context, records *domain.CatalogRecords) (*domain.CatalogRecords, error) {
+	var (
+		gormFacilities    []entity.Facility
+		gormPhysicalRooms []entity.PhysicalRoom
+		gormRooms         []entity.Room
+		gormBenefits      []entity.Benefit
+	)
+
+	facilityIDs := make([]string, len(records.Facilities))
+	for i, f := range records.Facilities {
+		facilityIDs[i] = f.ID
+	}
+	physicalRoomIDs := make([]string, len(records.PhysicalRooms))
+	for i, p := range records.PhysicalRooms {
+		physicalRoomIDs[i] = p.ID
+	}
+	roomIDs := make([]string, len(records.Rooms))
+	for i, room := range records.Rooms {
+		roomIDs[i] = room.ID
+	}
+	benefitIDs := make([]string, len(records.Benefits))
+	for i, b := range records.Benefits {
+		benefitIDs[i] = b.ID
+	}
+
+	db := conn(ctx, r.db)
+	for _, q := range []struct {
+		query *gorm.DB
+		dest  any
+	}{
+		{db.Where("facility_id IN ?", facilityIDs), &gormFacilities},
+		{db.Where("physical_room_id IN ?", physicalRoomIDs).Or("physical_room_id IN (?)", db.Model(&entity.Benefit{}).Select("physical_room_id").Where("benefit_id IN ?", benefitIDs)), &gormPhysicalRooms},
+		{db.Where("room_id IN ?", roomIDs), &gormRooms},
+		{db.Where("benefit_id IN ?", benefitIDs), &gormBenefits},
+	} {
+		if err := q.query.Find(q.dest).Error; err != nil {
+			slog.Error("[ADAPTER]", "message", "error while loading catalog rows by id", "error", err.Error())
+			return nil, err
+		}
+	}
+
+	facilities := make([]domain.Facility, len(gormFacilities))
+	for i, f := range gormFacilities {
+		facilities[i] = *mapper.ToDomainFacility(&f)
+	}
+
+	return &domain.CatalogRecords{
+		Facilities:    facilities,
+		PhysicalRooms: mapper.ToDomainPhysicalRooms(gormPhysicalRooms),
+		Rooms:         mapper.ToDomainRooms(gormRooms),
+		Benefits:      mapper.ToDomainBenefits(gormBenefits),
+	}, nil
+}
+
+func (r *catalogRepository) Upsert(ctx context.Context, records *domain.CatalogRecords) error {
+	gormCatalog := make([]entity.FacilityCatalog, len(records.FacilityCatalog))
+	for i := range records.FacilityCatalog {
+		gormCatalog[i] = *mapper.ToEntityFacilityCatalog(&records.FacilityCatalog[i])
+	}
+	gormPolicies := make([]entity.CancellationPolicy, len(records.CancellationPolicies))
+	for i := range records.CancellationPolicies {
+		gormPolicies[i] = *mapper.ToEntityCancellationPolicy(&records.CancellationPolicies[i])
+	}
+	gormHotels := make([]entity.Hotel, len(records.Hotels))
+	for i := range records.Hotels {
+		gormHotels[i] = *mapper.ToEntityHotel(&records.Hotels[i])
+	}
+	gormFacilities := make([]entity.Facility, len(records.Facilities))
+	for i := range records.Facilities {
+		gormFacilities[i] = *mapper.ToEntityFacility(&records.Facilities[i])
+	}
+	gormPhysicalRooms := make([]entity.PhysicalRoom, len(records.PhysicalRooms))
+	for i := range records.PhysicalRooms {
+		gormPhysicalRooms[i] = *mapper.ToEntityPhysicalRoom(&records.PhysicalRooms[i])
+	}
+	gormRooms := make([]entity.Room, len(records.Rooms))
+	for i := range records.Rooms {
+		gormRooms[i] = *mapper.ToEntityRoom(&records.Rooms[i])
+	}
+	gormBenefits := make([]entity.Benefit, len(records.Benefits))
+	for i := range records.Benefits {
+		gormBenefits[i] = *mapper.ToEntityBenefit(&records.Benefits[i])
+	}
+
+	// parents are written before their children
+	return conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		for _, rows := range []struct {
+			name  string
+			count int
+			value any
+		}{
+			{"facility catalog", len(gormCatalog), &gormCatalog},
+			{"cancellation policies", len(gormPolicies), &gormPolicies},
+			{"hotels", len(gormHotels), &gormHotels},
+			{"facilities", len(gormFacilities), &gormFacilities},
+			{"physical rooms", len(gormPhysicalRooms), &gormPhysicalRooms},
+			{"rooms", len(gormRooms), &gormRooms},
+			{"benefits", len(gormBenefits), &gormBenefits},
+		} {
+			if rows.count == 0 {
+				continue
+			}
+			if err := tx.Clauses(clause.OnConflict{UpdateAll: true}).Create(rows.value).Error; err != nil {
+				slog.Error("[ADAPTER]", "message", "error while upserting "+rows.name, "error", err.Error())
+				return err
+			}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,18 @@
+package entity
+
+type AuditLog struct {
+	AuditID  string `gorm:"column:audit_id;primaryKey"`
+	Entity   string `gorm:"column:entity;index:idx_audit_logs_entity"`
+	EntityID string `gorm:"column:entity_id;index:idx_audit_logs_entity"`
+	Action   string `gorm:"column:action"`
+	Actor    string `gorm:"column:actor;index"`
+	// Changes holds the JSON encoded AuditFieldChange list
+	Changes   string `gorm:"column:changes"`
+	CreatedAt int64  `gorm:"column:created_at;index"`
+}
+
+type AuditFieldChange struct {
+	Field  string `json:"field"`
+	Before any    `json:"before"`
+	After  any    `json:"after"`
+}
@@ -6,6 +6,8 @@ type Benefit struct {
 	Name           string `gorm:"column:name"`
 	Description    string `gorm:"column:description"`
 	IsActive       bool   `gorm:"column:is_active"`
+	ActiveFrom     *int64 `gorm:"column:active_from"`
+	ActiveUntil    *int64 `gorm:"column:active_until"`
 	CreatedAt      int64  `gorm:"column:created_at;autoCreateTime"`
 	UpdatedAt      int64  `gorm:"column:updated_at;autoUpdateTime"`
 }
@@ -0,0 +1,14 @@
+package entity
+
+type CancellationPolicy struct {
+	PolicyID              string `gorm:"column:policy_id;primaryKey"`
+	Name                  string `gorm:"column:name"`
+	Description           string `gorm:"column:description"`
+	FreeCancellationHours *int   `gorm:"column:free_cancellation_hours"`
+	// PenaltyTiers is a comma separated list of hours:percent pairs such as "48:50,24:100"
+	PenaltyTiers     string  `gorm:"column:penalty_tiers"`
+	SurchargePercent float64 `gorm:"column:surcharge_percent"`
+	IsActive         bool    `gorm:"column:is_active"`
+	CreatedAt        int64   `gorm:"column:created_at;autoCreateTime"`
+	UpdatedAt        int64   `gorm:"column:updated_at;autoUpdateTime"`
+}
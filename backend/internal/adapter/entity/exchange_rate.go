@@ -0,0 +1,10 @@
+package entity
+
+type ExchangeRate struct {
+	FromCurrency  string  `gorm:"column:from_currency;primaryKey"`
+	ToCurrency    string  `gorm:"column:to_currency;primaryKey"`
+	EffectiveDate string  `gorm:"column:effective_date;primaryKey"`
+	Rate          float64 `gorm:"column:rate"`
+	CreatedAt     int64   `gorm:"column:created_at;autoCreateTime"`
+	UpdatedAt     int64   `gorm:"column:updated_at;autoUpdateTime"`
+}
@@ -1,11 +1,23 @@
 package entity
 
-type Facility struct {
-	FacilityID  string `gorm:"column:facility_id;primaryKey"`
-	HotelID     string `gorm:"column:hotel_id;index"`
+type FacilityCatalog struct {
+	Code        string `gorm:"column:code;primaryKey"`
 	Name        string `gorm:"column:name"`
 	Description string `gorm:"column:description"`
 	IsActive    bool   `gorm:"column:is_active"`
 	CreatedAt   int64  `gorm:"column:created_at;autoCreateTime"`
 	UpdatedAt   int64  `gorm:"column:updated_at;autoUpdateTime"`
 }
+
+type Facility struct {
+	FacilityID  string          `gorm:"column:facility_id;primaryKey"`
+	HotelID     string          `gorm:"column:hotel_id;index"`
+	Code        string          `gorm:"column:code;index"`
+	Catalog     FacilityCatalog `gorm:"foreignKey:Code;references:Code"`
+	Description string          `gorm:"column:description"`
+	IsActive    bool            `gorm:"column:is_active"`
+	ActiveFrom  *int64          `gorm:"column:active_from"`
+	ActiveUntil *int64          `gorm:"column:active_until"`
+	CreatedAt   int64           `gorm:"column:created_at;autoCreateTime"`
+	UpdatedAt   int64           `gorm:"column:updated_at;autoUpdateTime"`
+}
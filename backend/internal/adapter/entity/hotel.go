@@ -1,11 +1,13 @@
 package entity
 
 type Hotel struct {
-	HotelID   string     `gorm:"column:hotel_id;primaryKey"`
-	Name      string     `gorm:"column:name"`
-	Address   string     `gorm:"column:address"`
-	Facility  []Facility `gorm:"foreignKey:HotelID;references:HotelID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
-	IsActive  bool       `gorm:"column:is_active"`
-	CreatedAt int64      `gorm:"column:created_at;autoCreateTime"`
-	UpdatedAt int64      `gorm:"column:updated_at;autoUpdateTime"`
+	HotelID     string     `gorm:"column:hotel_id;primaryKey"`
+	Name        string     `gorm:"column:name"`
+	Address     string     `gorm:"column:address"`
+	Facility    []Facility `gorm:"foreignKey:HotelID;references:HotelID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	IsActive    bool       `gorm:"column:is_active"`
+	ActiveFrom  *int64     `gorm:"column:active_from"`
+	ActiveUntil *int64     `gorm:"column:active_until"`
+	CreatedAt   int64      `gorm:"column:created_at;autoCreateTime"`
+	UpdatedAt   int64      `gorm:"column:updated_at;autoUpdateTime:nano"`
 }
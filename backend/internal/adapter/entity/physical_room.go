@@ -0,0 +1,18 @@
+package entity
+
+type PhysicalRoom struct {
+	PhysicalRoomID string    `gorm:"column:physical_room_id;primaryKey"`
+	HotelID        string    `gorm:"column:hotel_id;index"`
+	Name           string    `gorm:"column:name"`
+	Description    string    `gorm:"column:description"`
+	Type           string    `gorm:"column:type"`
+	SizeSqm        int       `gorm:"column:size_sqm"`
+	BedType        string    `gorm:"column:bed_type"`
+	BedCount       int       `gorm:"column:bed_count"`
+	UnitCount      int       `gorm:"column:unit_count"`
+	Benefit        []Benefit `gorm:"foreignKey:PhysicalRoomID;references:PhysicalRoomID"`
+	Offers         []Room    `gorm:"foreignKey:PhysicalRoomID;references:PhysicalRoomID"`
+	IsActive       bool      `gorm:"column:is_active"`
+	CreatedAt      int64     `gorm:"column:created_at;autoCreateTime"`
+	UpdatedAt      int64     `gorm:"column:updated_at;autoUpdateTime"`
+}
@@ -0,0 +1,14 @@
+package entity
+
+type PriceHistory struct {
+	PriceID string `gorm:"column:price_id;primaryKey"`
+	RoomID  string `gorm:"column:room_id;index:idx_price_histories_room"`
+	Source  string `gorm:"column:source"`
+	// Date is the night of a rate, empty for a base price
+	Date string `gorm:"column:date"`
+	// PriceMinor is NULL for a rate removed from the calendar
+	PriceMinor  *int64 `gorm:"column:price_minor"`
+	Currency    string `gorm:"column:currency"`
+	Actor       string `gorm:"column:actor"`
+	EffectiveAt int64  `gorm:"column:effective_at;index:idx_price_histories_room"`
+}
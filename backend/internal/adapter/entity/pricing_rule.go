@@ -0,0 +1,19 @@
+package entity
+
+type PricingRule struct {
+	RuleID   string `gorm:"column:rule_id;primaryKey"`
+	HotelID  string `gorm:"column:hotel_id;index"`
+	RoomType string `gorm:"column:room_type"`
+	RoomID   string `gorm:"column:room_id"`
+	Name     string `gorm:"column:name"`
+	// DateFrom and DateUntil are YYYY-MM-DD, Weekdays a comma separated list such as "SAT,SUN"
+	DateFrom   string  `gorm:"column:date_from"`
+	DateUntil  string  `gorm:"column:date_until"`
+	Weekdays   string  `gorm:"column:weekdays"`
+	Multiplier float64 `gorm:"column:multiplier"`
+	Priority   int     `gorm:"column:priority"`
+	Stackable  bool    `gorm:"column:stackable"`
+	IsActive   bool    `gorm:"column:is_active"`
+	CreatedAt  int64   `gorm:"column:created_at;autoCreateTime"`
+	UpdatedAt  int64   `gorm:"column:updated_at;autoUpdateTime"`
+}
@@ -0,0 +1,27 @@
+package entity
+
+type Promotion struct {
+	PromotionID string  `gorm:"column:promotion_id;primaryKey"`
+	Code        string  `gorm:"column:code;index"`
+	Name        string  `gorm:"column:name"`
+	Calculation string  `gorm:"column:calculation"`
+	Percent     float64 `gorm:"column:percent"`
+	AmountMinor int64   `gorm:"column:amount_minor"`
+	Currency    string  `gorm:"column:currency"`
+	ActiveFrom  *int64  `gorm:"column:active_from"`
+	ActiveUntil *int64  `gorm:"column:active_until"`
+	// StayFrom and StayUntil are YYYY-MM-DD, HotelIDs and RoomIDs comma separated lists
+	StayFrom             string `gorm:"column:stay_from"`
+	StayUntil            string `gorm:"column:stay_until"`
+	MinNights            int    `gorm:"column:min_nights"`
+	MinDaysBeforeArrival int    `gorm:"column:min_days_before_arrival"`
+	MaxDaysBeforeArrival *int   `gorm:"column:max_days_before_arrival"`
+	HotelIDs             string `gorm:"column:hotel_ids"`
+	RoomIDs              string `gorm:"column:room_ids"`
+	MaxUses              int    `gorm:"column:max_uses"`
+	Uses                 int    `gorm:"column:uses"`
+	Stackable            bool   `gorm:"column:stackable"`
+	IsActive             bool   `gorm:"column:is_active"`
+	CreatedAt            int64  `gorm:"column:created_at;autoCreateTime"`
+	UpdatedAt            int64  `gorm:"column:updated_at;autoUpdateTime"`
+}
@@ -0,0 +1,23 @@
+package entity
+
+type RatePlan struct {
+	RatePlanID           string  `gorm:"column:rate_plan_id;primaryKey"`
+	HotelID              string  `gorm:"column:hotel_id;index"`
+	Code                 string  `gorm:"column:code"`
+	Name                 string  `gorm:"column:name"`
+	Description          string  `gorm:"column:description"`
+	Kind                 string  `gorm:"column:kind"`
+	Multiplier           float64 `gorm:"column:multiplier"`
+	CancellationPolicyID string  `gorm:"column:cancellation_policy_id"`
+	// Inclusions is a JSON list of names, RoomIDs and RoomTypes comma separated lists
+	Inclusions           string `gorm:"column:inclusions"`
+	RoomIDs              string `gorm:"column:room_ids"`
+	RoomTypes            string `gorm:"column:room_types"`
+	MembersOnly          bool   `gorm:"column:members_only"`
+	AccessCode           string `gorm:"column:access_code"`
+	MinDaysBeforeArrival int    `gorm:"column:min_days_before_arrival"`
+	MinNights            int    `gorm:"column:min_nights"`
+	IsActive             bool   `gorm:"column:is_active"`
+	CreatedAt            int64  `gorm:"column:created_at;autoCreateTime"`
+	UpdatedAt            int64  `gorm:"column:updated_at;autoUpdateTime"`
+}
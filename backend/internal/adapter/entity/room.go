@@ -1,17 +1,24 @@
 package entity
 
 type Room struct {
-	RoomID             string    `gorm:"column:room_id;primaryKey"`
-	PhysicalRoomID     string    `gorm:"column:physical_room_id;index"`
-	HotelID            string    `gorm:"column:hotel_id;index"`
-	Name               string    `gorm:"column:name"`
-	Description        string    `gorm:"column:description"`
-	Type               string    `gorm:"column:type"`
-	BasePrice          int64     `gorm:"column:base_price"`
-	Currency           string    `gorm:"column:currency"`
-	CancellationPolicy string    `gorm:"column:cancellation_policy"`
-	Benefit            []Benefit `gorm:"foreignKey:PhysicalRoomID;references:PhysicalRoomID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
-	IsActive           bool      `gorm:"column:is_active"`
-	CreatedAt          int64     `gorm:"column:created_at;autoCreateTime"`
-	UpdatedAt          int64     `gorm:"column:updated_at;autoUpdateTime"`
+	RoomID          string `gorm:"column:room_id;primaryKey"`
+	PhysicalRoomID  string `gorm:"column:physical_room_id;index"`
+	HotelID         string `gorm:"column:hotel_id;index"`
+	Name            string `gorm:"column:name"`
+	Description     string `gorm:"column:description"`
+	Type            string `gorm:"column:type"`
+	BasePriceMinor  int64  `gorm:"column:base_price_minor"`
+	Currency        string `gorm:"column:currency"`
+	BaseOccupancy   int    `gorm:"column:base_occupancy"`
+	MaxOccupancy    int    `gorm:"column:max_occupancy"`
+	ExtraAdultMinor int64  `gorm:"column:extra_adult_minor"`
+	// ChildCharges is a comma separated list of maxAge:minor amount pairs such as "2:0,11:50000"
+	ChildCharges         string    `gorm:"column:child_charges"`
+	CancellationPolicyID string    `gorm:"column:cancellation_policy_id;index"`
+	Benefit              []Benefit `gorm:"foreignKey:PhysicalRoomID;references:PhysicalRoomID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE"`
+	IsActive             bool      `gorm:"column:is_active"`
+	ActiveFrom           *int64    `gorm:"column:active_from"`
+	ActiveUntil          *int64    `gorm:"column:active_until"`
+	CreatedAt            int64     `gorm:"column:created_at;autoCreateTime"`
+	UpdatedAt            int64     `gorm:"column:updated_at;autoUpdateTime:nano"`
 }
@@ -0,0 +1,10 @@
+package entity
+
+type RoomRate struct {
+	RoomID     string `gorm:"column:room_id;primaryKey"`
+	Date       string `gorm:"column:date;primaryKey"`
+	PriceMinor int64  `gorm:"column:price_minor"`
+	Currency   string `gorm:"column:currency"`
+	CreatedAt  int64  `gorm:"column:created_at;autoCreateTime"`
+	UpdatedAt  int64  `gorm:"column:updated_at;autoUpdateTime"`
+}
@@ -0,0 +1,18 @@
+package entity
+
+type StayRule struct {
+	RuleID  string `gorm:"column:rule_id;primaryKey"`
+	HotelID string `gorm:"column:hotel_id;index"`
+	RoomID  string `gorm:"column:room_id"`
+	Name    string `gorm:"column:name"`
+	Kind    string `gorm:"column:kind"`
+	// DateFrom and DateUntil are YYYY-MM-DD arrival dates
+	DateFrom  string  `gorm:"column:date_from"`
+	DateUntil string  `gorm:"column:date_until"`
+	MinNights int     `gorm:"column:min_nights"`
+	MaxNights int     `gorm:"column:max_nights"`
+	Percent   float64 `gorm:"column:percent"`
+	IsActive  bool    `gorm:"column:is_active"`
+	CreatedAt int64   `gorm:"column:created_at;autoCreateTime"`
+	UpdatedAt int64   `gorm:"column:updated_at;autoUpdateTime"`
+}
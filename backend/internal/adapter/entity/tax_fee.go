@@ -0,0 +1,19 @@
+package entity
+
+type TaxFee struct {
+	TaxFeeID    string  `gorm:"column:tax_fee_id;primaryKey"`
+	HotelID     string  `gorm:"column:hotel_id;index"`
+	Name        string  `gorm:"column:name"`
+	Kind        string  `gorm:"column:kind"`
+	Calculation string  `gorm:"column:calculation"`
+	Percent     float64 `gorm:"column:percent"`
+	AmountMinor int64   `gorm:"column:amount_minor"`
+	Basis       string  `gorm:"column:basis"`
+	Currency    string  `gorm:"column:currency"`
+	Inclusive   bool    `gorm:"column:inclusive"`
+	Compound    bool    `gorm:"column:compound"`
+	Sequence    int     `gorm:"column:sequence"`
+	IsActive    bool    `gorm:"column:is_active"`
+	CreatedAt   int64   `gorm:"column:created_at;autoCreateTime"`
+	UpdatedAt   int64   `gorm:"column:updated_at;autoUpdateTime"`
+}
@@ -0,0 +1,18 @@
+package adapter
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/chayutK/hotel-property-service/internal/domain"
+	"gorm.io/gorm"
+)
+
+// notFound wraps a missing row in domain.ErrNotFound so services and handlers can tell it
+// from other database errors
+func notFound(err error) error {
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return fmt.Errorf("%w: %w", domain.ErrNotFound, err)
+	}
+	return err
+}
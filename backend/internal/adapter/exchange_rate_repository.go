@@ -0,0 +1,92 @@
+package adapter
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/chayutK/hotel-property-service/internal/adapter/entity"
+	"github.com/chayutK/hotel-property-service/internal/adapter/mapper"
+	"github.com/chayutK/hotel-property-service/internal/domain"
+	"github.com/chayutK/hotel-property-service/internal/port"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type exchangeRateRepository struct {
+	db *gorm.DB
+}
+
+func NewExchangeRateRepository(db *gorm.DB) port.ExchangeRatePort {
+	return &exchangeRateRepository{db: db}
+}
+
+func (r *exchangeRateRepository) FindAll(ctx context.Context, from, to string) ([]domain.ExchangeRate, error) {
+	var gormRates []entity.ExchangeRate
+
+	query := conn(ctx, r.db)
+	if from != "" {
+		query = query.Where("from_currency = ?", from)
+	}
+	if to != "" {
+		query = query.Where("to_currency = ?", to)
+	}
+
+	if err := query.Order("from_currency, to_currency, effective_date").Find(&gormRates).Error; err != nil {
+		slog.Error("[ADAPTER]", "message", "error while inquiry exchange rates", "from", from, "to", to, "error", err.Error())
+		return nil, err
+	}
+
+	return mapper.ToDomainExchangeRates(gormRates), nil
+}
+
+func (r *exchangeRateRepository) FindEffective(ctx context.Context, from, to, date string) (*domain.ExchangeRate, error) {
+	var gormRates []entity.ExchangeRate
+
+	if err := conn(ctx, r.db).
+		Where("from_currency = ? AND to_currency = ? AND effective_date <= ?", from, to, date).
+		Order("effective_date DESC").
+		Limit(1).
+		Find(&gormRates).Error; err != nil {
+		slog.Error("[ADAPTER]", "message", "error while inquiry effective exchange rate", "from", from, "to", to, "date", date, "error", err.Error())
+		return nil, err
+	}
+
+	if len(gormRates) == 0 {
+		slog.Error("[ADAPTER]", "message", "no effective exchange rate", "from", from, "to", to, "date", date)
+		return nil, fmt.Errorf("%w from %s to %s on %s", domain.ErrNoExchangeRate, from, to, date)
+	}
+
+	return &mapper.ToDomainExchangeRates(gormRates)[0], nil
+}
+
+func (r *exchangeRateRepository) Upsert(ctx context.Context, rates []domain.ExchangeRate) error {
+	gormRates := mapper.ToEntityExchangeRates(rates)
+
+	if err := conn(ctx, r.db).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "from_currency"}, {Name: "to_currency"}, {Name: "effective_date"}},
+		DoUpdates: clause.AssignmentColumns([]string{"rate", "updated_at"}),
+	}).Create(&gormRates).Error; err != nil {
+		slog.Error("[ADAPTER]", "message", "error while saving exchange rates", "error", err.Error())
+		return err
+	}
+
+	return nil
+}
+
+func (r *exchangeRateRepository) Delete(ctx context.Context, from, to, date string) error {
+	result := conn(ctx, r.db).
+		Where("from_currency = ? AND to_currency = ? AND effective_date = ?", from, to, date).
+		Delete(&entity.ExchangeRate{})
+	if result.Error != nil {
+		slog.Error("[ADAPTER]", "message", "error while deleting exchange rate", "from", from, "to", to, "date", date, "error", result.Error.Error())
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		slog.Error("[ADAPTER]", "message", "exchange rate not found while deleting", "from", from, "to", to, "date", date)
+		return gorm.ErrRecordNotFound
+	}
+
+	return nil
+}
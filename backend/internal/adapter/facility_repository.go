@@ -0,0 +1,115 @@
+package adapter
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/chayutK/hotel-property-service/internal/adapter/entity"
+	"github.com/chayutK/hotel-property-service/internal/adapter/mapper"
+	"github.com/chayutK/hotel-property-service/internal/domain"
+	"github.com/chayutK/hotel-property-service/internal/port"
+	"gorm.io/gorm"
+)
+
+type facilityRepository struct {
+	db *gorm.DB
+}
+
+func NewFacilityRepository(db *gorm.DB) port.FacilityPort {
+	return &facilityRepository{db: db}
+}
+
+func (r *facilityRepository) FindCatalog(ctx context.Context) ([]domain.FacilityCatalogEntry, error) {
+	var gormEntries []entity.FacilityCatalog
+
+	if err := conn(ctx, r.db).Order("code").Find(&gormEntries, "is_active = ?", true).Error; err != nil {
+		slog.Error("[ADAPTER]", "message", "error while inquiry facility catalog", "error", err.Error())
+		return nil, err
+	}
+
+	return mapper.ToDomainFacilityCatalogEntries(gormEntries), nil
+}
+
+func (r *facilityRepository) FindCatalogByCode(ctx context.Context, code string) (*domain.FacilityCatalogEntry, error) {
+	var gormEntry entity.FacilityCatalog
+
+	if err := conn(ctx, r.db).First(&gormEntry, "code = ? AND is_active = ?", code, true).Error; err != nil {
+		slog.Error("[ADAPTER]", "message", "error while inquiry facility catalog by code", "code", code, "error", err.Error())
+		return nil, err
+	}
+
+	return mapper.ToDomainFacilityCatalogEntry(&gormEntry), nil
+}
+
+func (r *facilityRepository) CreateCatalogEntry(ctx context.Context, entry *domain.FacilityCatalogEntry) error {
+	if err := conn(ctx, r.db).Create(mapper.ToEntityFacilityCatalog(entry)).Error; err != nil {
+		slog.Error("[ADAPTER]", "message", "error while creating facility catalog entry", "code", entry.Code, "error", err.Error())
+		return err
+	}
+
+	return nil
+}
+
+func (r *facilityRepository) UpdateCatalogEntry(ctx context.Context, entry *domain.FacilityCatalogEntry) error {
+	result := conn(ctx, r.db).Model(&entity.FacilityCatalog{}).Where("code = ?", entry.Code).Updates(map[string]any{
+		"name":        entry.Name,
+		"description": entry.Description,
+	})
+	if result.Error != nil {
+		slog.Error("[ADAPTER]", "message", "error while updating facility catalog entry", "code", entry.Code, "error", result.Error.Error())
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		slog.Error("[ADAPTER]", "message", "facility catalog entry not found while updating", "code", entry.Code)
+		return gorm.ErrRecordNotFound
+	}
+
+	return nil
+}
+
+func (r *facilityRepository) FindByHotelID(ctx context.Context, hotelID string) ([]domain.Facility, error) {
+	var gormFacilities []entity.Facility
+
+	if err := conn(ctx, r.db).Preload("Catalog").Find(&gormFacilities, "hotel_id = ?", hotelID).Error; err != nil {
+		slog.Error("[ADAPTER]", "message", "error while inquiry facilities by hotel id", "hotel_id", hotelID, "error", err.Error())
+		return nil, err
+	}
+
+	facilities := make([]domain.Facility, len(gormFacilities))
+	for i, f := range gormFacilities {
+		facilities[i] = *mapper.ToDomainFacility(&f)
+	}
+	return facilities, nil
+}
+
+func (r *facilityRepository) Create(ctx context.Context, facility *domain.Facility) error {
+	if err := conn(ctx, r.db).Create(mapper.ToEntityFacility(facility)).Error; err != nil {
+		slog.Error("[ADAPTER]", "message", "error while creating facility", "facility_id", facility.ID, "error", err.Error())
+		return err
+	}
+
+	return nil
+}
+
+func (r *facilityRepository) Update(ctx context.Context, facility *domain.Facility) error {
+	gormFacility := mapper.ToEntityFacility(facility)
+
+	result := conn(ctx, r.db).Model(&entity.Facility{}).Where("facility_id = ?", facility.ID).Updates(map[string]any{
+		"description":  gormFacility.Description,
+		"is_active":    gormFacility.IsActive,
+		"active_from":  gormFacility.ActiveFrom,
+		"active_until": gormFacility.ActiveUntil,
+	})
+	if result.Error != nil {
+		slog.Error("[ADAPTER]", "message", "error while updating facility", "facility_id", facility.ID, "error", result.Error.Error())
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		slog.Error("[ADAPTER]", "message", "facility not found while updating", "facility_id", facility.ID)
+		return gorm.ErrRecordNotFound
+	}
+
+	return nil
+}
@@ -3,6 +3,7 @@ package adapter
 import (
 	"context"
 	"log/slog"
+	"time"
 
 	"github.com/chayutK/hotel-property-service/internal/adapter/entity"
 	"github.com/chayutK/hotel-property-service/internal/adapter/mapper"
@@ -19,10 +20,17 @@ func NewHotelRepository(db *gorm.DB) port.HotelPort {
 	return &hotelRepository{db: db}
 }
 
-func (r *hotelRepository) FindAll(ctx context.Context) ([]domain.Hotel, error) {
+func (r *hotelRepository) FindAll(ctx context.Context, filter domain.HotelFilter) ([]domain.Hotel, error) {
 	var gormHotels []entity.Hotel
 
-	if err := r.db.WithContext(ctx).Preload("Facility").Find(&gormHotels, "is_active = ?", true).Error; err != nil {
+	now := time.Now()
+	query := conn(ctx, r.db).Preload("Facility", liveAt(now)).Preload("Facility.Catalog")
+	for _, code := range filter.FacilityCodes {
+		query = query.Where("EXISTS (SELECT 1 FROM facilities WHERE facilities.hotel_id = hotels.hotel_id AND facilities.code = ? AND facilities.is_active = ? "+
+			"AND (facilities.active_from IS NULL OR facilities.active_from <= ?) AND (facilities.active_until IS NULL OR facilities.active_until > ?))", code, true, now.Unix(), now.Unix())
+	}
+
+	if err := query.Scopes(liveAt(now)).Find(&gormHotels).Error; err != nil {
 		slog.Error("[ADAPTER]", "message", "error while inquiry hotels", "error", err.Error())
 		return nil, err
 	}
@@ -34,11 +42,85 @@ func (r *hotelRepository) FindAll(ctx context.Context) ([]domain.Hotel, error) {
 func (r *hotelRepository) FindByID(ctx context.Context, id string) (*domain.Hotel, error) {
 	var gormHotel entity.Hotel
 
-	if err := r.db.WithContext(ctx).Preload("Facility").First(&gormHotel, "hotel_id = ? AND is_active = ?", id, true).Error; err != nil {
+	if err := conn(ctx, r.db).Preload("Facility", liveAt(time.Now())).Preload("Facility.Catalog").First(&gormHotel, "hotel_id = ? AND is_active = ?", id, true).Error; err != nil {
 		slog.Error("[ADAPTER]", "message", "error while inquiry hotel by id", "hotel_id", id, "error", err.Error())
-		return nil, err
+		return nil, notFound(err)
 	}
 
 	domainHotel := mapper.ToDomainHotel(&gormHotel)
 	return domainHotel, nil
 }
+
+func (r *hotelRepository) Create(ctx context.Context, hotel *domain.Hotel) error {
+	gormHotel := mapper.ToEntityHotel(hotel)
+
+	if err := conn(ctx, r.db).Create(gormHotel).Error; err != nil {
+		slog.Error("[ADAPTER]", "message", "error while creating hotel", "hotel_id", hotel.ID, "error", err.Error())
+		return err
+	}
+
+	return nil
+}
+
+func (r *hotelRepository) Update(ctx context.Context, hotel *domain.Hotel) error {
+	gormHotel := mapper.ToEntityHotel(hotel)
+
+	query := conn(ctx, r.db).Model(&entity.Hotel{}).Where("hotel_id = ?", hotel.ID)
+	if hotel.UpdatedAt != 0 {
+		query = query.Where("updated_at = ?", hotel.UpdatedAt)
+	}
+
+	result := query.Updates(map[string]any{
+		"name":         hotel.Name,
+		"address":      hotel.Address,
+		"active_from":  gormHotel.ActiveFrom,
+		"active_until": gormHotel.ActiveUntil,
+	})
+	if result.Error != nil {
+		slog.Error("[ADAPTER]", "message", "error while updating hotel", "hotel_id", hotel.ID, "error", result.Error.Error())
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 && hotel.UpdatedAt != 0 && r.exists(ctx, hotel.ID) {
+		slog.Error("[ADAPTER]", "message", "hotel changed while updating", "hotel_id", hotel.ID, "updated_at", hotel.UpdatedAt)
+		return domain.ErrPreconditionFailed
+	}
+
+	if result.RowsAffected == 0 {
+		slog.Error("[ADAPTER]", "message", "hotel not found while updating", "hotel_id", hotel.ID)
+		return gorm.ErrRecordNotFound
+	}
+
+	return nil
+}
+
+func (r *hotelRepository) Deactivate(ctx context.Context, id string, updatedAt int64) error {
+	query := conn(ctx, r.db).Model(&entity.Hotel{}).Where("hotel_id = ? AND is_active = ?", id, true)
+	if updatedAt != 0 {
+		query = query.Where("updated_at = ?", updatedAt)
+	}
+
+	result := query.Update("is_active", false)
+	if result.Error != nil {
+		slog.Error("[ADAPTER]", "message", "error while deactivating hotel", "hotel_id", id, "error", result.Error.Error())
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 && updatedAt != 0 && r.exists(ctx, id) {
+		slog.Error("[ADAPTER]", "message", "hotel changed while deactivating", "hotel_id", id, "updated_at", updatedAt)
+		return domain.ErrPreconditionFailed
+	}
+
+	if result.RowsAffected == 0 {
+		slog.Error("[ADAPTER]", "message", "hotel not found while deactivating", "hotel_id", id)
+		return gorm.ErrRecordNotFound
+	}
+
+	return nil
+}
+
+func (r *hotelRepository) exists(ctx context.Context, id string) bool {
+	var count int64
+	conn(ctx, r.db).Model(&entity.Hotel{}).Where("hotel_id = ?", id).Count(&count)
+	return count > 0
+}
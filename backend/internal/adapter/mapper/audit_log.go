@@ -0,0 +1,57 @@
+package mapper
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/chayutK/hotel-property-service/internal/adapter/entity"
+	"github.com/chayutK/hotel-property-service/internal/domain"
+)
+
+func ToDomainAuditEntries(es []entity.AuditLog) ([]domain.AuditEntry, error) {
+	domains := make([]domain.AuditEntry, len(es))
+	for i, e := range es {
+		var changes []entity.AuditFieldChange
+		if err := json.Unmarshal([]byte(e.Changes), &changes); err != nil {
+			return nil, err
+		}
+
+		fields := make([]domain.FieldChange, len(changes))
+		for j, c := range changes {
+			fields[j] = domain.FieldChange{Field: c.Field, Before: c.Before, After: c.After}
+		}
+
+		domains[i] = domain.AuditEntry{
+			ID:       e.AuditID,
+			Entity:   e.Entity,
+			EntityID: e.EntityID,
+			Action:   e.Action,
+			Actor:    e.Actor,
+			Fields:   fields,
+			At:       time.Unix(0, e.CreatedAt).UTC(),
+		}
+	}
+	return domains, nil
+}
+
+func ToEntityAuditLog(d *domain.AuditEntry) (*entity.AuditLog, error) {
+	changes := make([]entity.AuditFieldChange, len(d.Fields))
+	for i, f := range d.Fields {
+		changes[i] = entity.AuditFieldChange{Field: f.Field, Before: f.Before, After: f.After}
+	}
+
+	encoded, err := json.Marshal(changes)
+	if err != nil {
+		return nil, err
+	}
+
+	return &entity.AuditLog{
+		AuditID:   d.ID,
+		Entity:    d.Entity,
+		EntityID:  d.EntityID,
+		Action:    d.Action,
+		Actor:     d.Actor,
+		Changes:   string(encoded),
+		CreatedAt: d.At.UnixNano(),
+	}, nil
+}
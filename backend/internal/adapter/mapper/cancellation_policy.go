@@ -0,0 +1,66 @@
+package mapper
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/chayutK/hotel-property-service/internal/adapter/entity"
+	"github.com/chayutK/hotel-property-service/internal/domain"
+)
+
+func ToDomainCancellationPolicies(es []entity.CancellationPolicy) []domain.CancellationPolicy {
+	domains := make([]domain.CancellationPolicy, len(es))
+	for i, e := range es {
+		domains[i] = *ToDomainCancellationPolicy(&e)
+	}
+	return domains
+}
+
+func ToDomainCancellationPolicy(e *entity.CancellationPolicy) *domain.CancellationPolicy {
+	if e == nil {
+		return nil
+	}
+
+	var tiers []domain.CancellationTier
+	for _, pair := range strings.Split(e.PenaltyTiers, ",") {
+		hours, percent, ok := strings.Cut(pair, ":")
+		if !ok {
+			continue
+		}
+		h, _ := strconv.Atoi(hours)
+		p, _ := strconv.ParseFloat(percent, 64)
+		tiers = append(tiers, domain.CancellationTier{HoursBeforeArrival: h, PenaltyPercent: p})
+	}
+
+	return &domain.CancellationPolicy{
+		ID:                    e.PolicyID,
+		Name:                  e.Name,
+		Description:           e.Description,
+		FreeCancellationHours: e.FreeCancellationHours,
+		PenaltyTiers:          tiers,
+		SurchargePercent:      e.SurchargePercent,
+		IsActive:              e.IsActive,
+	}
+}
+
+func ToEntityCancellationPolicy(d *domain.CancellationPolicy) *entity.CancellationPolicy {
+	if d == nil {
+		return nil
+	}
+
+	tiers := make([]string, len(d.PenaltyTiers))
+	for i, tier := range d.PenaltyTiers {
+		tiers[i] = fmt.Sprintf("%d:%s", tier.HoursBeforeArrival, strconv.FormatFloat(tier.PenaltyPercent, 'f', -1, 64))
+	}
+
+	return &entity.CancellationPolicy{
+		PolicyID:              d.ID,
+		Name:                  d.Name,
+		Description:           d.Description,
+		FreeCancellationHours: d.FreeCancellationHours,
+		PenaltyTiers:          strings.Join(tiers, ","),
+		SurchargePercent:      d.SurchargePercent,
+		IsActive:              d.IsActive,
+	}
+}
@@ -0,0 +1,32 @@
+package mapper
+
+import (
+	"github.com/chayutK/hotel-property-service/internal/adapter/entity"
+	"github.com/chayutK/hotel-property-service/internal/domain"
+)
+
+func ToDomainExchangeRates(es []entity.ExchangeRate) []domain.ExchangeRate {
+	domains := make([]domain.ExchangeRate, len(es))
+	for i, e := range es {
+		domains[i] = domain.ExchangeRate{
+			From:          e.FromCurrency,
+			To:            e.ToCurrency,
+			EffectiveDate: e.EffectiveDate,
+			Rate:          e.Rate,
+		}
+	}
+	return domains
+}
+
+func ToEntityExchangeRates(ds []domain.ExchangeRate) []entity.ExchangeRate {
+	entities := make([]entity.ExchangeRate, len(ds))
+	for i, d := range ds {
+		entities[i] = entity.ExchangeRate{
+			FromCurrency:  d.From,
+			ToCurrency:    d.To,
+			EffectiveDate: d.EffectiveDate,
+			Rate:          d.Rate,
+		}
+	}
+	return entities
+}
@@ -0,0 +1,56 @@
+package mapper
+
+import (
+	"github.com/chayutK/hotel-property-service/internal/adapter/entity"
+	"github.com/chayutK/hotel-property-service/internal/domain"
+)
+
+func ToDomainFacilityCatalogEntries(es []entity.FacilityCatalog) []domain.FacilityCatalogEntry {
+	domains := make([]domain.FacilityCatalogEntry, len(es))
+	for i, e := range es {
+		domains[i] = *ToDomainFacilityCatalogEntry(&e)
+	}
+	return domains
+}
+
+func ToDomainFacilityCatalogEntry(e *entity.FacilityCatalog) *domain.FacilityCatalogEntry {
+	if e == nil {
+		return nil
+	}
+
+	return &domain.FacilityCatalogEntry{
+		Code:        e.Code,
+		Name:        e.Name,
+		Description: e.Description,
+		IsActive:    e.IsActive,
+	}
+}
+
+func ToEntityFacilityCatalog(d *domain.FacilityCatalogEntry) *entity.FacilityCatalog {
+	if d == nil {
+		return nil
+	}
+
+	return &entity.FacilityCatalog{
+		Code:        d.Code,
+		Name:        d.Name,
+		Description: d.Description,
+		IsActive:    d.IsActive,
+	}
+}
+
+func ToEntityFacility(d *domain.Facility) *entity.Facility {
+	if d == nil {
+		return nil
+	}
+
+	return &entity.Facility{
+		FacilityID:  d.ID,
+		HotelID:     d.HotelID,
+		Code:        d.Code,
+		Description: d.Description,
+		IsActive:    d.IsActive,
+		ActiveFrom:  toUnix(d.ActiveFrom),
+		ActiveUntil: toUnix(d.ActiveUntil),
+	}
+}
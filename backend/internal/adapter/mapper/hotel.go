@@ -21,14 +21,21 @@ func ToDomainHotel(e *entity.Hotel) *domain.Hotel {
 	facilities := make([]domain.Facility, len(e.Facility))
 	for i, f := range e.Facility {
 		facilities[i] = *ToDomainFacility(&f)
+		// hotels may describe a facility in their own words, otherwise the catalog wording is shown
+		if facilities[i].Description == "" {
+			facilities[i].Description = f.Catalog.Description
+		}
 	}
 
 	return &domain.Hotel{
-		ID:       e.HotelID,
-		Name:     e.Name,
-		Address:  e.Address,
-		IsActive: e.IsActive,
-		Facility: facilities,
+		ID:          e.HotelID,
+		Name:        e.Name,
+		Address:     e.Address,
+		IsActive:    e.IsActive,
+		ActiveFrom:  fromUnix(e.ActiveFrom),
+		ActiveUntil: fromUnix(e.ActiveUntil),
+		Facility:    facilities,
+		UpdatedAt:   e.UpdatedAt,
 	}
 }
 
@@ -40,8 +47,26 @@ func ToDomainFacility(e *entity.Facility) *domain.Facility {
 	return &domain.Facility{
 		ID:          e.FacilityID,
 		HotelID:     e.HotelID,
-		Name:        e.Name,
+		Code:        e.Code,
+		Name:        e.Catalog.Name,
 		Description: e.Description,
 		IsActive:    e.IsActive,
+		ActiveFrom:  fromUnix(e.ActiveFrom),
+		ActiveUntil: fromUnix(e.ActiveUntil),
+	}
+}
+
+func ToEntityHotel(d *domain.Hotel) *entity.Hotel {
+	if d == nil {
+		return nil
+	}
+
+	return &entity.Hotel{
+		HotelID:     d.ID,
+		Name:        d.Name,
+		Address:     d.Address,
+		IsActive:    d.IsActive,
+		ActiveFrom:  toUnix(d.ActiveFrom),
+		ActiveUntil: toUnix(d.ActiveUntil),
 	}
 }
@@ -0,0 +1,62 @@
+package mapper
+
+import (
+	"github.com/chayutK/hotel-property-service/internal/adapter/entity"
+	"github.com/chayutK/hotel-property-service/internal/domain"
+)
+
+func ToDomainPhysicalRooms(es []entity.PhysicalRoom) []domain.PhysicalRoom {
+	domains := make([]domain.PhysicalRoom, len(es))
+	for i, e := range es {
+		domains[i] = *ToDomainPhysicalRoom(&e)
+	}
+	return domains
+}
+
+func ToDomainPhysicalRoom(e *entity.PhysicalRoom) *domain.PhysicalRoom {
+	if e == nil {
+		return nil
+	}
+
+	benefits := ToDomainBenefits(e.Benefit)
+
+	// offers inherit the benefits of their physical room
+	offers := ToDomainRooms(e.Offers)
+	for i := range offers {
+		offers[i].Benefit = benefits
+	}
+
+	return &domain.PhysicalRoom{
+		ID:          e.PhysicalRoomID,
+		HotelID:     e.HotelID,
+		Name:        e.Name,
+		Description: e.Description,
+		Type:        e.Type,
+		SizeSqm:     e.SizeSqm,
+		BedType:     e.BedType,
+		BedCount:    e.BedCount,
+		UnitCount:   e.UnitCount,
+		Benefit:     benefits,
+		Offers:      offers,
+		IsActive:    e.IsActive,
+	}
+}
+
+func ToEntityPhysicalRoom(d *domain.PhysicalRoom) *entity.PhysicalRoom {
+	if d == nil {
+		return nil
+	}
+
+	return &entity.PhysicalRoom{
+		PhysicalRoomID: d.ID,
+		HotelID:        d.HotelID,
+		Name:           d.Name,
+		Description:    d.Description,
+		Type:           d.Type,
+		SizeSqm:        d.SizeSqm,
+		BedType:        d.BedType,
+		BedCount:       d.BedCount,
+		UnitCount:      d.UnitCount,
+		IsActive:       d.IsActive,
+	}
+}
@@ -0,0 +1,48 @@
+package mapper
+
+import (
+	"time"
+
+	"github.com/chayutK/hotel-property-service/internal/adapter/entity"
+	"github.com/chayutK/hotel-property-service/internal/domain"
+)
+
+func ToDomainPricePoints(es []entity.PriceHistory) []domain.PricePoint {
+	domains := make([]domain.PricePoint, len(es))
+	for i, e := range es {
+		var price *domain.Money
+		if e.PriceMinor != nil {
+			price = &domain.Money{Amount: *e.PriceMinor, Currency: e.Currency}
+		}
+
+		domains[i] = domain.PricePoint{
+			ID:          e.PriceID,
+			RoomID:      e.RoomID,
+			Source:      e.Source,
+			Date:        e.Date,
+			Price:       price,
+			EffectiveAt: time.Unix(0, e.EffectiveAt).UTC(),
+			Actor:       e.Actor,
+		}
+	}
+	return domains
+}
+
+func ToEntityPriceHistories(ds []domain.PricePoint) []entity.PriceHistory {
+	entities := make([]entity.PriceHistory, len(ds))
+	for i, d := range ds {
+		entities[i] = entity.PriceHistory{
+			PriceID:     d.ID,
+			RoomID:      d.RoomID,
+			Source:      d.Source,
+			Date:        d.Date,
+			Actor:       d.Actor,
+			EffectiveAt: d.EffectiveAt.UnixNano(),
+		}
+		if d.Price != nil {
+			entities[i].PriceMinor = &d.Price.Amount
+			entities[i].Currency = d.Price.Currency
+		}
+	}
+	return entities
+}
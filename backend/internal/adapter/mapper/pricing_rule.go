@@ -0,0 +1,63 @@
+package mapper
+
+import (
+	"strings"
+
+	"github.com/chayutK/hotel-property-service/internal/adapter/entity"
+	"github.com/chayutK/hotel-property-service/internal/domain"
+)
+
+func ToDomainPricingRules(es []entity.PricingRule) []domain.PricingRule {
+	domains := make([]domain.PricingRule, len(es))
+	for i, e := range es {
+		domains[i] = *ToDomainPricingRule(&e)
+	}
+	return domains
+}
+
+func ToDomainPricingRule(e *entity.PricingRule) *domain.PricingRule {
+	if e == nil {
+		return nil
+	}
+
+	var weekdays []string
+	if e.Weekdays != "" {
+		weekdays = strings.Split(e.Weekdays, ",")
+	}
+
+	return &domain.PricingRule{
+		ID:         e.RuleID,
+		HotelID:    e.HotelID,
+		RoomType:   e.RoomType,
+		RoomID:     e.RoomID,
+		Name:       e.Name,
+		DateFrom:   e.DateFrom,
+		DateUntil:  e.DateUntil,
+		Weekdays:   weekdays,
+		Multiplier: e.Multiplier,
+		Priority:   e.Priority,
+		Stackable:  e.Stackable,
+		IsActive:   e.IsActive,
+	}
+}
+
+func ToEntityPricingRule(d *domain.PricingRule) *entity.PricingRule {
+	if d == nil {
+		return nil
+	}
+
+	return &entity.PricingRule{
+		RuleID:     d.ID,
+		HotelID:    d.HotelID,
+		RoomType:   d.RoomType,
+		RoomID:     d.RoomID,
+		Name:       d.Name,
+		DateFrom:   d.DateFrom,
+		DateUntil:  d.DateUntil,
+		Weekdays:   strings.Join(d.Weekdays, ","),
+		Multiplier: d.Multiplier,
+		Priority:   d.Priority,
+		Stackable:  d.Stackable,
+		IsActive:   d.IsActive,
+	}
+}
@@ -0,0 +1,80 @@
+package mapper
+
+import (
+	"strings"
+
+	"github.com/chayutK/hotel-property-service/internal/adapter/entity"
+	"github.com/chayutK/hotel-property-service/internal/domain"
+)
+
+func ToDomainPromotions(es []entity.Promotion) []domain.Promotion {
+	domains := make([]domain.Promotion, len(es))
+	for i, e := range es {
+		domains[i] = *ToDomainPromotion(&e)
+	}
+	return domains
+}
+
+func ToDomainPromotion(e *entity.Promotion) *domain.Promotion {
+	if e == nil {
+		return nil
+	}
+
+	return &domain.Promotion{
+		ID:                   e.PromotionID,
+		Code:                 e.Code,
+		Name:                 e.Name,
+		Calculation:          e.Calculation,
+		Percent:              e.Percent,
+		Amount:               domain.Money{Amount: e.AmountMinor, Currency: e.Currency},
+		ActiveFrom:           fromUnix(e.ActiveFrom),
+		ActiveUntil:          fromUnix(e.ActiveUntil),
+		StayFrom:             e.StayFrom,
+		StayUntil:            e.StayUntil,
+		MinNights:            e.MinNights,
+		MinDaysBeforeArrival: e.MinDaysBeforeArrival,
+		MaxDaysBeforeArrival: e.MaxDaysBeforeArrival,
+		HotelIDs:             splitIDs(e.HotelIDs),
+		RoomIDs:              splitIDs(e.RoomIDs),
+		MaxUses:              e.MaxUses,
+		Uses:                 e.Uses,
+		Stackable:            e.Stackable,
+		IsActive:             e.IsActive,
+	}
+}
+
+func ToEntityPromotion(d *domain.Promotion) *entity.Promotion {
+	if d == nil {
+		return nil
+	}
+
+	return &entity.Promotion{
+		PromotionID:          d.ID,
+		Code:                 d.Code,
+		Name:                 d.Name,
+		Calculation:          d.Calculation,
+		Percent:              d.Percent,
+		AmountMinor:          d.Amount.Amount,
+		Currency:             d.Amount.Currency,
+		ActiveFrom:           toUnix(d.ActiveFrom),
+		ActiveUntil:          toUnix(d.ActiveUntil),
+		StayFrom:             d.StayFrom,
+		StayUntil:            d.StayUntil,
+		MinNights:            d.MinNights,
+		MinDaysBeforeArrival: d.MinDaysBeforeArrival,
+		MaxDaysBeforeArrival: d.MaxDaysBeforeArrival,
+		HotelIDs:             strings.Join(d.HotelIDs, ","),
+		RoomIDs:              strings.Join(d.RoomIDs, ","),
+		MaxUses:              d.MaxUses,
+		Uses:                 d.Uses,
+		Stackable:            d.Stackable,
+		IsActive:             d.IsActive,
+	}
+}
+
+func splitIDs(ids string) []string {
+	if ids == "" {
+		return nil
+	}
+	return strings.Split(ids, ",")
+}
@@ -0,0 +1,78 @@
+package mapper
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/chayutK/hotel-property-service/internal/adapter/entity"
+	"github.com/chayutK/hotel-property-service/internal/domain"
+)
+
+func ToDomainRatePlans(es []entity.RatePlan) []domain.RatePlan {
+	domains := make([]domain.RatePlan, len(es))
+	for i, e := range es {
+		domains[i] = *ToDomainRatePlan(&e)
+	}
+	return domains
+}
+
+func ToDomainRatePlan(e *entity.RatePlan) *domain.RatePlan {
+	if e == nil {
+		return nil
+	}
+
+	var inclusions []string
+	if e.Inclusions != "" {
+		_ = json.Unmarshal([]byte(e.Inclusions), &inclusions)
+	}
+
+	return &domain.RatePlan{
+		ID:                   e.RatePlanID,
+		HotelID:              e.HotelID,
+		Code:                 e.Code,
+		Name:                 e.Name,
+		Description:          e.Description,
+		Kind:                 e.Kind,
+		Multiplier:           e.Multiplier,
+		CancellationPolicyID: e.CancellationPolicyID,
+		Inclusions:           inclusions,
+		RoomIDs:              splitIDs(e.RoomIDs),
+		RoomTypes:            splitIDs(e.RoomTypes),
+		MembersOnly:          e.MembersOnly,
+		AccessCode:           e.AccessCode,
+		MinDaysBeforeArrival: e.MinDaysBeforeArrival,
+		MinNights:            e.MinNights,
+		IsActive:             e.IsActive,
+	}
+}
+
+func ToEntityRatePlan(d *domain.RatePlan) *entity.RatePlan {
+	if d == nil {
+		return nil
+	}
+
+	var inclusions string
+	if len(d.Inclusions) > 0 {
+		encoded, _ := json.Marshal(d.Inclusions)
+		inclusions = string(encoded)
+	}
+
+	return &entity.RatePlan{
+		RatePlanID:           d.ID,
+		HotelID:              d.HotelID,
+		Code:                 d.Code,
+		Name:                 d.Name,
+		Description:          d.Description,
+		Kind:                 d.Kind,
+		Multiplier:           d.Multiplier,
+		CancellationPolicyID: d.CancellationPolicyID,
+		Inclusions:           inclusions,
+		RoomIDs:              strings.Join(d.RoomIDs, ","),
+		RoomTypes:            strings.Join(d.RoomTypes, ","),
+		MembersOnly:          d.MembersOnly,
+		AccessCode:           d.AccessCode,
+		MinDaysBeforeArrival: d.MinDaysBeforeArrival,
+		MinNights:            d.MinNights,
+		IsActive:             d.IsActive,
+	}
+}
@@ -1,6 +1,10 @@
 package mapper
 
 import (
+	"fmt"
+	"strconv"
+	"strings"
+
 	"github.com/chayutK/hotel-property-service/internal/adapter/entity"
 	"github.com/chayutK/hotel-property-service/internal/domain"
 )
@@ -24,6 +28,8 @@ func ToDomainBenefit(e *entity.Benefit) *domain.Benefit {
 		Name:           e.Name,
 		Description:    e.Description,
 		IsActive:       e.IsActive,
+		ActiveFrom:     fromUnix(e.ActiveFrom),
+		ActiveUntil:    fromUnix(e.ActiveUntil),
 	}
 }
 
@@ -45,17 +51,80 @@ func ToDomainRoom(e *entity.Room) *domain.Room {
 		benefits[i] = *ToDomainBenefit(&b)
 	}
 
+	var childCharges []domain.ChildCharge
+	for _, pair := range strings.Split(e.ChildCharges, ",") {
+		age, amount, ok := strings.Cut(pair, ":")
+		if !ok {
+			continue
+		}
+		a, _ := strconv.Atoi(age)
+		m, _ := strconv.ParseInt(amount, 10, 64)
+		childCharges = append(childCharges, domain.ChildCharge{MaxAge: a, Amount: domain.Money{Amount: m, Currency: e.Currency}})
+	}
+
 	return &domain.Room{
-		ID:                 e.RoomID,
-		PhysicalRoomID:     e.PhysicalRoomID,
-		HotelID:            e.HotelID,
-		Name:               e.Name,
-		Description:        e.Description,
-		BasePrice:          float64(e.BasePrice),
-		Type:               e.Type,
-		Currency:           e.Currency,
-		CancellationPolicy: e.CancellationPolicy,
-		Benefit:            benefits,
-		IsActive:           e.IsActive,
+		ID:                   e.RoomID,
+		PhysicalRoomID:       e.PhysicalRoomID,
+		HotelID:              e.HotelID,
+		Name:                 e.Name,
+		Description:          e.Description,
+		BasePrice:            domain.Money{Amount: e.BasePriceMinor, Currency: e.Currency},
+		BaseOccupancy:        e.BaseOccupancy,
+		MaxOccupancy:         e.MaxOccupancy,
+		ExtraAdultCharge:     domain.Money{Amount: e.ExtraAdultMinor, Currency: e.Currency},
+		ChildCharges:         childCharges,
+		Type:                 e.Type,
+		CancellationPolicyID: e.CancellationPolicyID,
+		Benefit:              benefits,
+		IsActive:             e.IsActive,
+		ActiveFrom:           fromUnix(e.ActiveFrom),
+		ActiveUntil:          fromUnix(e.ActiveUntil),
+		UpdatedAt:            e.UpdatedAt,
+	}
+}
+
+func ToEntityRoom(d *domain.Room) *entity.Room {
+	if d == nil {
+		return nil
+	}
+
+	childCharges := make([]string, len(d.ChildCharges))
+	for i, band := range d.ChildCharges {
+		childCharges[i] = fmt.Sprintf("%d:%d", band.MaxAge, band.Amount.Amount)
+	}
+
+	return &entity.Room{
+		RoomID:               d.ID,
+		PhysicalRoomID:       d.PhysicalRoomID,
+		HotelID:              d.HotelID,
+		Name:                 d.Name,
+		Description:          d.Description,
+		Type:                 d.Type,
+		BasePriceMinor:       d.BasePrice.Amount,
+		Currency:             d.BasePrice.Currency,
+		BaseOccupancy:        d.BaseOccupancy,
+		MaxOccupancy:         d.MaxOccupancy,
+		ExtraAdultMinor:      d.ExtraAdultCharge.Amount,
+		ChildCharges:         strings.Join(childCharges, ","),
+		CancellationPolicyID: d.CancellationPolicyID,
+		IsActive:             d.IsActive,
+		ActiveFrom:           toUnix(d.ActiveFrom),
+		ActiveUntil:          toUnix(d.ActiveUntil),
+	}
+}
+
+func ToEntityBenefit(d *domain.Benefit) *entity.Benefit {
+	if d == nil {
+		return nil
+	}
+
+	return &entity.Benefit{
+		BenefitID:      d.ID,
+		PhysicalRoomID: d.PhysicalRoomID,
+		Name:           d.Name,
+		Description:    d.Description,
+		IsActive:       d.IsActive,
+		ActiveFrom:     toUnix(d.ActiveFrom),
+		ActiveUntil:    toUnix(d.ActiveUntil),
 	}
 }
@@ -0,0 +1,31 @@
+package mapper
+
+import (
+	"github.com/chayutK/hotel-property-service/internal/adapter/entity"
+	"github.com/chayutK/hotel-property-service/internal/domain"
+)
+
+func ToDomainRoomRates(es []entity.RoomRate) []domain.RoomRate {
+	domains := make([]domain.RoomRate, len(es))
+	for i, e := range es {
+		domains[i] = domain.RoomRate{
+			RoomID: e.RoomID,
+			Date:   e.Date,
+			Price:  domain.Money{Amount: e.PriceMinor, Currency: e.Currency},
+		}
+	}
+	return domains
+}
+
+func ToEntityRoomRates(ds []domain.RoomRate) []entity.RoomRate {
+	entities := make([]entity.RoomRate, len(ds))
+	for i, d := range ds {
+		entities[i] = entity.RoomRate{
+			RoomID:     d.RoomID,
+			Date:       d.Date,
+			PriceMinor: d.Price.Amount,
+			Currency:   d.Price.Currency,
+		}
+	}
+	return entities
+}
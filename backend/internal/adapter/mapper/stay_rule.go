@@ -0,0 +1,54 @@
+package mapper
+
+import (
+	"github.com/chayutK/hotel-property-service/internal/adapter/entity"
+	"github.com/chayutK/hotel-property-service/internal/domain"
+)
+
+func ToDomainStayRules(es []entity.StayRule) []domain.StayRule {
+	domains := make([]domain.StayRule, len(es))
+	for i, e := range es {
+		domains[i] = *ToDomainStayRule(&e)
+	}
+	return domains
+}
+
+func ToDomainStayRule(e *entity.StayRule) *domain.StayRule {
+	if e == nil {
+		return nil
+	}
+
+	return &domain.StayRule{
+		ID:        e.RuleID,
+		HotelID:   e.HotelID,
+		RoomID:    e.RoomID,
+		Name:      e.Name,
+		Kind:      e.Kind,
+		DateFrom:  e.DateFrom,
+		DateUntil: e.DateUntil,
+		MinNights: e.MinNights,
+		MaxNights: e.MaxNights,
+		Percent:   e.Percent,
+		IsActive:  e.IsActive,
+	}
+}
+
+func ToEntityStayRule(d *domain.StayRule) *entity.StayRule {
+	if d == nil {
+		return nil
+	}
+
+	return &entity.StayRule{
+		RuleID:    d.ID,
+		HotelID:   d.HotelID,
+		RoomID:    d.RoomID,
+		Name:      d.Name,
+		Kind:      d.Kind,
+		DateFrom:  d.DateFrom,
+		DateUntil: d.DateUntil,
+		MinNights: d.MinNights,
+		MaxNights: d.MaxNights,
+		Percent:   d.Percent,
+		IsActive:  d.IsActive,
+	}
+}
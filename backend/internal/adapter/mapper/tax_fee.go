@@ -0,0 +1,57 @@
+package mapper
+
+import (
+	"github.com/chayutK/hotel-property-service/internal/adapter/entity"
+	"github.com/chayutK/hotel-property-service/internal/domain"
+)
+
+func ToDomainTaxFees(es []entity.TaxFee) []domain.TaxFee {
+	domains := make([]domain.TaxFee, len(es))
+	for i, e := range es {
+		domains[i] = *ToDomainTaxFee(&e)
+	}
+	return domains
+}
+
+func ToDomainTaxFee(e *entity.TaxFee) *domain.TaxFee {
+	if e == nil {
+		return nil
+	}
+
+	return &domain.TaxFee{
+		ID:          e.TaxFeeID,
+		HotelID:     e.HotelID,
+		Name:        e.Name,
+		Kind:        e.Kind,
+		Calculation: e.Calculation,
+		Percent:     e.Percent,
+		Amount:      domain.Money{Amount: e.AmountMinor, Currency: e.Currency},
+		Basis:       e.Basis,
+		Inclusive:   e.Inclusive,
+		Compound:    e.Compound,
+		Sequence:    e.Sequence,
+		IsActive:    e.IsActive,
+	}
+}
+
+func ToEntityTaxFee(d *domain.TaxFee) *entity.TaxFee {
+	if d == nil {
+		return nil
+	}
+
+	return &entity.TaxFee{
+		TaxFeeID:    d.ID,
+		HotelID:     d.HotelID,
+		Name:        d.Name,
+		Kind:        d.Kind,
+		Calculation: d.Calculation,
+		Percent:     d.Percent,
+		AmountMinor: d.Amount.Amount,
+		Basis:       d.Basis,
+		Currency:    d.Amount.Currency,
+		Inclusive:   d.Inclusive,
+		Compound:    d.Compound,
+		Sequence:    d.Sequence,
+		IsActive:    d.IsActive,
+	}
+}
@@ -0,0 +1,20 @@
+package mapper
+
+import "time"
+
+// toUnix stores optional timestamps as unix seconds
+func toUnix(t *time.Time) *int64 {
+	if t == nil {
+		return nil
+	}
+	seconds := t.Unix()
+	return &seconds
+}
+
+func fromUnix(seconds *int64) *time.Time {
+	if seconds == nil {
+		return nil
+	}
+	t := time.Unix(*seconds, 0).UTC()
+	return &t
+}
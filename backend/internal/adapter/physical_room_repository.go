@@ -0,0 +1,103 @@
+package adapter
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/chayutK/hotel-property-service/internal/adapter/entity"
+	"github.com/chayutK/hotel-property-service/internal/adapter/mapper"
+	"github.com/chayutK/hotel-property-service/internal/domain"
+	"github.com/chayutK/hotel-property-service/internal/port"
+	"gorm.io/gorm"
+)
+
+type physicalRoomRepository struct {
+	db *gorm.DB
+}
+
+func NewPhysicalRoomRepository(db *gorm.DB) port.PhysicalRoomPort {
+	return &physicalRoomRepository{db: db}
+}
+
+func (r *physicalRoomRepository) FindByHotelID(ctx context.Context, hotelID string) ([]domain.PhysicalRoom, error) {
+	var gormPhysicalRooms []entity.PhysicalRoom
+
+	if err := conn(ctx, r.db).
+		Preload("Benefit", "is_active = ?", true).
+		Preload("Offers", "is_active = ?", true).
+		Where("hotel_id = ? AND is_active = ?", hotelID, true).
+		Find(&gormPhysicalRooms).Error; err != nil {
+		slog.Error("[ADAPTER]", "message", "error while inquiry physical rooms by hotel id", "hotel_id", hotelID, "error", err.Error())
+		return nil, err
+	}
+
+	return mapper.ToDomainPhysicalRooms(gormPhysicalRooms), nil
+}
+
+func (r *physicalRoomRepository) FindByID(ctx context.Context, physicalRoomID string) (*domain.PhysicalRoom, error) {
+	var gormPhysicalRoom entity.PhysicalRoom
+
+	if err := conn(ctx, r.db).
+		Preload("Benefit", "is_active = ?", true).
+		Preload("Offers", "is_active = ?", true).
+		First(&gormPhysicalRoom, "physical_room_id = ? AND is_active = ?", physicalRoomID, true).Error; err != nil {
+		slog.Error("[ADAPTER]", "message", "error while inquiry physical room by id", "physical_room_id", physicalRoomID, "error", err.Error())
+		return nil, err
+	}
+
+	return mapper.ToDomainPhysicalRoom(&gormPhysicalRoom), nil
+}
+
+func (r *physicalRoomRepository) Create(ctx context.Context, physicalRoom *domain.PhysicalRoom) error {
+	if err := conn(ctx, r.db).Create(mapper.ToEntityPhysicalRoom(physicalRoom)).Error; err != nil {
+		slog.Error("[ADAPTER]", "message", "error while creating physical room", "physical_room_id", physicalRoom.ID, "error", err.Error())
+		return err
+	}
+
+	return nil
+}
+
+func (r *physicalRoomRepository) Update(ctx context.Context, physicalRoom *domain.PhysicalRoom) error {
+	result := conn(ctx, r.db).Model(&entity.PhysicalRoom{}).Where("physical_room_id = ?", physicalRoom.ID).Updates(map[string]any{
+		"name":        physicalRoom.Name,
+		"description": physicalRoom.Description,
+		"type":        physicalRoom.Type,
+		"size_sqm":    physicalRoom.SizeSqm,
+		"bed_type":    physicalRoom.BedType,
+		"bed_count":   physicalRoom.BedCount,
+		"unit_count":  physicalRoom.UnitCount,
+	})
+	if result.Error != nil {
+		slog.Error("[ADAPTER]", "message", "error while updating physical room", "physical_room_id", physicalRoom.ID, "error", result.Error.Error())
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		slog.Error("[ADAPTER]", "message", "physical room not found while updating", "physical_room_id", physicalRoom.ID)
+		return gorm.ErrRecordNotFound
+	}
+
+	return nil
+}
+
+func (r *physicalRoomRepository) Deactivate(ctx context.Context, physicalRoomID string) error {
+	return conn(ctx, r.db).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&entity.PhysicalRoom{}).Where("physical_room_id = ? AND is_active = ?", physicalRoomID, true).Update("is_active", false)
+		if result.Error != nil {
+			slog.Error("[ADAPTER]", "message", "error while deactivating physical room", "physical_room_id", physicalRoomID, "error", result.Error.Error())
+			return result.Error
+		}
+
+		if result.RowsAffected == 0 {
+			slog.Error("[ADAPTER]", "message", "physical room not found while deactivating", "physical_room_id", physicalRoomID)
+			return gorm.ErrRecordNotFound
+		}
+
+		if err := tx.Model(&entity.Room{}).Where("physical_room_id = ? AND is_active = ?", physicalRoomID, true).Update("is_active", false).Error; err != nil {
+			slog.Error("[ADAPTER]", "message", "error while deactivating physical room offers", "physical_room_id", physicalRoomID, "error", err.Error())
+			return err
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,59 @@
+package adapter
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/chayutK/hotel-property-service/internal/adapter/entity"
+	"github.com/chayutK/hotel-property-service/internal/adapter/mapper"
+	"github.com/chayutK/hotel-property-service/internal/domain"
+	"github.com/chayutK/hotel-property-service/internal/port"
+	"gorm.io/gorm"
+)
+
+type priceHistoryRepository struct {
+	db *gorm.DB
+}
+
+func NewPriceHistoryRepository(db *gorm.DB) port.PriceHistoryPort {
+	return &priceHistoryRepository{db: db}
+}
+
+func (r *priceHistoryRepository) Record(ctx context.Context, points []domain.PricePoint) error {
+	if len(points) == 0 {
+		return nil
+	}
+
+	gormPoints := mapper.ToEntityPriceHistories(points)
+	if err := conn(ctx, r.db).Create(&gormPoints).Error; err != nil {
+		slog.Error("[ADAPTER]", "message", "error while creating price history", "error", err.Error())
+		return err
+	}
+
+	return nil
+}
+
+func (r *priceHistoryRepository) Find(ctx context.Context, filter domain.PriceHistoryFilter) ([]domain.PricePoint, error) {
+	var gormPoints []entity.PriceHistory
+
+	query := conn(ctx, r.db).Where("room_id = ?", filter.RoomID).Order("effective_at, price_id")
+	if filter.Source != "" {
+		query = query.Where("source = ?", filter.Source)
+	}
+	if filter.Night != "" {
+		query = query.Where("source = ? OR date = ?", domain.RateSourceBasePrice, filter.Night)
+	}
+	if filter.From != nil {
+		query = query.Where("effective_at >= ?", filter.From.UnixNano())
+	}
+	if filter.To != nil {
+		query = query.Where("effective_at < ?", filter.To.UnixNano())
+	}
+
+	if err := query.Find(&gormPoints).Error; err != nil {
+		slog.Error("[ADAPTER]", "message", "error while inquiry price history", "room_id", filter.RoomID, "error", err.Error())
+		return nil, err
+	}
+
+	return mapper.ToDomainPricePoints(gormPoints), nil
+}
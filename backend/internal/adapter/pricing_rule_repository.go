@@ -0,0 +1,93 @@
+package adapter
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/chayutK/hotel-property-service/internal/adapter/entity"
+	"github.com/chayutK/hotel-property-service/internal/adapter/mapper"
+	"github.com/chayutK/hotel-property-service/internal/domain"
+	"github.com/chayutK/hotel-property-service/internal/port"
+	"gorm.io/gorm"
+)
+
+type pricingRuleRepository struct {
+	db *gorm.DB
+}
+
+func NewPricingRuleRepository(db *gorm.DB) port.PricingRulePort {
+	return &pricingRuleRepository{db: db}
+}
+
+func (r *pricingRuleRepository) FindByHotelID(ctx context.Context, hotelID string) ([]domain.PricingRule, error) {
+	var gormRules []entity.PricingRule
+
+	if err := conn(ctx, r.db).Where("hotel_id = ? AND is_active = ?", hotelID, true).Order("priority DESC, name").Find(&gormRules).Error; err != nil {
+		slog.Error("[ADAPTER]", "message", "error while inquiry pricing rules by hotel id", "hotel_id", hotelID, "error", err.Error())
+		return nil, err
+	}
+
+	return mapper.ToDomainPricingRules(gormRules), nil
+}
+
+func (r *pricingRuleRepository) FindByID(ctx context.Context, ruleID string) (*domain.PricingRule, error) {
+	var gormRule entity.PricingRule
+
+	if err := conn(ctx, r.db).First(&gormRule, "rule_id = ? AND is_active = ?", ruleID, true).Error; err != nil {
+		slog.Error("[ADAPTER]", "message", "error while inquiry pricing rule by id", "rule_id", ruleID, "error", err.Error())
+		return nil, err
+	}
+
+	return mapper.ToDomainPricingRule(&gormRule), nil
+}
+
+func (r *pricingRuleRepository) Create(ctx context.Context, rule *domain.PricingRule) error {
+	if err := conn(ctx, r.db).Create(mapper.ToEntityPricingRule(rule)).Error; err != nil {
+		slog.Error("[ADAPTER]", "message", "error while creating pricing rule", "rule_id", rule.ID, "error", err.Error())
+		return err
+	}
+
+	return nil
+}
+
+func (r *pricingRuleRepository) Update(ctx context.Context, rule *domain.PricingRule) error {
+	gormRule := mapper.ToEntityPricingRule(rule)
+
+	result := conn(ctx, r.db).Model(&entity.PricingRule{}).Where("rule_id = ?", rule.ID).Updates(map[string]any{
+		"room_type":  gormRule.RoomType,
+		"room_id":    gormRule.RoomID,
+		"name":       gormRule.Name,
+		"date_from":  gormRule.DateFrom,
+		"date_until": gormRule.DateUntil,
+		"weekdays":   gormRule.Weekdays,
+		"multiplier": gormRule.Multiplier,
+		"priority":   gormRule.Priority,
+		"stackable":  gormRule.Stackable,
+	})
+	if result.Error != nil {
+		slog.Error("[ADAPTER]", "message", "error while updating pricing rule", "rule_id", rule.ID, "error", result.Error.Error())
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		slog.Error("[ADAPTER]", "message", "pricing rule not found while updating", "rule_id", rule.ID)
+		return gorm.ErrRecordNotFound
+	}
+
+	return nil
+}
+
+func (r *pricingRuleRepository) Deactivate(ctx context.Context, ruleID string) error {
+	result := conn(ctx, r.db).Model(&entity.PricingRule{}).Where("rule_id = ? AND is_active = ?", ruleID, true).Update("is_active", false)
+	if result.Error != nil {
+		slog.Error("[ADAPTER]", "message", "error while deactivating pricing rule", "rule_id", ruleID, "error", result.Error.Error())
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		slog.Error("[ADAPTER]", "message", "pricing rule not found while deactivating", "rule_id", ruleID)
+		return gorm.ErrRecordNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,123 @@
+package adapter
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/chayutK/hotel-property-service/internal/adapter/entity"
+	"github.com/chayutK/hotel-property-service/internal/adapter/mapper"
+	"github.com/chayutK/hotel-property-service/internal/domain"
+	"github.com/chayutK/hotel-property-service/internal/port"
+	"gorm.io/gorm"
+)
+
+type promotionRepository struct {
+	db *gorm.DB
+}
+
+func NewPromotionRepository(db *gorm.DB) port.PromotionPort {
+	return &promotionRepository{db: db}
+}
+
+func (r *promotionRepository) FindAll(ctx context.Context) ([]domain.Promotion, error) {
+	var gormPromotions []entity.Promotion
+
+	if err := conn(ctx, r.db).Where("is_active = ?", true).Order("code").Order("name").Find(&gormPromotions).Error; err != nil {
+		slog.Error("[ADAPTER]", "message", "error while inquiry promotions", "error", err.Error())
+		return nil, err
+	}
+
+	return mapper.ToDomainPromotions(gormPromotions), nil
+}
+
+func (r *promotionRepository) FindByID(ctx context.Context, promotionID string) (*domain.Promotion, error) {
+	var gormPromotion entity.Promotion
+
+	if err := conn(ctx, r.db).First(&gormPromotion, "promotion_id = ? AND is_active = ?", promotionID, true).Error; err != nil {
+		slog.Error("[ADAPTER]", "message", "error while inquiry promotion by id", "promotion_id", promotionID, "error", err.Error())
+		return nil, err
+	}
+
+	return mapper.ToDomainPromotion(&gormPromotion), nil
+}
+
+func (r *promotionRepository) Create(ctx context.Context, promotion *domain.Promotion) error {
+	if err := conn(ctx, r.db).Create(mapper.ToEntityPromotion(promotion)).Error; err != nil {
+		slog.Error("[ADAPTER]", "message", "error while creating promotion", "promotion_id", promotion.ID, "error", err.Error())
+		return err
+	}
+
+	return nil
+}
+
+func (r *promotionRepository) Update(ctx context.Context, promotion *domain.Promotion) error {
+	gormPromotion := mapper.ToEntityPromotion(promotion)
+
+	result := conn(ctx, r.db).Model(&entity.Promotion{}).Where("promotion_id = ? AND is_active = ?", promotion.ID, true).Updates(map[string]any{
+		"code":                    gormPromotion.Code,
+		"name":                    gormPromotion.Name,
+		"calculation":             gormPromotion.Calculation,
+		"percent":                 gormPromotion.Percent,
+		"amount_minor":            gormPromotion.AmountMinor,
+		"currency":                gormPromotion.Currency,
+		"active_from":             gormPromotion.ActiveFrom,
+		"active_until":            gormPromotion.ActiveUntil,
+		"stay_from":               gormPromotion.StayFrom,
+		"stay_until":              gormPromotion.StayUntil,
+		"min_nights":              gormPromotion.MinNights,
+		"min_days_before_arrival": gormPromotion.MinDaysBeforeArrival,
+		"max_days_before_arrival": gormPromotion.MaxDaysBeforeArrival,
+		"hotel_ids":               gormPromotion.HotelIDs,
+		"room_ids":                gormPromotion.RoomIDs,
+		"max_uses":                gormPromotion.MaxUses,
+		"stackable":               gormPromotion.Stackable,
+	})
+	if result.Error != nil {
+		slog.Error("[ADAPTER]", "message", "error while updating promotion", "promotion_id", promotion.ID, "error", result.Error.Error())
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		slog.Error("[ADAPTER]", "message", "promotion not found while updating", "promotion_id", promotion.ID)
+		return gorm.ErrRecordNotFound
+	}
+
+	return nil
+}
+
+func (r *promotionRepository) Deactivate(ctx context.Context, promotionID string) error {
+	result := conn(ctx, r.db).Model(&entity.Promotion{}).Where("promotion_id = ? AND is_active = ?", promotionID, true).Update("is_active", false)
+	if result.Error != nil {
+		slog.Error("[ADAPTER]", "message", "error while deactivating promotion", "promotion_id", promotionID, "error", result.Error.Error())
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		slog.Error("[ADAPTER]", "message", "promotion not found while deactivating", "promotion_id", promotionID)
+		return gorm.ErrRecordNotFound
+	}
+
+	return nil
+}
+
+// Redeem increments the use count in the same statement that checks the cap, so concurrent
+// redemptions cannot exceed it
+func (r *promotionRepository) Redeem(ctx context.Context, promotionID string) error {
+	result := conn(ctx, r.db).Model(&entity.Promotion{}).
+		Where("promotion_id = ? AND is_active = ? AND (max_uses = 0 OR uses < max_uses)", promotionID, true).
+		UpdateColumn("uses", gorm.Expr("uses + 1"))
+	if result.Error != nil {
+		slog.Error("[ADAPTER]", "message", "error while redeeming promotion", "promotion_id", promotionID, "error", result.Error.Error())
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		if _, err := r.FindByID(ctx, promotionID); err != nil {
+			return err
+		}
+		slog.Error("[ADAPTER]", "message", "promotion used up while redeeming", "promotion_id", promotionID)
+		return domain.ErrPromotionUsedUp
+	}
+
+	return nil
+}
@@ -0,0 +1,97 @@
+package adapter
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/chayutK/hotel-property-service/internal/adapter/entity"
+	"github.com/chayutK/hotel-property-service/internal/adapter/mapper"
+	"github.com/chayutK/hotel-property-service/internal/domain"
+	"github.com/chayutK/hotel-property-service/internal/port"
+	"gorm.io/gorm"
+)
+
+type ratePlanRepository struct {
+	db *gorm.DB
+}
+
+func NewRatePlanRepository(db *gorm.DB) port.RatePlanPort {
+	return &ratePlanRepository{db: db}
+}
+
+func (r *ratePlanRepository) FindByHotelID(ctx context.Context, hotelID string) ([]domain.RatePlan, error) {
+	var gormPlans []entity.RatePlan
+
+	if err := conn(ctx, r.db).Where("hotel_id = ? AND is_active = ?", hotelID, true).Order("multiplier DESC, code").Find(&gormPlans).Error; err != nil {
+		slog.Error("[ADAPTER]", "message", "error while inquiry rate plans by hotel id", "hotel_id", hotelID, "error", err.Error())
+		return nil, err
+	}
+
+	return mapper.ToDomainRatePlans(gormPlans), nil
+}
+
+func (r *ratePlanRepository) FindByID(ctx context.Context, ratePlanID string) (*domain.RatePlan, error) {
+	var gormPlan entity.RatePlan
+
+	if err := conn(ctx, r.db).First(&gormPlan, "rate_plan_id = ? AND is_active = ?", ratePlanID, true).Error; err != nil {
+		slog.Error("[ADAPTER]", "message", "error while inquiry rate plan by id", "rate_plan_id", ratePlanID, "error", err.Error())
+		return nil, err
+	}
+
+	return mapper.ToDomainRatePlan(&gormPlan), nil
+}
+
+func (r *ratePlanRepository) Create(ctx context.Context, plan *domain.RatePlan) error {
+	if err := conn(ctx, r.db).Create(mapper.ToEntityRatePlan(plan)).Error; err != nil {
+		slog.Error("[ADAPTER]", "message", "error while creating rate plan", "rate_plan_id", plan.ID, "error", err.Error())
+		return err
+	}
+
+	return nil
+}
+
+func (r *ratePlanRepository) Update(ctx context.Context, plan *domain.RatePlan) error {
+	gormPlan := mapper.ToEntityRatePlan(plan)
+
+	result := conn(ctx, r.db).Model(&entity.RatePlan{}).Where("rate_plan_id = ? AND is_active = ?", plan.ID, true).Updates(map[string]any{
+		"code":                    gormPlan.Code,
+		"name":                    gormPlan.Name,
+		"description":             gormPlan.Description,
+		"kind":                    gormPlan.Kind,
+		"multiplier":              gormPlan.Multiplier,
+		"cancellation_policy_id":  gormPlan.CancellationPolicyID,
+		"inclusions":              gormPlan.Inclusions,
+		"room_ids":                gormPlan.RoomIDs,
+		"room_types":              gormPlan.RoomTypes,
+		"members_only":            gormPlan.MembersOnly,
+		"access_code":             gormPlan.AccessCode,
+		"min_days_before_arrival": gormPlan.MinDaysBeforeArrival,
+		"min_nights":              gormPlan.MinNights,
+	})
+	if result.Error != nil {
+		slog.Error("[ADAPTER]", "message", "error while updating rate plan", "rate_plan_id", plan.ID, "error", result.Error.Error())
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		slog.Error("[ADAPTER]", "message", "rate plan not found while updating", "rate_plan_id", plan.ID)
+		return gorm.ErrRecordNotFound
+	}
+
+	return nil
+}
+
+func (r *ratePlanRepository) Deactivate(ctx context.Context, ratePlanID string) error {
+	result := conn(ctx, r.db).Model(&entity.RatePlan{}).Where("rate_plan_id = ? AND is_active = ?", ratePlanID, true).Update("is_active", false)
+	if result.Error != nil {
+		slog.Error("[ADAPTER]", "message", "error while deactivating rate plan", "rate_plan_id", ratePlanID, "error", result.Error.Error())
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		slog.Error("[ADAPTER]", "message", "rate plan not found while deactivating", "rate_plan_id", ratePlanID)
+		return gorm.ErrRecordNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,88 @@
+package adapter
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/chayutK/hotel-property-service/internal/adapter/entity"
+	"github.com/chayutK/hotel-property-service/internal/adapter/mapper"
+	"github.com/chayutK/hotel-property-service/internal/domain"
+	"github.com/chayutK/hotel-property-service/internal/port"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type roomRateRepository struct {
+	db *gorm.DB
+}
+
+func NewRoomRateRepository(db *gorm.DB) port.RoomRatePort {
+	return &roomRateRepository{db: db}
+}
+
+func (r *roomRateRepository) FindByRoomID(ctx context.Context, roomID, from, to string) ([]domain.RoomRate, error) {
+	var gormRates []entity.RoomRate
+
+	query := conn(ctx, r.db).Where("room_id = ?", roomID)
+	if from != "" {
+		query = query.Where("date >= ?", from)
+	}
+	if to != "" {
+		query = query.Where("date < ?", to)
+	}
+
+	if err := query.Order("date").Find(&gormRates).Error; err != nil {
+		slog.Error("[ADAPTER]", "message", "error while inquiry room rates by room id", "room_id", roomID, "error", err.Error())
+		return nil, err
+	}
+
+	return mapper.ToDomainRoomRates(gormRates), nil
+}
+
+func (r *roomRateRepository) FindByRoomIDs(ctx context.Context, roomIDs []string, from, to string) ([]domain.RoomRate, error) {
+	var gormRates []entity.RoomRate
+
+	query := conn(ctx, r.db).Where("room_id IN ?", roomIDs)
+	if from != "" {
+		query = query.Where("date >= ?", from)
+	}
+	if to != "" {
+		query = query.Where("date < ?", to)
+	}
+
+	if err := query.Order("room_id, date").Find(&gormRates).Error; err != nil {
+		slog.Error("[ADAPTER]", "message", "error while inquiry room rates by room ids", "room_ids", len(roomIDs), "error", err.Error())
+		return nil, err
+	}
+
+	return mapper.ToDomainRoomRates(gormRates), nil
+}
+
+func (r *roomRateRepository) Upsert(ctx context.Context, rates []domain.RoomRate) error {
+	gormRates := mapper.ToEntityRoomRates(rates)
+
+	if err := conn(ctx, r.db).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "room_id"}, {Name: "date"}},
+		DoUpdates: clause.AssignmentColumns([]string{"price_minor", "currency", "updated_at"}),
+	}).Create(&gormRates).Error; err != nil {
+		slog.Error("[ADAPTER]", "message", "error while saving room rates", "error", err.Error())
+		return err
+	}
+
+	return nil
+}
+
+func (r *roomRateRepository) Delete(ctx context.Context, roomID, date string) error {
+	result := conn(ctx, r.db).Where("room_id = ? AND date = ?", roomID, date).Delete(&entity.RoomRate{})
+	if result.Error != nil {
+		slog.Error("[ADAPTER]", "message", "error while deleting room rate", "room_id", roomID, "date", date, "error", result.Error.Error())
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		slog.Error("[ADAPTER]", "message", "room rate not found while deleting", "room_id", roomID, "date", date)
+		return gorm.ErrRecordNotFound
+	}
+
+	return nil
+}
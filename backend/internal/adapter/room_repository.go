@@ -3,6 +3,7 @@ package adapter
 import (
 	"context"
 	"log/slog"
+	"time"
 
 	"github.com/chayutK/hotel-property-service/internal/adapter/entity"
 	"github.com/chayutK/hotel-property-service/internal/adapter/mapper"
@@ -22,7 +23,8 @@ func NewRoomRepository(db *gorm.DB) port.RoomPort {
 func (r *RoomRepository) FindByHotelID(ctx context.Context, hotelID string) ([]domain.Room, error) {
 	var gormRooms []entity.Room
 
-	if err := r.db.WithContext(ctx).Preload("Benefit").Where("hotel_id = ? AND is_active = ?", hotelID, true).Find(&gormRooms).Error; err != nil {
+	now := time.Now()
+	if err := conn(ctx, r.db).Preload("Benefit", liveAt(now)).Scopes(liveAt(now)).Where("hotel_id = ?", hotelID).Find(&gormRooms).Error; err != nil {
 		slog.Error("[ADAPTER]", "message", "error while inquiry rooms by hotel id", "hotel_id", hotelID, "error", err.Error())
 		return nil, err
 	}
@@ -34,11 +36,116 @@ func (r *RoomRepository) FindByHotelID(ctx context.Context, hotelID string) ([]d
 func (r *RoomRepository) FindByRoomID(ctx context.Context, roomID string) (*domain.Room, error) {
 	var gormRoom entity.Room
 
-	if err := r.db.WithContext(ctx).Preload("Benefit").First(&gormRoom, "room_id = ? AND is_active = ?", roomID, true).Error; err != nil {
+	if err := conn(ctx, r.db).Preload("Benefit", liveAt(time.Now())).First(&gormRoom, "room_id = ? AND is_active = ?", roomID, true).Error; err != nil {
 		slog.Error("[ADAPTER]", "message", "error while inquiry room by room id", "room_id", roomID, "error", err.Error())
-		return nil, err
+		return nil, notFound(err)
 	}
 
 	domainRoom := mapper.ToDomainRoom(&gormRoom)
 	return domainRoom, nil
 }
+
+func (r *RoomRepository) FindByRoomIDs(ctx context.Context, roomIDs []string) ([]domain.Room, error) {
+	var gormRooms []entity.Room
+
+	if err := conn(ctx, r.db).Preload("Benefit", liveAt(time.Now())).Where("room_id IN ? AND is_active = ?", roomIDs, true).Find(&gormRooms).Error; err != nil {
+		slog.Error("[ADAPTER]", "message", "error while inquiry rooms by room ids", "room_ids", len(roomIDs), "error", err.Error())
+		return nil, err
+	}
+
+	domainRooms := mapper.ToDomainRooms(gormRooms)
+	return domainRooms, nil
+}
+
+func (r *RoomRepository) FindCurrenciesByHotelID(ctx context.Context, hotelID string) ([]string, error) {
+	var currencies []string
+
+	if err := conn(ctx, r.db).Model(&entity.Room{}).Where("hotel_id = ? AND is_active = ?", hotelID, true).Distinct().Pluck("currency", &currencies).Error; err != nil {
+		slog.Error("[ADAPTER]", "message", "error while inquiry room currencies by hotel id", "hotel_id", hotelID, "error", err.Error())
+		return nil, err
+	}
+
+	return currencies, nil
+}
+
+func (r *RoomRepository) Create(ctx context.Context, room *domain.Room) error {
+	gormRoom := mapper.ToEntityRoom(room)
+
+	if err := conn(ctx, r.db).Create(gormRoom).Error; err != nil {
+		slog.Error("[ADAPTER]", "message", "error while creating room", "room_id", room.ID, "error", err.Error())
+		return err
+	}
+
+	return nil
+}
+
+func (r *RoomRepository) Update(ctx context.Context, room *domain.Room) error {
+	gormRoom := mapper.ToEntityRoom(room)
+
+	query := conn(ctx, r.db).Model(&entity.Room{}).Where("room_id = ?", room.ID)
+	if room.UpdatedAt != 0 {
+		query = query.Where("updated_at = ?", room.UpdatedAt)
+	}
+
+	result := query.Updates(map[string]any{
+		"name":                   gormRoom.Name,
+		"description":            gormRoom.Description,
+		"type":                   gormRoom.Type,
+		"base_price_minor":       gormRoom.BasePriceMinor,
+		"currency":               gormRoom.Currency,
+		"base_occupancy":         gormRoom.BaseOccupancy,
+		"max_occupancy":          gormRoom.MaxOccupancy,
+		"extra_adult_minor":      gormRoom.ExtraAdultMinor,
+		"child_charges":          gormRoom.ChildCharges,
+		"cancellation_policy_id": gormRoom.CancellationPolicyID,
+		"active_from":            gormRoom.ActiveFrom,
+		"active_until":           gormRoom.ActiveUntil,
+	})
+	if result.Error != nil {
+		slog.Error("[ADAPTER]", "message", "error while updating room", "room_id", room.ID, "error", result.Error.Error())
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 && room.UpdatedAt != 0 && r.exists(ctx, room.ID) {
+		slog.Error("[ADAPTER]", "message", "room changed while updating", "room_id", room.ID, "updated_at", room.UpdatedAt)
+		return domain.ErrPreconditionFailed
+	}
+
+	if result.RowsAffected == 0 {
+		slog.Error("[ADAPTER]", "message", "room not found while updating", "room_id", room.ID)
+		return gorm.ErrRecordNotFound
+	}
+
+	return nil
+}
+
+func (r *RoomRepository) Deactivate(ctx context.Context, roomID string, updatedAt int64) error {
+	query := conn(ctx, r.db).Model(&entity.Room{}).Where("room_id = ? AND is_active = ?", roomID, true)
+	if updatedAt != 0 {
+		query = query.Where("updated_at = ?", updatedAt)
+	}
+
+	result := query.Update("is_active", false)
+	if result.Error != nil {
+		slog.Error("[ADAPTER]", "message", "error while deactivating room", "room_id", roomID, "error", result.Error.Error())
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 && updatedAt != 0 && r.exists(ctx, roomID) {
+		slog.Error("[ADAPTER]", "message", "room changed while deactivating", "room_id", roomID, "updated_at", updatedAt)
+		return domain.ErrPreconditionFailed
+	}
+
+	if result.RowsAffected == 0 {
+		slog.Error("[ADAPTER]", "message", "room not found while deactivating", "room_id", roomID)
+		return gorm.ErrRecordNotFound
+	}
+
+	return nil
+}
+
+func (r *RoomRepository) exists(ctx context.Context, roomID string) bool {
+	var count int64
+	conn(ctx, r.db).Model(&entity.Room{}).Where("room_id = ?", roomID).Count(&count)
+	return count > 0
+}
@@ -0,0 +1,92 @@
+package adapter
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/chayutK/hotel-property-service/internal/adapter/entity"
+	"github.com/chayutK/hotel-property-service/internal/adapter/mapper"
+	"github.com/chayutK/hotel-property-service/internal/domain"
+	"github.com/chayutK/hotel-property-service/internal/port"
+	"gorm.io/gorm"
+)
+
+type stayRuleRepository struct {
+	db *gorm.DB
+}
+
+func NewStayRuleRepository(db *gorm.DB) port.StayRulePort {
+	return &stayRuleRepository{db: db}
+}
+
+func (r *stayRuleRepository) FindByHotelID(ctx context.Context, hotelID string) ([]domain.StayRule, error) {
+	var gormRules []entity.StayRule
+
+	if err := conn(ctx, r.db).Where("hotel_id = ? AND is_active = ?", hotelID, true).Order("kind DESC, min_nights, name").Find(&gormRules).Error; err != nil {
+		slog.Error("[ADAPTER]", "message", "error while inquiry stay rules by hotel id", "hotel_id", hotelID, "error", err.Error())
+		return nil, err
+	}
+
+	return mapper.ToDomainStayRules(gormRules), nil
+}
+
+func (r *stayRuleRepository) FindByID(ctx context.Context, ruleID string) (*domain.StayRule, error) {
+	var gormRule entity.StayRule
+
+	if err := conn(ctx, r.db).First(&gormRule, "rule_id = ? AND is_active = ?", ruleID, true).Error; err != nil {
+		slog.Error("[ADAPTER]", "message", "error while inquiry stay rule by id", "rule_id", ruleID, "error", err.Error())
+		return nil, err
+	}
+
+	return mapper.ToDomainStayRule(&gormRule), nil
+}
+
+func (r *stayRuleRepository) Create(ctx context.Context, rule *domain.StayRule) error {
+	if err := conn(ctx, r.db).Create(mapper.ToEntityStayRule(rule)).Error; err != nil {
+		slog.Error("[ADAPTER]", "message", "error while creating stay rule", "rule_id", rule.ID, "error", err.Error())
+		return err
+	}
+
+	return nil
+}
+
+func (r *stayRuleRepository) Update(ctx context.Context, rule *domain.StayRule) error {
+	gormRule := mapper.ToEntityStayRule(rule)
+
+	result := conn(ctx, r.db).Model(&entity.StayRule{}).Where("rule_id = ? AND is_active = ?", rule.ID, true).Updates(map[string]any{
+		"room_id":    gormRule.RoomID,
+		"name":       gormRule.Name,
+		"kind":       gormRule.Kind,
+		"date_from":  gormRule.DateFrom,
+		"date_until": gormRule.DateUntil,
+		"min_nights": gormRule.MinNights,
+		"max_nights": gormRule.MaxNights,
+		"percent":    gormRule.Percent,
+	})
+	if result.Error != nil {
+		slog.Error("[ADAPTER]", "message", "error while updating stay rule", "rule_id", rule.ID, "error", result.Error.Error())
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		slog.Error("[ADAPTER]", "message", "stay rule not found while updating", "rule_id", rule.ID)
+		return gorm.ErrRecordNotFound
+	}
+
+	return nil
+}
+
+func (r *stayRuleRepository) Deactivate(ctx context.Context, ruleID string) error {
+	result := conn(ctx, r.db).Model(&entity.StayRule{}).Where("rule_id = ? AND is_active = ?", ruleID, true).Update("is_active", false)
+	if result.Error != nil {
+		slog.Error("[ADAPTER]", "message", "error while deactivating stay rule", "rule_id", ruleID, "error", result.Error.Error())
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		slog.Error("[ADAPTER]", "message", "stay rule not found while deactivating", "rule_id", ruleID)
+		return gorm.ErrRecordNotFound
+	}
+
+	return nil
+}
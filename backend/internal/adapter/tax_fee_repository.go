@@ -0,0 +1,94 @@
+package adapter
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/chayutK/hotel-property-service/internal/adapter/entity"
+	"github.com/chayutK/hotel-property-service/internal/adapter/mapper"
+	"github.com/chayutK/hotel-property-service/internal/domain"
+	"github.com/chayutK/hotel-property-service/internal/port"
+	"gorm.io/gorm"
+)
+
+type taxFeeRepository struct {
+	db *gorm.DB
+}
+
+func NewTaxFeeRepository(db *gorm.DB) port.TaxFeePort {
+	return &taxFeeRepository{db: db}
+}
+
+func (r *taxFeeRepository) FindByHotelID(ctx context.Context, hotelID string) ([]domain.TaxFee, error) {
+	var gormTaxFees []entity.TaxFee
+
+	if err := conn(ctx, r.db).Where("hotel_id = ? AND is_active = ?", hotelID, true).Order("sequence, name").Find(&gormTaxFees).Error; err != nil {
+		slog.Error("[ADAPTER]", "message", "error while inquiry taxes and fees by hotel id", "hotel_id", hotelID, "error", err.Error())
+		return nil, err
+	}
+
+	return mapper.ToDomainTaxFees(gormTaxFees), nil
+}
+
+func (r *taxFeeRepository) FindByID(ctx context.Context, taxFeeID string) (*domain.TaxFee, error) {
+	var gormTaxFee entity.TaxFee
+
+	if err := conn(ctx, r.db).First(&gormTaxFee, "tax_fee_id = ? AND is_active = ?", taxFeeID, true).Error; err != nil {
+		slog.Error("[ADAPTER]", "message", "error while inquiry tax or fee by id", "tax_fee_id", taxFeeID, "error", err.Error())
+		return nil, err
+	}
+
+	return mapper.ToDomainTaxFee(&gormTaxFee), nil
+}
+
+func (r *taxFeeRepository) Create(ctx context.Context, taxFee *domain.TaxFee) error {
+	if err := conn(ctx, r.db).Create(mapper.ToEntityTaxFee(taxFee)).Error; err != nil {
+		slog.Error("[ADAPTER]", "message", "error while creating tax or fee", "tax_fee_id", taxFee.ID, "error", err.Error())
+		return err
+	}
+
+	return nil
+}
+
+func (r *taxFeeRepository) Update(ctx context.Context, taxFee *domain.TaxFee) error {
+	gormTaxFee := mapper.ToEntityTaxFee(taxFee)
+
+	result := conn(ctx, r.db).Model(&entity.TaxFee{}).Where("tax_fee_id = ?", taxFee.ID).Updates(map[string]any{
+		"name":         gormTaxFee.Name,
+		"kind":         gormTaxFee.Kind,
+		"calculation":  gormTaxFee.Calculation,
+		"percent":      gormTaxFee.Percent,
+		"amount_minor": gormTaxFee.AmountMinor,
+		"basis":        gormTaxFee.Basis,
+		"currency":     gormTaxFee.Currency,
+		"inclusive":    gormTaxFee.Inclusive,
+		"compound":     gormTaxFee.Compound,
+		"sequence":     gormTaxFee.Sequence,
+	})
+	if result.Error != nil {
+		slog.Error("[ADAPTER]", "message", "error while updating tax or fee", "tax_fee_id", taxFee.ID, "error", result.Error.Error())
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		slog.Error("[ADAPTER]", "message", "tax or fee not found while updating", "tax_fee_id", taxFee.ID)
+		return gorm.ErrRecordNotFound
+	}
+
+	return nil
+}
+
+func (r *taxFeeRepository) Deactivate(ctx context.Context, taxFeeID string) error {
+	result := conn(ctx, r.db).Model(&entity.TaxFee{}).Where("tax_fee_id = ? AND is_active = ?", taxFeeID, true).Update("is_active", false)
+	if result.Error != nil {
+		slog.Error("[ADAPTER]", "message", "error while deactivating tax or fee", "tax_fee_id", taxFeeID, "error", result.Error.Error())
+		return result.Error
+	}
+
+	if result.RowsAffected == 0 {
+		slog.Error("[ADAPTER]", "message", "tax or fee not found while deactivating", "tax_fee_id", taxFeeID)
+		return gorm.ErrRecordNotFound
+	}
+
+	return nil
+}
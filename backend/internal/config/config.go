@@ -2,6 +2,7 @@ package config
 
 import (
 	"log/slog"
+	"time"
 
 	"github.com/spf13/viper"
 )
@@ -17,12 +18,20 @@ type Config struct {
 		Migration bool
 		Seeding   bool
 	}
+	// Quote signs the quotes of /price with Secret, a signed quote is valid for TTL. The secret
+	// can also be set with QUOTE_SECRET.
+	Quote struct {
+		Secret string
+		TTL    time.Duration
+	}
 }
 
 func Load() (*Config, error) {
 	viper.SetConfigName("config")
 	viper.SetConfigType("yaml")
 	viper.AddConfigPath(".")
+	viper.SetDefault("quote.ttl", "15m")
+	viper.BindEnv("quote.secret", "QUOTE_SECRET")
 
 	if err := viper.ReadInConfig(); err != nil {
 		return nil, err
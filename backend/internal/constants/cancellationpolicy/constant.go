@@ -1,5 +1,7 @@
 package cancellationpolicy
 
+// IDs of the built-in cancellation policies. They are the values rooms stored before policies
+// were configurable, so older rooms keep referencing the same terms.
 const (
 	NonRefundable    = "NON_REFUNDABLE"
 	FreeCancellation = "FREE_CANCELLATION"
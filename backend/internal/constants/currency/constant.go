@@ -0,0 +1,26 @@
+package currency
+
+// ISO 4217 codes accepted for room offers
+const (
+	THB = "THB"
+	USD = "USD"
+	EUR = "EUR"
+	JPY = "JPY"
+)
+
+func IsValid(code string) bool {
+	switch code {
+	case THB, USD, EUR, JPY:
+		return true
+	}
+	return false
+}
+
+// MinorUnits is the number of decimals amounts in the currency are kept with, e.g. 2 for the
+// satang of THB and 0 for JPY, which has no minor unit
+func MinorUnits(code string) int {
+	if code == JPY {
+		return 0
+	}
+	return 2
+}
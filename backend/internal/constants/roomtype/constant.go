@@ -0,0 +1,31 @@
+package roomtype
+
+const (
+	Standard = "standard"
+	Deluxe   = "deluxe"
+	Suite    = "suite"
+	Family   = "family"
+)
+
+func IsValid(roomType string) bool {
+	switch roomType {
+	case Standard, Deluxe, Suite, Family:
+		return true
+	}
+	return false
+}
+
+// DefaultMaxOccupancy is how many guests a room of the type sleeps when the room does not say
+func DefaultMaxOccupancy(roomType string) int {
+	switch roomType {
+	case Suite:
+		return 3
+	case Family:
+		return 4
+	}
+	return 2
+}
+
+// DefaultBaseOccupancy is how many guests the base price of a room includes when the room does
+// not say
+const DefaultBaseOccupancy = 2
@@ -0,0 +1,18 @@
+package domain
+
+import "time"
+
+// liveAt reports whether an active row with the given activation window is visible at t.
+// The window includes activeFrom and excludes activeUntil, a nil bound is open.
+func liveAt(isActive bool, activeFrom, activeUntil *time.Time, t time.Time) bool {
+	if !isActive {
+		return false
+	}
+	if activeFrom != nil && t.Before(*activeFrom) {
+		return false
+	}
+	if activeUntil != nil && !t.Before(*activeUntil) {
+		return false
+	}
+	return true
+}
@@ -0,0 +1,43 @@
+package domain
+
+import (
+	"context"
+	"time"
+)
+
+// AuditEntry records one change to a catalog row: who made it, when, and which fields moved.
+type AuditEntry struct {
+	ID       string
+	Entity   string
+	EntityID string
+	Action   string
+	Actor    string
+	Fields   []FieldChange
+	At       time.Time
+}
+
+type AuditFilter struct {
+	Entity   string
+	EntityID string
+	Actor    string
+	// Field keeps only entries that changed this field
+	Field string
+	Limit int
+}
+
+// UnknownActor is recorded when a change does not say who made it
+const UnknownActor = "unknown"
+
+type actorKey struct{}
+
+// WithActor returns a context recording actor as the author of catalog changes made with it
+func WithActor(ctx context.Context, actor string) context.Context {
+	return context.WithValue(ctx, actorKey{}, actor)
+}
+
+func ActorFrom(ctx context.Context) string {
+	if actor, ok := ctx.Value(actorKey{}).(string); ok && actor != "" {
+		return actor
+	}
+	return UnknownActor
+}
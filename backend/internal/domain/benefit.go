@@ -1,9 +1,13 @@
 package domain
 
+import "time"
+
 type Benefit struct {
 	ID             string
 	PhysicalRoomID string
 	Name           string
 	Description    string
 	IsActive       bool
+	ActiveFrom     *time.Time
+	ActiveUntil    *time.Time
 }
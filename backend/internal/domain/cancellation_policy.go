@@ -0,0 +1,53 @@
+package domain
+
+import "sort"
+
+// CancellationPolicy describes what cancelling a booking costs and what a room using it
+// charges for that flexibility. Policies are shared by every hotel.
+type CancellationPolicy struct {
+	ID          string
+	Name        string
+	Description string
+	// FreeCancellationHours is how many hours before arrival a booking can still be cancelled
+	// for free, nil when it never can
+	FreeCancellationHours *int
+	// PenaltyTiers are the penalties after the free cancellation deadline
+	PenaltyTiers []CancellationTier `diff:"value"`
+	// SurchargePercent is added to the price of a stay, e.g. 20 for 20%
+	SurchargePercent float64
+	IsActive         bool
+}
+
+// CancellationTier charges PenaltyPercent of the stay for cancelling less than
+// HoursBeforeArrival hours before arrival. Tiers are recorded as is in the audit log, hence the
+// JSON names.
+type CancellationTier struct {
+	HoursBeforeArrival int     `json:"hoursBeforeArrival"`
+	PenaltyPercent     float64 `json:"penaltyPercent"`
+}
+
+// Surcharge returns what the policy adds to the price of a stay
+func (p *CancellationPolicy) Surcharge(price Money) Money {
+	return price.Percent(p.SurchargePercent)
+}
+
+// PenaltyPercent returns the share of the stay charged for cancelling hoursBeforeArrival hours
+// before arrival. Cancelling before the free cancellation deadline costs nothing, after it the
+// tier closest to arrival that has been reached applies, and without one the whole stay is
+// charged.
+func (p *CancellationPolicy) PenaltyPercent(hoursBeforeArrival float64) float64 {
+	if p.FreeCancellationHours != nil && hoursBeforeArrival >= float64(*p.FreeCancellationHours) {
+		return 0
+	}
+
+	tiers := make([]CancellationTier, len(p.PenaltyTiers))
+	copy(tiers, p.PenaltyTiers)
+	sort.Slice(tiers, func(i, j int) bool { return tiers[i].HoursBeforeArrival < tiers[j].HoursBeforeArrival })
+
+	for _, tier := range tiers {
+		if hoursBeforeArrival < float64(tier.HoursBeforeArrival) {
+			return tier.PenaltyPercent
+		}
+	}
+	return 100
+}
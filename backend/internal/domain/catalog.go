@@ -0,0 +1,47 @@
+package domain
+
+// Catalog entity names used when reporting changes
+const (
+	EntityFacilityCatalog    = "facility_catalog"
+	EntityHotel              = "hotel"
+	EntityFacility           = "facility"
+	EntityPhysicalRoom       = "physical_room"
+	EntityRoom               = "room"
+	EntityBenefit            = "benefit"
+	EntityRoomRate           = "room_rate"
+	EntityPricingRule        = "pricing_rule"
+	EntityCancellationPolicy = "cancellation_policy"
+	EntityTaxFee             = "tax_fee"
+	EntityExchangeRate       = "exchange_rate"
+	EntityPromotion          = "promotion"
+	EntityStayRule           = "stay_rule"
+	EntityRatePlan           = "rate_plan"
+)
+
+// Catalog change actions
+const (
+	ActionCreate     = "create"
+	ActionUpdate     = "update"
+	ActionDeactivate = "deactivate"
+	ActionDelete     = "delete"
+)
+
+// CatalogRecords is a flat set of catalog rows, active or not. Child rows carry the IDs of
+// their parents (HotelID, PhysicalRoomID) so the set can be written without nesting.
+type CatalogRecords struct {
+	FacilityCatalog      []FacilityCatalogEntry
+	CancellationPolicies []CancellationPolicy
+	Hotels               []Hotel
+	Facilities           []Facility
+	PhysicalRooms        []PhysicalRoom
+	Rooms                []Room
+	Benefits             []Benefit
+}
+
+// CatalogChange describes what writing one catalog row does to the stored catalog.
+type CatalogChange struct {
+	Entity string
+	ID     string
+	Action string
+	Fields []FieldChange
+}
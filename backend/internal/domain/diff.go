@@ -0,0 +1,93 @@
+package domain
+
+import (
+	"reflect"
+	"strings"
+	"time"
+	"unicode"
+)
+
+type FieldChange struct {
+	Field  string
+	Before any
+	After  any
+}
+
+// DiffFields compares two values of the same struct type field by field and returns the
+// fields that differ. Slices of structs and maps are skipped, nested collections are compared
+// as entities of their own, unless the field is tagged `diff:"value"` because its elements
+// are plain values of the row. Fields tagged `diff:"-"` are bookkeeping and never reported.
+func DiffFields(before, after any) []FieldChange {
+	bv, av := indirect(reflect.ValueOf(before)), indirect(reflect.ValueOf(after))
+	if bv.Kind() != reflect.Struct || av.Kind() != reflect.Struct || bv.Type() != av.Type() {
+		return nil
+	}
+
+	var changes []FieldChange
+	for i := 0; i < bv.NumField(); i++ {
+		field := bv.Type().Field(i)
+		tag := field.Tag.Get("diff")
+		if !field.IsExported() || tag == "-" || tag != "value" && isCollection(field.Type) {
+			continue
+		}
+
+		b, a := bv.Field(i), av.Field(i)
+		if equalValues(b, a) {
+			continue
+		}
+
+		changes = append(changes, FieldChange{
+			Field:  fieldName(field.Name),
+			Before: valueOf(b),
+			After:  valueOf(a),
+		})
+	}
+	return changes
+}
+
+// isCollection reports whether a field holds nested entities rather than a plain value
+func isCollection(t reflect.Type) bool {
+	return t.Kind() == reflect.Map || t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Struct
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// equalValues compares field values, times are equal when they are the same instant
+func equalValues(a, b reflect.Value) bool {
+	if a.Kind() == reflect.Pointer {
+		if a.IsNil() || b.IsNil() {
+			return a.IsNil() == b.IsNil()
+		}
+		return equalValues(a.Elem(), b.Elem())
+	}
+	if a.Type() == timeType {
+		return a.Interface().(time.Time).Equal(b.Interface().(time.Time))
+	}
+	return reflect.DeepEqual(a.Interface(), b.Interface())
+}
+
+func indirect(v reflect.Value) reflect.Value {
+	for v.Kind() == reflect.Pointer && !v.IsNil() {
+		v = v.Elem()
+	}
+	return v
+}
+
+func valueOf(v reflect.Value) any {
+	if v.Kind() == reflect.Pointer && v.IsNil() {
+		return nil
+	}
+	return indirect(v).Interface()
+}
+
+// fieldName turns a Go field name into the lower camel case used by the API, e.g.
+// "BasePrice" becomes "basePrice" and "ID" becomes "id".
+func fieldName(name string) string {
+	if strings.ToUpper(name) == name {
+		return strings.ToLower(name)
+	}
+
+	runes := []rune(name)
+	runes[0] = unicode.ToLower(runes[0])
+	return string(runes)
+}
@@ -0,0 +1,11 @@
+package domain
+
+import "errors"
+
+// ErrPreconditionFailed is returned when a row changed since the version the caller based
+// its update on.
+var ErrPreconditionFailed = errors.New("precondition failed")
+
+// ErrNotFound is returned when a row does not exist, is inactive or is outside its
+// activation window.
+var ErrNotFound = errors.New("not found")
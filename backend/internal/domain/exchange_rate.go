@@ -0,0 +1,46 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrNoExchangeRate is returned when no exchange rate of a currency pair is effective yet
+var ErrNoExchangeRate = errors.New("no exchange rate")
+
+// ExchangeRate converts From into To, one unit of From buys Rate units of To. A rate applies
+// from EffectiveDate until a later rate of the same pair takes over.
+type ExchangeRate struct {
+	From          string
+	To            string
+	EffectiveDate string
+	Rate          float64
+}
+
+// Conversion is the total of a quote in another currency than the room's
+type Conversion struct {
+	Rate  ExchangeRate
+	Total Money
+}
+
+// Convert returns the amount in the currency the rate converts to, rounded to its minor unit.
+// Converting an amount of another currency than the rate's is a programming error and panics.
+func (m Money) Convert(rate ExchangeRate) Money {
+	if m.Currency != rate.From {
+		panic(fmt.Sprintf("domain: converting %s with a rate from %s", m.Currency, rate.From))
+	}
+
+	factor := decimal(rate.Rate)
+	factor.Mul(factor, minorUnitScale(rate.To))
+	factor.Quo(factor, minorUnitScale(rate.From))
+
+	converted := m.mul(factor)
+	converted.Currency = rate.To
+	return converted
+}
+
+// ConvertTo adds the total converted at the given rate to the quote, the quote itself stays in
+// the room currency
+func (q *Quote) ConvertTo(rate ExchangeRate) {
+	q.Conversion = &Conversion{Rate: rate, Total: q.Total().Convert(rate)}
+}
@@ -1,9 +1,22 @@
 package domain
 
+import "time"
+
+// FacilityCatalogEntry is a master facility definition shared by every hotel.
+type FacilityCatalogEntry struct {
+	Code        string
+	Name        string
+	Description string
+	IsActive    bool
+}
+
 type Facility struct {
 	ID          string
 	HotelID     string
+	Code        string
 	Name        string
 	Description string
 	IsActive    bool
+	ActiveFrom  *time.Time
+	ActiveUntil *time.Time
 }
@@ -1,9 +1,25 @@
 package domain
 
+import "time"
+
 type Hotel struct {
 	ID       string
 	Name     string
 	Address  string
 	IsActive bool
-	Facility []Facility
+	// ActiveFrom and ActiveUntil optionally limit when an active hotel is visible
+	ActiveFrom  *time.Time
+	ActiveUntil *time.Time
+	Facility    []Facility
+	// UpdatedAt is the stored row version, updates carrying it only apply to that version
+	UpdatedAt int64 `diff:"-"`
+}
+
+func (h *Hotel) IsLiveAt(t time.Time) bool {
+	return liveAt(h.IsActive, h.ActiveFrom, h.ActiveUntil, t)
+}
+
+type HotelFilter struct {
+	// FacilityCodes keeps only hotels offering every listed facility
+	FacilityCodes []string
 }
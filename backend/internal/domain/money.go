@@ -0,0 +1,159 @@
+package domain
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+
+	"github.com/chayutK/hotel-property-service/internal/constants/currency"
+)
+
+// Money is an exact amount in the minor unit of its ISO 4217 currency, e.g. satang for THB or
+// yen for JPY. Calculations producing a fraction of the minor unit round half away from zero.
+// The zero Money has no currency and adds to an amount of any currency.
+type Money struct {
+	Amount   int64
+	Currency string
+}
+
+// ErrCurrencyMismatch is returned when a price, rate, tax or fee is in another currency than the
+// room it applies to
+var ErrCurrencyMismatch = errors.New("currency mismatch")
+
+// MaxAmount is the largest amount in major units a price, charge or fee is given with. It keeps
+// the totals of a stay far from the range of Money.
+const MaxAmount = 1_000_000_000
+
+// IsExactAmount reports whether an amount in major units is at most MaxAmount either way and has
+// no more decimals than the currency, so NewMoney takes it as it is, e.g. 1500 JPY but not 1500.5.
+func IsExactAmount(amount float64, code string) bool {
+	if math.IsNaN(amount) || math.Abs(amount) > MaxAmount {
+		return false
+	}
+	minor := decimal(amount)
+	return minor.Mul(minor, minorUnitScale(code)).IsInt()
+}
+
+// NewMoney converts an amount in major units, e.g. 1234.5 THB, to Money. The amount is taken
+// as the shortest decimal that reads back as it, so 0.1 is exactly a tenth, and rounded to the
+// minor unit of the currency. Amounts given by callers are checked with IsExactAmount first.
+func NewMoney(amount float64, code string) Money {
+	minor := decimal(amount)
+	minor.Mul(minor, minorUnitScale(code))
+	return Money{Amount: round(minor), Currency: code}
+}
+
+// Zero returns no money in the currency
+func Zero(code string) Money {
+	return Money{Currency: code}
+}
+
+func (m Money) IsZero() bool {
+	return m.Amount == 0
+}
+
+// Add returns the sum of two amounts of the same currency. Adding another currency is a
+// programming error and panics, amounts have to be converted first.
+func (m Money) Add(other Money) Money {
+	switch {
+	case other.Currency == "" && other.Amount == 0:
+		return m
+	case m.Currency == "" && m.Amount == 0:
+		return other
+	case m.Currency != other.Currency:
+		panic(fmt.Sprintf("domain: adding %s to %s", other.Currency, m.Currency))
+	}
+	return Money{Amount: m.Amount + other.Amount, Currency: m.Currency}
+}
+
+func (m Money) Sub(other Money) Money {
+	return m.Add(Money{Amount: -other.Amount, Currency: other.Currency})
+}
+
+// Times returns the amount n times over, e.g. a per night fee for every night
+func (m Money) Times(n int) Money {
+	return Money{Amount: m.Amount * int64(n), Currency: m.Currency}
+}
+
+// Mul returns the amount multiplied by factor and rounded to the minor unit
+func (m Money) Mul(factor float64) Money {
+	return m.mul(decimal(factor))
+}
+
+// Percent returns percent of the amount rounded to the minor unit, e.g. 7 for 7% VAT
+func (m Money) Percent(percent float64) Money {
+	return m.mul(percentOf(percent))
+}
+
+// Float64 returns the amount in major units. Use it only to hand amounts to callers that
+// expect plain numbers, it is not exact.
+func (m Money) Float64() float64 {
+	f, _ := new(big.Rat).SetFrac(big.NewInt(m.Amount), minorUnitScale(m.Currency).Num()).Float64()
+	return f
+}
+
+// String writes the amount in major units with every decimal of the currency, e.g. "1234.50"
+func (m Money) String() string {
+	units := currency.MinorUnits(m.Currency)
+	if units == 0 {
+		return strconv.FormatInt(m.Amount, 10)
+	}
+
+	sign, amount := "", m.Amount
+	if amount < 0 {
+		sign, amount = "-", -amount
+	}
+	scale := minorUnitScale(m.Currency).Num().Int64()
+	return fmt.Sprintf("%s%d.%0*d", sign, amount/scale, units, amount%scale)
+}
+
+// MarshalJSON writes the amount the way the API does, it is how amounts end up in the audit log
+func (m Money) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Amount   json.Number `json:"amount"`
+		Currency string      `json:"currency"`
+	}{json.Number(m.String()), m.Currency})
+}
+
+func (m Money) mul(factor *big.Rat) Money {
+	product := new(big.Rat).SetInt64(m.Amount)
+	product.Mul(product, factor)
+	return Money{Amount: round(product), Currency: m.Currency}
+}
+
+// decimal reads a float as the shortest decimal that formats as it, multiplying that exactly
+// avoids the binary representation of e.g. 1.15 being slightly less than 1.15
+func decimal(f float64) *big.Rat {
+	r, ok := new(big.Rat).SetString(strconv.FormatFloat(f, 'g', -1, 64))
+	if !ok {
+		return new(big.Rat)
+	}
+	return r
+}
+
+func percentOf(percent float64) *big.Rat {
+	r := decimal(percent)
+	return r.Quo(r, big.NewRat(100, 1))
+}
+
+// minorUnitScale is how many minor units make up one major unit of the currency
+func minorUnitScale(code string) *big.Rat {
+	scale := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(currency.MinorUnits(code))), nil)
+	return new(big.Rat).SetInt(scale)
+}
+
+// round rounds to a whole number of minor units, halves away from zero
+func round(r *big.Rat) int64 {
+	num := new(big.Int).Abs(r.Num())
+	quo, rem := new(big.Int).QuoRem(num, r.Denom(), new(big.Int))
+	if rem.Lsh(rem, 1).Cmp(r.Denom()) >= 0 {
+		quo.Add(quo, big.NewInt(1))
+	}
+	if r.Sign() < 0 {
+		quo.Neg(quo)
+	}
+	return quo.Int64()
+}
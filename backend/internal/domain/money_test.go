@@ -0,0 +1,119 @@
+package domain
+
+import (
+	"math"
+	"testing"
+
+	"github.com/chayutK/hotel-property-service/internal/constants/currency"
+)
+
+func TestNewMoney(t *testing.T) {
+	tests := []struct {
+		name   string
+		amount float64
+		code   string
+		want   int64
+	}{
+		{"THB in satang", 1234.5, currency.THB, 123450},
+		{"a tenth is exact", 0.1, currency.USD, 10},
+		{"binary fraction below the decimal", 1.15, currency.USD, 115},
+		{"JPY has no minor unit", 1500, currency.JPY, 1500},
+		{"JPY half rounds away from zero", 1500.5, currency.JPY, 1501},
+		{"negative half rounds away from zero", -0.125, currency.USD, -13},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewMoney(tt.amount, tt.code)
+			if got.Amount != tt.want || got.Currency != tt.code {
+				t.Errorf("NewMoney(%v, %s) = %d %s, want %d %s", tt.amount, tt.code, got.Amount, got.Currency, tt.want, tt.code)
+			}
+		})
+	}
+}
+
+func TestIsExactAmount(t *testing.T) {
+	tests := []struct {
+		name   string
+		amount float64
+		code   string
+		want   bool
+	}{
+		{"whole yen", 1500, currency.JPY, true},
+		{"fraction of a yen", 1500.5, currency.JPY, false},
+		{"satang", 100.12, currency.THB, true},
+		{"fraction of a satang", 100.125, currency.THB, false},
+		{"a tenth", 0.1, currency.USD, true},
+		{"float sum off by a binary fraction", 0.30000000000000004, currency.USD, false},
+		{"negative", -5.05, currency.USD, true},
+		{"the maximum", MaxAmount, currency.THB, true},
+		{"above the maximum", MaxAmount + 0.01, currency.THB, false},
+		{"below the negative maximum", -MaxAmount - 1, currency.JPY, false},
+		{"not a number", math.NaN(), currency.USD, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsExactAmount(tt.amount, tt.code); got != tt.want {
+				t.Errorf("IsExactAmount(%v, %s) = %v, want %v", tt.amount, tt.code, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMoneySub(t *testing.T) {
+	tests := []struct {
+		name   string
+		m      Money
+		other  Money
+		factor float64
+		want   string
+	}{
+		{"positive difference", NewMoney(15.05, currency.USD), NewMoney(10, currency.USD), 1, "5.05"},
+		{"negative difference", NewMoney(10, currency.USD), NewMoney(15.05, currency.USD), 1, "-5.05"},
+		{"negative half cent rounds away from zero", NewMoney(10, currency.USD), NewMoney(15.05, currency.USD), 0.5, "-2.53"},
+		{"negative below one unit", NewMoney(10, currency.THB), NewMoney(10.05, currency.THB), 1, "-0.05"},
+		{"negative half yen rounds away from zero", NewMoney(1000, currency.JPY), NewMoney(1005, currency.JPY), 0.3, "-2"},
+		{"negative exact half yen", NewMoney(1000, currency.JPY), NewMoney(1005, currency.JPY), 0.5, "-3"},
+		{"from zero money", Money{}, NewMoney(12.34, currency.EUR), 1, "-12.34"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.m.Sub(tt.other).Mul(tt.factor)
+			if got.String() != tt.want {
+				t.Errorf("(%s - %s) * %v = %s, want %s", tt.m, tt.other, tt.factor, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMoneyAddOtherCurrencyPanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("adding USD to THB did not panic")
+		}
+	}()
+	NewMoney(1, currency.THB).Add(NewMoney(1, currency.USD))
+}
+
+func TestMoneyString(t *testing.T) {
+	tests := []struct {
+		name string
+		m    Money
+		want string
+	}{
+		{"all decimals of THB", NewMoney(5000, currency.THB), "5000.00"},
+		{"no decimals for JPY", NewMoney(5000, currency.JPY), "5000"},
+		{"negative JPY", NewMoney(-1501, currency.JPY), "-1501"},
+		{"leading zero decimal", Money{Amount: 105, Currency: currency.USD}, "1.05"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.m.String(); got != tt.want {
+				t.Errorf("String() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,84 @@
+package domain
+
+import (
+	"fmt"
+	"sort"
+)
+
+// StayOverCapacity is the reason a stay with more guests than the room sleeps is refused
+const StayOverCapacity = "max_occupancy"
+
+// ChildCharge is what a child up to MaxAge, and older than the band before, costs a night when
+// the child does not fit in the base occupancy. Bands are recorded as is in the audit log, hence
+// the JSON names.
+type ChildCharge struct {
+	MaxAge int   `json:"maxAge"`
+	Amount Money `json:"amount"`
+}
+
+// CheckOccupancy returns the violation of a stay with more guests than the room sleeps, nil
+// when everybody fits
+func (r *Room) CheckOccupancy(stay Stay) *StayViolation {
+	if stay.Guests() <= r.MaxOccupancy {
+		return nil
+	}
+	return &StayViolation{
+		Reason:  StayOverCapacity,
+		Message: fmt.Sprintf("the room sleeps at most %d guests", r.MaxOccupancy),
+	}
+}
+
+// occupancyCharges lists what the guests beyond the base occupancy cost for the stay. Adults take
+// the places included in the base price first, then the oldest children. Every further adult
+// costs the extra adult charge and every further child the charge of their age band, a child
+// older than every band is charged as an adult.
+func (r *Room) occupancyCharges(stay Stay) []QuoteLine {
+	nights := len(stay.Nights())
+	included := r.BaseOccupancy
+
+	var lines []QuoteLine
+	add := func(name string, perNight Money) {
+		if !perNight.IsZero() {
+			lines = append(lines, QuoteLine{Name: name, Amount: perNight.Times(nights)})
+		}
+	}
+
+	for i := 0; i < stay.Adults; i++ {
+		if included > 0 {
+			included--
+			continue
+		}
+		add("Extra adult", r.ExtraAdultCharge)
+	}
+
+	ages := make([]int, len(stay.ChildAges))
+	copy(ages, stay.ChildAges)
+	sort.Sort(sort.Reverse(sort.IntSlice(ages)))
+
+	for _, age := range ages {
+		if included > 0 {
+			included--
+			continue
+		}
+		if band, ok := r.childBand(age); ok {
+			add(fmt.Sprintf("Child, age %d", age), band.Amount)
+		} else {
+			add(fmt.Sprintf("Child, age %d, adult rate", age), r.ExtraAdultCharge)
+		}
+	}
+
+	return lines
+}
+
+func (r *Room) childBand(age int) (ChildCharge, bool) {
+	bands := make([]ChildCharge, len(r.ChildCharges))
+	copy(bands, r.ChildCharges)
+	sort.Slice(bands, func(i, j int) bool { return bands[i].MaxAge < bands[j].MaxAge })
+
+	for _, band := range bands {
+		if age <= band.MaxAge {
+			return band, true
+		}
+	}
+	return ChildCharge{}, false
+}
@@ -0,0 +1,18 @@
+package domain
+
+// PhysicalRoom is a real room type of a hotel. Several sellable offers (rate variants such as
+// refundable and non-refundable) can share one physical room and inherit its benefits.
+type PhysicalRoom struct {
+	ID          string
+	HotelID     string
+	Name        string
+	Description string
+	Type        string
+	SizeSqm     int
+	BedType     string
+	BedCount    int
+	UnitCount   int
+	Benefit     []Benefit
+	Offers      []Room
+	IsActive    bool
+}
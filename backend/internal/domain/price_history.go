@@ -0,0 +1,54 @@
+package domain
+
+import "time"
+
+// PricePoint is a price of a room that took effect at EffectiveAt, its base price or, with Source
+// rate_calendar, the rate of the night Date. A rate removed from the calendar has no Price, the
+// night went back to the base price.
+type PricePoint struct {
+	ID          string
+	RoomID      string
+	Source      string
+	Date        string
+	Price       *Money
+	EffectiveAt time.Time
+	Actor       string
+}
+
+// PriceHistoryFilter selects the price points of a room that took effect from From, inclusive,
+// up to To, exclusive. Source keeps one kind of price and Night the base prices and the rates of
+// that night.
+type PriceHistoryFilter struct {
+	RoomID string
+	Source string
+	Night  string
+	From   *time.Time
+	To     *time.Time
+}
+
+// NightPriceAt returns the point that set the price of night as it was at t, before pricing
+// rules: the rate of the night in effect then, or else the base price. history is every point of
+// the room in EffectiveAt order, nil is returned when the room had no price yet at t.
+func NightPriceAt(history []PricePoint, night string, t time.Time) *PricePoint {
+	var basePrice, rate *PricePoint
+	for i := range history {
+		point := &history[i]
+		if point.EffectiveAt.After(t) {
+			break
+		}
+
+		switch {
+		case point.Source == RateSourceBasePrice:
+			basePrice = point
+		case point.Date == night && point.Price != nil:
+			rate = point
+		case point.Date == night:
+			rate = nil
+		}
+	}
+
+	if rate != nil {
+		return rate
+	}
+	return basePrice
+}
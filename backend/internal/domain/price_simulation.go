@@ -0,0 +1,118 @@
+package domain
+
+import (
+	"errors"
+	"maps"
+	"math"
+	"slices"
+	"time"
+)
+
+// MaxSimulationNights is how many nights one price simulation covers at most
+const MaxSimulationNights = 366
+
+// ErrInvalidDraft is returned for a draft pricing rooms of another hotel or in another currency
+// than their own, or referencing pricing rules of another hotel
+var ErrInvalidDraft = errors.New("invalid price draft")
+
+// PriceDraft is a change to the prices of a hotel that is previewed before it is published.
+// Rules are added to the pricing rules of the hotel, a rule with the ID of a stored rule replaces
+// it, and the stored rules of RemovedRuleIDs are left out. BasePrices holds new base prices by
+// room ID and Rates new rate calendar prices, both in the currency of their room.
+type PriceDraft struct {
+	Rules          []PricingRule
+	RemovedRuleIDs []string
+	BasePrices     map[string]Money
+	Rates          []RoomRate
+}
+
+// SimulatedNight is the price of a night as it is and as it would be with a draft
+type SimulatedNight struct {
+	Current   NightlyPrice
+	Simulated NightlyPrice
+}
+
+// Change is what the draft adds to the price of the night, negative when it lowers it
+func (n SimulatedNight) Change() Money {
+	return n.Simulated.Rate.Sub(n.Current.Rate)
+}
+
+// ChangePercent is Change as a percentage of the current price, rounded to two decimals
+func (n SimulatedNight) ChangePercent() float64 {
+	if n.Current.Rate.Amount == 0 {
+		return 0
+	}
+	return math.Round(float64(n.Change().Amount)*10000/float64(n.Current.Rate.Amount)) / 100
+}
+
+// SimulatedRoom is one row of a price simulation, the nights of the room in date order
+type SimulatedRoom struct {
+	Room   Room
+	Nights []SimulatedNight
+}
+
+// CurrentTotal sums the current prices of the nights
+func (r SimulatedRoom) CurrentTotal() Money {
+	total := Zero(r.Room.BasePrice.Currency)
+	for _, night := range r.Nights {
+		total = total.Add(night.Current.Rate)
+	}
+	return total
+}
+
+// SimulatedTotal sums the simulated prices of the nights
+func (r SimulatedRoom) SimulatedTotal() Money {
+	total := Zero(r.Room.BasePrice.Currency)
+	for _, night := range r.Nights {
+		total = total.Add(night.Simulated.Rate)
+	}
+	return total
+}
+
+// PricingRules returns the pricing rules of the hotel as they would be with the draft
+func (d *PriceDraft) PricingRules(rules []PricingRule) []PricingRule {
+	var drafted []PricingRule
+	for _, rule := range rules {
+		replaced := slices.ContainsFunc(d.Rules, func(draft PricingRule) bool { return draft.ID == rule.ID })
+		if !replaced && !slices.Contains(d.RemovedRuleIDs, rule.ID) {
+			drafted = append(drafted, rule)
+		}
+	}
+	return append(drafted, d.Rules...)
+}
+
+// Simulate prices every night of nights in every room twice, at the current prices and at the
+// prices of the draft. rates holds the rate calendar of each room by room ID and rules the pricing
+// rules of the hotel. Prices are the room's own nightly prices, before rate plans, occupancy
+// charges, discounts, taxes and fees.
+func (d *PriceDraft) Simulate(rooms []Room, nights []time.Time, rates map[string]map[string]Money, rules []PricingRule) []SimulatedRoom {
+	draftRules := d.PricingRules(rules)
+
+	draftRates := make(map[string]map[string]Money, len(rates))
+	for roomID, roomRates := range rates {
+		draftRates[roomID] = maps.Clone(roomRates)
+	}
+	for _, rate := range d.Rates {
+		if draftRates[rate.RoomID] == nil {
+			draftRates[rate.RoomID] = map[string]Money{}
+		}
+		draftRates[rate.RoomID][rate.Date] = rate.Price
+	}
+
+	simulated := make([]SimulatedRoom, len(rooms))
+	for i := range rooms {
+		current, draft := rooms[i], rooms[i]
+		if basePrice, ok := d.BasePrices[current.ID]; ok {
+			draft.BasePrice = basePrice
+		}
+
+		simulated[i].Room = current
+		for _, night := range nights {
+			simulated[i].Nights = append(simulated[i].Nights, SimulatedNight{
+				Current:   current.PriceNight(night, rates[current.ID], rules),
+				Simulated: draft.PriceNight(night, draftRates[current.ID], draftRules),
+			})
+		}
+	}
+	return simulated
+}
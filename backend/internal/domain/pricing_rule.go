@@ -0,0 +1,129 @@
+package domain
+
+import (
+	"math/big"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Pricing rule scopes, a narrower scope takes precedence over a wider one
+const (
+	ScopeHotel    = "hotel"
+	ScopeRoomType = "room_type"
+	ScopeRoom     = "room"
+)
+
+// PricingRule multiplies the price of the nights it matches. A rule always belongs to a hotel
+// and can be narrowed to a room type or a single room. Without DateFrom, DateUntil or Weekdays
+// it matches every night.
+//
+// For each night the matching non-stackable rule with the highest precedence applies, and every
+// matching stackable rule is applied on top of it. Precedence is the narrowest scope first,
+// then the highest priority.
+type PricingRule struct {
+	ID       string
+	HotelID  string
+	RoomType string
+	RoomID   string
+	Name     string
+	// DateFrom and DateUntil bound a season or holiday, DateUntil is exclusive
+	DateFrom  string
+	DateUntil string
+	// Weekdays holds three letter codes such as "SAT", empty means every day of the week
+	Weekdays   []string
+	Multiplier float64
+	Priority   int
+	Stackable  bool
+	IsActive   bool
+}
+
+// Scope is how narrow the rule is
+func (r *PricingRule) Scope() string {
+	switch {
+	case r.RoomID != "":
+		return ScopeRoom
+	case r.RoomType != "":
+		return ScopeRoomType
+	default:
+		return ScopeHotel
+	}
+}
+
+// Matches reports whether the rule adjusts the price of room for the night of date
+func (r *PricingRule) Matches(room *Room, date time.Time) bool {
+	if !r.IsActive || r.HotelID != room.HotelID {
+		return false
+	}
+	if r.RoomID != "" && r.RoomID != room.ID {
+		return false
+	}
+	if r.RoomType != "" && r.RoomType != room.Type {
+		return false
+	}
+
+	night := date.Format(DateLayout)
+	if r.DateFrom != "" && night < r.DateFrom {
+		return false
+	}
+	if r.DateUntil != "" && night >= r.DateUntil {
+		return false
+	}
+
+	return len(r.Weekdays) == 0 || slices.Contains(r.Weekdays, WeekdayCode(date.Weekday()))
+}
+
+// WeekdayCode returns the code pricing rules use for a day of the week, e.g. "SAT"
+func WeekdayCode(day time.Weekday) string {
+	return strings.ToUpper(day.String()[:3])
+}
+
+// ApplyPricingRules adjusts the price of one night by the rules that match it and returns the
+// rules that were applied, in the order they were. The multipliers are combined before the
+// price is rounded once.
+func ApplyPricingRules(price Money, room *Room, date time.Time, rules []PricingRule) (Money, []PricingRule) {
+	var matching []PricingRule
+	for _, rule := range rules {
+		if rule.Matches(room, date) {
+			matching = append(matching, rule)
+		}
+	}
+
+	sort.SliceStable(matching, func(i, j int) bool {
+		si, sj := scopeRank(matching[i].Scope()), scopeRank(matching[j].Scope())
+		if si != sj {
+			return si > sj
+		}
+		return matching[i].Priority > matching[j].Priority
+	})
+
+	var (
+		applied   []PricingRule
+		exclusive bool
+		factor    = big.NewRat(1, 1)
+	)
+	for _, rule := range matching {
+		if !rule.Stackable {
+			if exclusive {
+				continue
+			}
+			exclusive = true
+		}
+		factor.Mul(factor, decimal(rule.Multiplier))
+		applied = append(applied, rule)
+	}
+
+	return price.mul(factor), applied
+}
+
+func scopeRank(scope string) int {
+	switch scope {
+	case ScopeRoom:
+		return 2
+	case ScopeRoomType:
+		return 1
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,154 @@
+package domain
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/chayutK/hotel-property-service/internal/constants/currency"
+)
+
+func TestApplyPricingRules(t *testing.T) {
+	room := &Room{ID: "room-1", HotelID: "hotel-1", Type: "deluxe"}
+	rule := func(id string, multiplier float64, edit func(*PricingRule)) PricingRule {
+		r := PricingRule{ID: id, HotelID: "hotel-1", Multiplier: multiplier, IsActive: true}
+		if edit != nil {
+			edit(&r)
+		}
+		return r
+	}
+
+	season := rule("season", 1.2, func(r *PricingRule) { r.DateFrom, r.DateUntil, r.Priority = "2026-12-20", "2027-01-05", 10 })
+	deluxe := rule("deluxe", 1.1, func(r *PricingRule) { r.RoomType = "deluxe" })
+	thisRoom := rule("room", 0.9, func(r *PricingRule) { r.RoomID = "room-1" })
+	weekend := rule("weekend", 1.3, func(r *PricingRule) { r.Weekdays, r.Stackable = []string{"SAT", "SUN"}, true })
+	christmas := rule("christmas", 1.5, func(r *PricingRule) { r.DateFrom, r.DateUntil, r.Stackable = "2026-12-24", "2026-12-27", true })
+
+	tests := []struct {
+		name        string
+		price       Money
+		date        string
+		rules       []PricingRule
+		want        string
+		wantApplied []string
+	}{
+		{
+			name:  "no rule matches",
+			price: NewMoney(1000, currency.THB),
+			date:  "2026-11-10",
+			rules: []PricingRule{season, weekend},
+			want:  "1000.00",
+		},
+		{
+			name:        "room type before a hotel rule of higher priority",
+			price:       NewMoney(1000, currency.THB),
+			date:        "2026-12-22",
+			rules:       []PricingRule{season, deluxe},
+			want:        "1100.00",
+			wantApplied: []string{"deluxe"},
+		},
+		{
+			name:        "room before room type and hotel",
+			price:       NewMoney(1000, currency.THB),
+			date:        "2026-12-22",
+			rules:       []PricingRule{season, deluxe, thisRoom},
+			want:        "900.00",
+			wantApplied: []string{"room"},
+		},
+		{
+			name:  "highest priority of the same scope",
+			price: NewMoney(1000, currency.THB),
+			date:  "2026-12-22",
+			rules: []PricingRule{
+				rule("low", 1.5, func(r *PricingRule) { r.Priority = 1 }),
+				season,
+			},
+			want:        "1200.00",
+			wantApplied: []string{"season"},
+		},
+		{
+			name:        "one exclusive rule and every stackable one on top",
+			price:       NewMoney(1000, currency.THB),
+			date:        "2026-12-26",
+			rules:       []PricingRule{weekend, season, christmas, deluxe},
+			want:        "2145.00",
+			wantApplied: []string{"deluxe", "weekend", "christmas"},
+		},
+		{
+			name:        "stackable rules without an exclusive one",
+			price:       NewMoney(1000, currency.THB),
+			date:        "2026-11-14",
+			rules:       []PricingRule{weekend, christmas},
+			want:        "1300.00",
+			wantApplied: []string{"weekend"},
+		},
+		{
+			name:        "last night before DateUntil",
+			price:       NewMoney(1000, currency.THB),
+			date:        "2027-01-04",
+			rules:       []PricingRule{season},
+			want:        "1200.00",
+			wantApplied: []string{"season"},
+		},
+		{
+			name:  "DateUntil is exclusive",
+			price: NewMoney(1000, currency.THB),
+			date:  "2027-01-05",
+			rules: []PricingRule{season},
+			want:  "1000.00",
+		},
+		{
+			name:        "DateFrom is inclusive",
+			price:       NewMoney(1000, currency.THB),
+			date:        "2026-12-20",
+			rules:       []PricingRule{season},
+			want:        "1200.00",
+			wantApplied: []string{"season"},
+		},
+		{
+			name:  "inactive rules, other hotels, rooms and room types are left out",
+			price: NewMoney(1000, currency.THB),
+			date:  "2026-12-22",
+			rules: []PricingRule{
+				rule("inactive", 2, func(r *PricingRule) { r.IsActive = false }),
+				rule("other hotel", 2, func(r *PricingRule) { r.HotelID = "hotel-2" }),
+				rule("other room", 2, func(r *PricingRule) { r.RoomID = "room-2" }),
+				rule("other type", 2, func(r *PricingRule) { r.RoomType = "suite" }),
+			},
+			want: "1000.00",
+		},
+		{
+			name:  "multipliers are combined before rounding once",
+			price: NewMoney(10, currency.JPY),
+			date:  "2026-12-26",
+			rules: []PricingRule{
+				rule("first", 1.15, func(r *PricingRule) { r.Stackable = true }),
+				rule("second", 1.15, func(r *PricingRule) { r.Stackable = true }),
+			},
+			want:        "13",
+			wantApplied: []string{"first", "second"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			date, err := time.Parse(DateLayout, tt.date)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got, applied := ApplyPricingRules(tt.price, room, date, tt.rules)
+			if got.String() != tt.want || got.Currency != tt.price.Currency {
+				t.Errorf("ApplyPricingRules() = %s %s, want %s %s", got, got.Currency, tt.want, tt.price.Currency)
+			}
+
+			var appliedIDs []string
+			for _, rule := range applied {
+				appliedIDs = append(appliedIDs, rule.ID)
+			}
+			if !slices.Equal(appliedIDs, tt.wantApplied) {
+				t.Errorf("applied %v, want %v", appliedIDs, tt.wantApplied)
+			}
+		})
+	}
+}
@@ -0,0 +1,192 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+)
+
+var (
+	// ErrPromotionUsedUp is returned when redeeming a promotion that reached its usage cap
+	ErrPromotionUsedUp = errors.New("promotion used up")
+	// ErrPromotionCodeTaken is returned when another active promotion has the same code
+	ErrPromotionCodeTaken = errors.New("promotion code taken")
+)
+
+// Reasons a promo code is rejected
+const (
+	PromotionUnknownCode   = "unknown_code"
+	PromotionNotYetValid   = "not_yet_valid"
+	PromotionExpired       = "expired"
+	PromotionHotel         = "hotel_not_eligible"
+	PromotionRoom          = "room_not_eligible"
+	PromotionStayDates     = "stay_dates"
+	PromotionMinNights     = "min_nights"
+	PromotionBookingWindow = "booking_window"
+	PromotionUsedUp        = "used_up"
+	PromotionCurrency      = "currency"
+)
+
+// Promotion is a discount off the subtotal of a stay, the nights and the extra guest charges,
+// either Percent of it or a fixed Amount per stay. Guests get a promotion with a Code by entering
+// it, a campaign without one applies to every quote it is eligible for.
+//
+// A promotion can be booked inside its validity window, for stays whose nights all lie between
+// StayFrom and StayUntil, exclusive, of at least MinNights nights and booked between
+// MinDaysBeforeArrival and MaxDaysBeforeArrival days ahead. Empty HotelIDs and RoomIDs make
+// every hotel and room eligible. MaxUses caps the redemptions, 0 leaves them unlimited.
+//
+// A promotion that is not Stackable is never combined with another one.
+type Promotion struct {
+	ID          string
+	Code        string
+	Name        string
+	Calculation string
+	Percent     float64
+	Amount      Money
+	// ActiveFrom and ActiveUntil optionally limit when the promotion can be booked
+	ActiveFrom           *time.Time
+	ActiveUntil          *time.Time
+	StayFrom             string
+	StayUntil            string
+	MinNights            int
+	MinDaysBeforeArrival int
+	MaxDaysBeforeArrival *int
+	HotelIDs             []string
+	RoomIDs              []string
+	MaxUses              int
+	// Uses counts the redemptions, it is bookkeeping rather than part of the promotion
+	Uses      int `diff:"-"`
+	Stackable bool
+	IsActive  bool
+}
+
+// PromotionRejection explains why a promo code does not apply to a quote, Reason is one of the
+// Promotion reasons above
+type PromotionRejection struct {
+	Reason  string
+	Message string
+}
+
+// Check returns why the promotion does not apply to a stay in room booked at now, nil when it
+// does
+func (p *Promotion) Check(room *Room, stay Stay, now time.Time) *PromotionRejection {
+	reject := func(reason, format string, args ...any) *PromotionRejection {
+		return &PromotionRejection{Reason: reason, Message: fmt.Sprintf(format, args...)}
+	}
+
+	nights := stay.Nights()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	daysAhead := int(stay.CheckIn.Sub(today).Hours() / 24)
+
+	switch {
+	case !p.IsActive:
+		return reject(PromotionUnknownCode, "the code does not exist")
+	case p.ActiveFrom != nil && now.Before(*p.ActiveFrom):
+		return reject(PromotionNotYetValid, "the code can be used from %s", p.ActiveFrom.UTC().Format(DateLayout))
+	case p.ActiveUntil != nil && !now.Before(*p.ActiveUntil):
+		return reject(PromotionExpired, "the code expired on %s", p.ActiveUntil.UTC().Format(DateLayout))
+	case len(p.HotelIDs) > 0 && !slices.Contains(p.HotelIDs, room.HotelID):
+		return reject(PromotionHotel, "the code does not apply to this hotel")
+	case len(p.RoomIDs) > 0 && !slices.Contains(p.RoomIDs, room.ID):
+		return reject(PromotionRoom, "the code does not apply to this room")
+	case p.StayFrom != "" && stay.CheckIn.Format(DateLayout) < p.StayFrom,
+		p.StayUntil != "" && len(nights) > 0 && nights[len(nights)-1].Format(DateLayout) >= p.StayUntil:
+		return reject(PromotionStayDates, "the code only applies to nights %s", p.stayDates())
+	case len(nights) < p.MinNights:
+		return reject(PromotionMinNights, "the code applies to stays of at least %d nights", p.MinNights)
+	case daysAhead < p.MinDaysBeforeArrival:
+		return reject(PromotionBookingWindow, "the code applies to stays booked at least %d days before arrival", p.MinDaysBeforeArrival)
+	case p.MaxDaysBeforeArrival != nil && daysAhead > *p.MaxDaysBeforeArrival:
+		return reject(PromotionBookingWindow, "the code applies to stays booked at most %d days before arrival", *p.MaxDaysBeforeArrival)
+	case p.MaxUses > 0 && p.Uses >= p.MaxUses:
+		return reject(PromotionUsedUp, "the code has been used up")
+	case p.Calculation == CalculationFixed && p.Amount.Currency != room.BasePrice.Currency:
+		return reject(PromotionCurrency, "the code is for rooms sold in %s", p.Amount.Currency)
+	}
+	return nil
+}
+
+func (p *Promotion) stayDates() string {
+	switch {
+	case p.StayFrom == "":
+		return "before " + p.StayUntil
+	case p.StayUntil == "":
+		return "from " + p.StayFrom
+	default:
+		return "from " + p.StayFrom + " before " + p.StayUntil
+	}
+}
+
+// discount is what the promotion takes off the subtotal of a quote, the nights and the extra
+// guest charges
+func (p *Promotion) discount(q *Quote) Money {
+	if p.Calculation == CalculationFixed {
+		return p.Amount
+	}
+	return q.Subtotal().Percent(p.Percent)
+}
+
+// ApplyPromotions takes the discounts of the promotion with the promo code and of the eligible
+// campaigns off the quote. The code goes first, campaigns follow by the size of their discount.
+// Like pricing rules, a promotion that is not stackable is only applied on its own, and the
+// discounts, a length of stay discount included, never exceed the subtotal. Why the code was rejected is kept in PromoRejection.
+func (q *Quote) ApplyPromotions(code string, promotions []Promotion, room *Room, stay Stay, now time.Time) {
+	var (
+		candidates []Promotion
+		rejection  *PromotionRejection
+	)
+
+	if code != "" {
+		rejection = &PromotionRejection{Reason: PromotionUnknownCode, Message: "the code does not exist"}
+		for _, p := range promotions {
+			if p.Code != "" && strings.EqualFold(p.Code, code) {
+				if rejection = p.Check(room, stay, now); rejection == nil {
+					candidates = append(candidates, p)
+				}
+				break
+			}
+		}
+	}
+
+	var campaigns []Promotion
+	for _, p := range promotions {
+		if p.Code == "" && p.Check(room, stay, now) == nil {
+			campaigns = append(campaigns, p)
+		}
+	}
+	sort.SliceStable(campaigns, func(i, j int) bool {
+		return campaigns[i].discount(q).Amount > campaigns[j].discount(q).Amount
+	})
+	candidates = append(candidates, campaigns...)
+
+	remaining := q.Subtotal()
+	for _, line := range q.Discounts {
+		remaining = remaining.Sub(line.Amount)
+	}
+
+	var (
+		applied   int
+		exclusive bool
+	)
+	for _, p := range candidates {
+		if exclusive || !p.Stackable && applied > 0 {
+			continue
+		}
+		exclusive = !p.Stackable
+		applied++
+
+		amount := p.discount(q)
+		if amount.Amount > remaining.Amount {
+			amount = remaining
+		}
+		remaining = remaining.Sub(amount)
+		q.Discounts = append(q.Discounts, QuoteLine{Name: p.Name, Amount: amount, PromotionID: p.ID})
+	}
+
+	q.PromoCode = code
+	q.PromoRejection = rejection
+}
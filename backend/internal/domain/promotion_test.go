@@ -0,0 +1,203 @@
+package domain
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/chayutK/hotel-property-service/internal/constants/currency"
+)
+
+func TestApplyPromotions(t *testing.T) {
+	var (
+		room = &Room{ID: "room-1", HotelID: "hotel-1", BasePrice: NewMoney(1000, currency.THB)}
+		stay = Stay{
+			CheckIn:  time.Date(2026, 12, 10, 0, 0, 0, 0, time.UTC),
+			CheckOut: time.Date(2026, 12, 12, 0, 0, 0, 0, time.UTC),
+			Adults:   2,
+		}
+		now       = time.Date(2026, 11, 1, 10, 0, 0, 0, time.UTC)
+		yesterday = now.AddDate(0, 0, -1)
+		tomorrow  = now.AddDate(0, 0, 1)
+		thirty    = 30
+	)
+	percent := func(name string, pct float64, edit func(*Promotion)) Promotion {
+		p := Promotion{ID: name, Name: name, Calculation: CalculationPercent, Percent: pct, Stackable: true, IsActive: true}
+		if edit != nil {
+			edit(&p)
+		}
+		return p
+	}
+	fixed := func(name string, amount Money, edit func(*Promotion)) Promotion {
+		p := Promotion{ID: name, Name: name, Calculation: CalculationFixed, Amount: amount, Stackable: true, IsActive: true}
+		if edit != nil {
+			edit(&p)
+		}
+		return p
+	}
+	withCode := func(p *Promotion) { p.Code = "WELCOME10" }
+	exclusive := func(p *Promotion) { p.Stackable = false }
+
+	code := percent("WELCOME10", 10, withCode)
+	fiveOff := percent("5% campaign", 5, nil)
+	fiveHundredOff := fixed("500 THB campaign", NewMoney(500, currency.THB), nil)
+	thirtyOff := percent("30% flash sale", 30, exclusive)
+
+	tests := []struct {
+		name          string
+		code          string
+		promotions    []Promotion
+		stayDiscount  Money
+		wantDiscounts []string
+		wantRejection string
+	}{
+		{
+			name:          "code first, then campaigns by the size of their discount",
+			code:          "WELCOME10",
+			promotions:    []Promotion{fiveOff, code, fiveHundredOff},
+			wantDiscounts: []string{"WELCOME10 200.00", "500 THB campaign 500.00", "5% campaign 100.00"},
+		},
+		{
+			name:          "code regardless of case",
+			code:          "welcome10",
+			promotions:    []Promotion{code},
+			wantDiscounts: []string{"WELCOME10 200.00"},
+		},
+		{
+			name:          "campaigns only without a code",
+			promotions:    []Promotion{code, fiveOff},
+			wantDiscounts: []string{"5% campaign 100.00"},
+		},
+		{
+			name:          "exclusive campaign on its own",
+			promotions:    []Promotion{fiveOff, thirtyOff},
+			wantDiscounts: []string{"30% flash sale 600.00"},
+		},
+		{
+			name:          "exclusive campaign after a stackable code is left out",
+			code:          "WELCOME10",
+			promotions:    []Promotion{thirtyOff, fiveOff, code},
+			wantDiscounts: []string{"WELCOME10 200.00", "5% campaign 100.00"},
+		},
+		{
+			name:          "exclusive code shuts out the campaigns",
+			code:          "WELCOME10",
+			promotions:    []Promotion{percent("WELCOME10", 10, func(p *Promotion) { withCode(p); exclusive(p) }), fiveHundredOff, fiveOff},
+			wantDiscounts: []string{"WELCOME10 200.00"},
+		},
+		{
+			name:          "capped at the subtotal",
+			promotions:    []Promotion{fixed("big", NewMoney(2500, currency.THB), nil)},
+			wantDiscounts: []string{"big 2000.00"},
+		},
+		{
+			name:          "capped at what the length of stay discount left",
+			promotions:    []Promotion{fiveHundredOff},
+			stayDiscount:  NewMoney(1800, currency.THB),
+			wantDiscounts: []string{"stay 1800.00", "500 THB campaign 200.00"},
+		},
+		{
+			name:          "unknown code, campaigns still apply",
+			code:          "NOPE",
+			promotions:    []Promotion{code, fiveOff},
+			wantDiscounts: []string{"5% campaign 100.00"},
+			wantRejection: PromotionUnknownCode,
+		},
+		{
+			name:          "inactive code is unknown",
+			code:          "WELCOME10",
+			promotions:    []Promotion{percent("WELCOME10", 10, func(p *Promotion) { withCode(p); p.IsActive = false })},
+			wantRejection: PromotionUnknownCode,
+		},
+		{
+			name:          "not valid yet",
+			code:          "WELCOME10",
+			promotions:    []Promotion{percent("WELCOME10", 10, func(p *Promotion) { withCode(p); p.ActiveFrom = &tomorrow })},
+			wantRejection: PromotionNotYetValid,
+		},
+		{
+			name:          "expired",
+			code:          "WELCOME10",
+			promotions:    []Promotion{percent("WELCOME10", 10, func(p *Promotion) { withCode(p); p.ActiveUntil = &yesterday })},
+			wantRejection: PromotionExpired,
+		},
+		{
+			name:          "another hotel",
+			code:          "WELCOME10",
+			promotions:    []Promotion{percent("WELCOME10", 10, func(p *Promotion) { withCode(p); p.HotelIDs = []string{"hotel-2"} })},
+			wantRejection: PromotionHotel,
+		},
+		{
+			name:          "another room",
+			code:          "WELCOME10",
+			promotions:    []Promotion{percent("WELCOME10", 10, func(p *Promotion) { withCode(p); p.RoomIDs = []string{"room-2"} })},
+			wantRejection: PromotionRoom,
+		},
+		{
+			name:          "last night on StayUntil",
+			code:          "WELCOME10",
+			promotions:    []Promotion{percent("WELCOME10", 10, func(p *Promotion) { withCode(p); p.StayUntil = "2026-12-11" })},
+			wantRejection: PromotionStayDates,
+		},
+		{
+			name:          "stay too short",
+			code:          "WELCOME10",
+			promotions:    []Promotion{percent("WELCOME10", 10, func(p *Promotion) { withCode(p); p.MinNights = 3 })},
+			wantRejection: PromotionMinNights,
+		},
+		{
+			name:          "booked too late",
+			code:          "WELCOME10",
+			promotions:    []Promotion{percent("WELCOME10", 10, func(p *Promotion) { withCode(p); p.MinDaysBeforeArrival = 60 })},
+			wantRejection: PromotionBookingWindow,
+		},
+		{
+			name:          "booked too early",
+			code:          "WELCOME10",
+			promotions:    []Promotion{percent("WELCOME10", 10, func(p *Promotion) { withCode(p); p.MaxDaysBeforeArrival = &thirty })},
+			wantRejection: PromotionBookingWindow,
+		},
+		{
+			name:          "used up",
+			code:          "WELCOME10",
+			promotions:    []Promotion{percent("WELCOME10", 10, func(p *Promotion) { withCode(p); p.MaxUses, p.Uses = 5, 5 })},
+			wantRejection: PromotionUsedUp,
+		},
+		{
+			name:          "fixed amount in another currency",
+			code:          "WELCOME10",
+			promotions:    []Promotion{fixed("WELCOME10", NewMoney(10, currency.USD), withCode)},
+			wantRejection: PromotionCurrency,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q := quoteOf(1000, currency.THB, 2)
+			if tt.stayDiscount.Amount != 0 {
+				q.Discounts = append(q.Discounts, QuoteLine{Name: "stay", Amount: tt.stayDiscount})
+			}
+
+			q.ApplyPromotions(tt.code, tt.promotions, room, stay, now)
+
+			var discounts []string
+			for _, line := range q.Discounts {
+				discounts = append(discounts, line.Name+" "+line.Amount.String())
+			}
+			if !slices.Equal(discounts, tt.wantDiscounts) {
+				t.Errorf("discounts %q, want %q", discounts, tt.wantDiscounts)
+			}
+
+			var rejection string
+			if q.PromoRejection != nil {
+				rejection = q.PromoRejection.Reason
+			}
+			if rejection != tt.wantRejection {
+				t.Errorf("rejection %q, want %q", rejection, tt.wantRejection)
+			}
+			if q.PromoCode != tt.code {
+				t.Errorf("PromoCode = %q, want %q", q.PromoCode, tt.code)
+			}
+		})
+	}
+}
@@ -0,0 +1,94 @@
+package domain
+
+import "errors"
+
+// ErrNotForSale is returned when pricing a room that does not exist, is not live or belongs to
+// another hotel
+var ErrNotForSale = errors.New("room not for sale")
+
+// Where the price of a night before pricing rules comes from
+const (
+	RateSourceCalendar  = "rate_calendar"
+	RateSourceBasePrice = "base_price"
+)
+
+// Quote is the itemized price of a stay, every amount is in Currency. Discounts are positive
+// amounts taken off the total, inclusive taxes and fees are already part of the nights.
+type Quote struct {
+	Currency string
+	Nights   []NightlyPrice
+	// Occupancy charges the guests beyond the base occupancy for the stay
+	Occupancy []QuoteLine
+	// RatePlan is the rate plan the stay is priced on, nil for the room's own price
+	RatePlan *RatePlan
+	// CancellationPolicyID is the policy whose surcharge is added to the subtotal
+	CancellationPolicyID string
+	SurchargePercent     float64
+	Surcharge            Money
+	Discounts            []QuoteLine
+	// PromoCode is the code the quote was asked with, PromoRejection why it does not apply
+	PromoCode      string
+	PromoRejection *PromotionRejection
+	Taxes          []QuoteLine
+	Fees           []QuoteLine
+	// Conversion is the total in the currency the quote was asked for, nil for the room currency
+	Conversion *Conversion
+	// Signed lets the quote be honoured later without pricing it again
+	Signed *SignedQuote
+}
+
+// NightlyPrice is one night of a quote. BaseRate is the rate calendar price or the base price
+// and Rate what the night costs after the pricing rules in Rules and the rate plan adjusted it.
+type NightlyPrice struct {
+	Date     string
+	Source   string
+	BaseRate Money
+	Rate     Money
+	Rules    []PricingRule
+}
+
+type QuoteLine struct {
+	Name      string
+	Amount    Money
+	Inclusive bool
+	// PromotionID and StayRuleID reference the promotion or length of stay rule a discount
+	// comes from
+	PromotionID string
+	StayRuleID  string
+}
+
+// Subtotal is the price of the nights and the occupancy charges
+func (q *Quote) Subtotal() Money {
+	subtotal := Zero(q.Currency)
+	for _, night := range q.Nights {
+		subtotal = subtotal.Add(night.Rate)
+	}
+	for _, line := range q.Occupancy {
+		subtotal = subtotal.Add(line.Amount)
+	}
+	return subtotal
+}
+
+// Total is the subtotal with the surcharge and exclusive taxes and fees added and the discounts
+// taken off
+func (q *Quote) Total() Money {
+	total := q.roomAmount()
+	for _, lines := range [][]QuoteLine{q.Taxes, q.Fees} {
+		for _, line := range lines {
+			if !line.Inclusive {
+				total = total.Add(line.Amount)
+			}
+		}
+	}
+	return total
+}
+
+// roomAmount is the subtotal with the surcharge added and the discounts taken off, the amount
+// taxes and fees are charged on
+func (q *Quote) roomAmount() Money {
+	amount := q.Subtotal().Add(q.Surcharge)
+	for _, line := range q.Discounts {
+		amount = amount.Sub(line.Amount)
+	}
+	return amount
+}
@@ -0,0 +1,39 @@
+package domain
+
+import "time"
+
+// DateLayout is how calendar dates are written, a night is identified by the date it starts on
+const DateLayout = "2006-01-02"
+
+// MaxStayNights is how many nights one stay is priced for at most
+const MaxStayNights = 90
+
+// RoomRate overrides the base price of a room for one night, in the currency of the room
+type RoomRate struct {
+	RoomID string
+	Date   string
+	Price  Money
+}
+
+// Stay runs from the check-in date up to, not including, the check-out date, for Adults adults
+// and a child of each of ChildAges
+type Stay struct {
+	CheckIn   time.Time
+	CheckOut  time.Time
+	Adults    int
+	ChildAges []int
+}
+
+// Guests counts everybody staying, children included
+func (s Stay) Guests() int {
+	return s.Adults + len(s.ChildAges)
+}
+
+// Nights lists the date every night of the stay starts on
+func (s Stay) Nights() []time.Time {
+	var nights []time.Time
+	for d := s.CheckIn; d.Before(s.CheckOut); d = d.AddDate(0, 0, 1) {
+		nights = append(nights, d)
+	}
+	return nights
+}
@@ -0,0 +1,148 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+// ErrRatePlanCodeTaken is returned when another active rate plan of the hotel has the same code
+var ErrRatePlanCodeTaken = errors.New("rate plan code taken")
+
+// Rate plan kinds
+const (
+	RatePlanBestAvailable   = "bar"
+	RatePlanMember          = "member"
+	RatePlanCorporate       = "corporate"
+	RatePlanAdvancePurchase = "advance_purchase"
+)
+
+// Reasons a stay cannot be booked on a rate plan
+const (
+	RatePlanUnknown       = "unknown_rate_plan"
+	RatePlanRoom          = "rate_plan_room"
+	RatePlanMembersOnly   = "members_only"
+	RatePlanAccessCode    = "access_code"
+	RatePlanBookingWindow = "booking_window"
+	RatePlanMinNights     = "min_nights"
+)
+
+// RatePlan is a way a hotel sells its rooms. The nights of a stay on the plan cost their rate
+// times Multiplier, e.g. 0.9 for 10% off the best available rate, and the stay is sold with the
+// plan's cancellation policy instead of the room's when CancellationPolicyID is set. Inclusions
+// name what the plan includes, such as breakfast.
+//
+// A plan is sold on the rooms in RoomIDs and the rooms of RoomTypes, on every room of the hotel
+// when both are empty. Guests are eligible when they are members if the plan is MembersOnly,
+// give the AccessCode of a corporate plan, book at least MinDaysBeforeArrival days ahead and
+// stay at least MinNights nights.
+type RatePlan struct {
+	ID                   string
+	HotelID              string
+	Code                 string
+	Name                 string
+	Description          string
+	Kind                 string
+	Multiplier           float64
+	CancellationPolicyID string
+	Inclusions           []string
+	RoomIDs              []string
+	RoomTypes            []string
+	MembersOnly          bool
+	AccessCode           string
+	MinDaysBeforeArrival int
+	MinNights            int
+	IsActive             bool
+}
+
+// BestAvailableRate is the rate plan every hotel starts with, its rooms sold at their own price
+// and with their own cancellation policy
+func BestAvailableRate(hotelID string) RatePlan {
+	return RatePlan{
+		HotelID:     hotelID,
+		Code:        "BAR",
+		Name:        "Best Available Rate",
+		Description: "Flexible rate with the room's cancellation policy",
+		Kind:        RatePlanBestAvailable,
+		Multiplier:  1,
+		IsActive:    true,
+	}
+}
+
+// RatePlanGuest is what the guest told about themselves to be eligible for a rate plan
+type RatePlanGuest struct {
+	Member     bool
+	AccessCode string
+}
+
+// RatePlanViolation is the error a stay that cannot be booked on a rate plan is refused with,
+// Reason is one of the reasons above
+type RatePlanViolation struct {
+	RatePlanID string
+	Reason     string
+	Message    string
+}
+
+func (v *RatePlanViolation) Error() string {
+	return v.Message
+}
+
+// SellsRoom reports whether the plan is sold on room
+func (p *RatePlan) SellsRoom(room *Room) bool {
+	if !p.IsActive || p.HotelID != room.HotelID {
+		return false
+	}
+	if len(p.RoomIDs) == 0 && len(p.RoomTypes) == 0 {
+		return true
+	}
+	return slices.Contains(p.RoomIDs, room.ID) || slices.Contains(p.RoomTypes, room.Type)
+}
+
+// Check returns why a stay in room booked at now by guest cannot be booked on the plan, nil when
+// it can
+func (p *RatePlan) Check(room *Room, stay Stay, guest RatePlanGuest, now time.Time) *RatePlanViolation {
+	reject := func(reason, format string, args ...any) *RatePlanViolation {
+		return &RatePlanViolation{RatePlanID: p.ID, Reason: reason, Message: fmt.Sprintf(format, args...)}
+	}
+
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	daysAhead := int(stay.CheckIn.Sub(today).Hours() / 24)
+
+	switch {
+	case !p.SellsRoom(room):
+		return reject(RatePlanRoom, "the rate plan is not sold on this room")
+	case p.MembersOnly && !guest.Member:
+		return reject(RatePlanMembersOnly, "the rate plan is for members only")
+	case p.AccessCode != "" && !strings.EqualFold(p.AccessCode, guest.AccessCode):
+		return reject(RatePlanAccessCode, "the rate plan needs a valid access code")
+	case daysAhead < p.MinDaysBeforeArrival:
+		return reject(RatePlanBookingWindow, "the rate plan applies to stays booked at least %d days before arrival", p.MinDaysBeforeArrival)
+	case len(stay.Nights()) < p.MinNights:
+		return reject(RatePlanMinNights, "the rate plan applies to stays of at least %d nights", p.MinNights)
+	}
+	return nil
+}
+
+// FindRatePlan returns the rate plan with ratePlanID among plans, or the violation of a plan the
+// hotel does not sell
+func FindRatePlan(plans []RatePlan, ratePlanID string) (*RatePlan, *RatePlanViolation) {
+	for i := range plans {
+		if plans[i].ID == ratePlanID && plans[i].IsActive {
+			return &plans[i], nil
+		}
+	}
+	return nil, &RatePlanViolation{RatePlanID: ratePlanID, Reason: RatePlanUnknown, Message: "the hotel has no such rate plan"}
+}
+
+// RatePlansFor returns the plans of plans sold on room
+func RatePlansFor(room *Room, plans []RatePlan) []RatePlan {
+	var sold []RatePlan
+	for _, plan := range plans {
+		if plan.SellsRoom(room) {
+			sold = append(sold, plan)
+		}
+	}
+	return sold
+}
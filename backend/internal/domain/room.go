@@ -1,24 +1,74 @@
 package domain
 
-import "github.com/chayutK/hotel-property-service/internal/constants/cancellationpolicy"
+import "time"
 
 type Room struct {
-	ID                 string
-	PhysicalRoomID     string
-	HotelID            string
-	Name               string
-	Description        string
-	Type               string
-	BasePrice          float64
-	Currency           string
-	CancellationPolicy string
-	Benefit            []Benefit
-	IsActive           bool
+	ID             string
+	PhysicalRoomID string
+	HotelID        string
+	Name           string
+	Description    string
+	Type           string
+	// BasePrice is what a night costs before pricing rules, in the currency the room is sold in
+	BasePrice Money
+	// BaseOccupancy guests are included in the base price and up to MaxOccupancy guests can
+	// stay, the others are charged ExtraAdultCharge or the charge of their ChildCharges band a
+	// night
+	BaseOccupancy    int
+	MaxOccupancy     int
+	ExtraAdultCharge Money
+	ChildCharges     []ChildCharge `diff:"value"`
+	// CancellationPolicyID references the cancellation policy the room is sold with
+	CancellationPolicyID string
+	Benefit              []Benefit
+	// RatePlans are the rate plans the room is sold on, only set when listing rooms
+	RatePlans []RatePlan `diff:"-"`
+	IsActive  bool
+	// ActiveFrom and ActiveUntil optionally limit when an active room is sold
+	ActiveFrom  *time.Time
+	ActiveUntil *time.Time
+	// UpdatedAt is the stored row version, updates carrying it only apply to that version
+	UpdatedAt int64 `diff:"-"`
 }
 
-func (r *Room) CalculatePrice(nights int) float64 {
-	if r.CancellationPolicy == cancellationpolicy.FreeCancellation {
-		return r.BasePrice * float64(nights) * 1.2 // 20% surcharge for free cancellation
+func (r *Room) IsLiveAt(t time.Time) bool {
+	return liveAt(r.IsActive, r.ActiveFrom, r.ActiveUntil, t)
+}
+
+// Quote prices every night of the stay. A rate calendar price is final for its night, other
+// nights cost the base price adjusted by the pricing rules matching them. On a rate plan every
+// night then costs its price times the plan multiplier. Guests beyond the base occupancy are
+// charged on top of the nights, and the surcharge of the cancellation policy on top of both.
+func (r *Room) Quote(stay Stay, rates map[string]Money, rules []PricingRule, plan *RatePlan, policy *CancellationPolicy) *Quote {
+	quote := &Quote{
+		Currency:             r.BasePrice.Currency,
+		RatePlan:             plan,
+		CancellationPolicyID: policy.ID,
+		SurchargePercent:     policy.SurchargePercent,
 	}
-	return r.BasePrice * float64(nights)
+
+	for _, night := range stay.Nights() {
+		price := r.PriceNight(night, rates, rules)
+		if plan != nil {
+			price.Rate = price.Rate.Mul(plan.Multiplier)
+		}
+		quote.Nights = append(quote.Nights, price)
+	}
+
+	quote.Occupancy = r.occupancyCharges(stay)
+	quote.Surcharge = policy.Surcharge(quote.Subtotal())
+	return quote
+}
+
+// PriceNight prices one night at the room's own price: its rate calendar price when rates has
+// one for the night, or else the base price adjusted by the matching pricing rules
+func (r *Room) PriceNight(night time.Time, rates map[string]Money, rules []PricingRule) NightlyPrice {
+	date := night.Format(DateLayout)
+	if rate, ok := rates[date]; ok {
+		return NightlyPrice{Date: date, Source: RateSourceCalendar, BaseRate: rate, Rate: rate}
+	}
+
+	price := NightlyPrice{Date: date, Source: RateSourceBasePrice, BaseRate: r.BasePrice}
+	price.Rate, price.Rules = ApplyPricingRules(r.BasePrice, r, night, rules)
+	return price
 }
@@ -0,0 +1,56 @@
+package domain
+
+import (
+	"errors"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	// ErrQuoteSignature is returned when a signed quote was not signed by this service or was
+	// altered after signing
+	ErrQuoteSignature = errors.New("quote signature invalid")
+	// ErrQuoteExpired is returned when an authentic signed quote is past its expiry
+	ErrQuoteExpired = errors.New("quote expired")
+)
+
+// SignedQuote is what the signature of a quote vouches for: the room and rate plan, the stay
+// with its occupancy and the total in the room currency, until ExpiresAt
+type SignedQuote struct {
+	ID         string
+	HotelID    string
+	RoomID     string
+	RatePlanID string
+	Stay       Stay
+	Total      Money
+	ExpiresAt  time.Time
+	Signature  string
+}
+
+// Payload is the text the signature is computed over. Child ages are sorted, the order children
+// are listed in does not change the quote.
+func (q *SignedQuote) Payload() string {
+	ages := slices.Clone(q.Stay.ChildAges)
+	slices.Sort(ages)
+
+	childAges := make([]string, len(ages))
+	for i, age := range ages {
+		childAges[i] = strconv.Itoa(age)
+	}
+
+	return strings.Join([]string{
+		q.ID,
+		q.HotelID,
+		q.RoomID,
+		q.RatePlanID,
+		q.Stay.CheckIn.Format(DateLayout),
+		q.Stay.CheckOut.Format(DateLayout),
+		strconv.Itoa(q.Stay.Adults),
+		strings.Join(childAges, ","),
+		strconv.FormatInt(q.Total.Amount, 10),
+		q.Total.Currency,
+		strconv.FormatInt(q.ExpiresAt.Unix(), 10),
+	}, "|")
+}
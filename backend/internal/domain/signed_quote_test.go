@@ -0,0 +1,80 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/chayutK/hotel-property-service/internal/constants/currency"
+)
+
+// signedQuote is a quote of two adults and two children, listed youngest last
+func signedQuote() SignedQuote {
+	return SignedQuote{
+		ID:         "quote-1",
+		HotelID:    "hotel-1",
+		RoomID:     "room-1",
+		RatePlanID: "plan-1",
+		Stay: Stay{
+			CheckIn:   time.Date(2026, 12, 10, 0, 0, 0, 0, time.UTC),
+			CheckOut:  time.Date(2026, 12, 12, 0, 0, 0, 0, time.UTC),
+			Adults:    2,
+			ChildAges: []int{10, 4},
+		},
+		Total:     NewMoney(2354, currency.THB),
+		ExpiresAt: time.Date(2026, 11, 1, 10, 15, 0, 0, time.UTC),
+	}
+}
+
+func TestSignedQuotePayload(t *testing.T) {
+	q := signedQuote()
+
+	want := "quote-1|hotel-1|room-1|plan-1|2026-12-10|2026-12-12|2|4,10|235400|THB|1793528100"
+	if got := q.Payload(); got != want {
+		t.Errorf("Payload() = %q, want %q", got, want)
+	}
+	if q.Stay.ChildAges[0] != 10 {
+		t.Errorf("Payload() sorted the child ages of the quote, %v", q.Stay.ChildAges)
+	}
+}
+
+func TestSignedQuotePayloadChildOrder(t *testing.T) {
+	q, reordered := signedQuote(), signedQuote()
+	reordered.Stay.ChildAges = []int{4, 10}
+
+	if q.Payload() != reordered.Payload() {
+		t.Errorf("Payload() depends on the order of the children: %q and %q", q.Payload(), reordered.Payload())
+	}
+}
+
+func TestSignedQuotePayloadCoversEveryField(t *testing.T) {
+	tests := []struct {
+		name   string
+		change func(*SignedQuote)
+	}{
+		{"quote", func(q *SignedQuote) { q.ID = "quote-2" }},
+		{"hotel", func(q *SignedQuote) { q.HotelID = "hotel-2" }},
+		{"room", func(q *SignedQuote) { q.RoomID = "room-2" }},
+		{"rate plan", func(q *SignedQuote) { q.RatePlanID = "" }},
+		{"check-in", func(q *SignedQuote) { q.Stay.CheckIn = q.Stay.CheckIn.AddDate(0, 0, 1) }},
+		{"check-out", func(q *SignedQuote) { q.Stay.CheckOut = q.Stay.CheckOut.AddDate(0, 0, 1) }},
+		{"adults", func(q *SignedQuote) { q.Stay.Adults = 1 }},
+		{"a child less", func(q *SignedQuote) { q.Stay.ChildAges = []int{4} }},
+		{"a child older", func(q *SignedQuote) { q.Stay.ChildAges = []int{10, 5} }},
+		{"children merged into one age", func(q *SignedQuote) { q.Stay.ChildAges = []int{410} }},
+		{"total", func(q *SignedQuote) { q.Total.Amount-- }},
+		{"currency", func(q *SignedQuote) { q.Total.Currency = currency.USD }},
+		{"expiry", func(q *SignedQuote) { q.ExpiresAt = q.ExpiresAt.Add(time.Second) }},
+	}
+
+	original := signedQuote()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			changed := signedQuote()
+			tt.change(&changed)
+
+			if changed.Payload() == original.Payload() {
+				t.Errorf("Payload() = %q after changing the %s", changed.Payload(), tt.name)
+			}
+		})
+	}
+}
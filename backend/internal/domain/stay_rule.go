@@ -0,0 +1,122 @@
+package domain
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Stay rule kinds
+const (
+	StayRuleRestriction = "restriction"
+	StayRuleDiscount    = "discount"
+)
+
+// Reasons a stay is refused
+const (
+	StayBelowMinimum = "min_stay"
+	StayAboveMaximum = "max_stay"
+)
+
+// StayRule is a length of stay rule of a hotel, optionally narrowed to a single room and to
+// stays arriving from DateFrom up to, not including, DateUntil.
+//
+// A restriction refuses stays shorter than MinNights or, when MaxNights is set, longer than
+// MaxNights. A discount takes Percent off the subtotal, the nights and the extra guest charges,
+// of stays of at least MinNights nights; of the discounts matching a stay only the tier with the
+// highest MinNights applies.
+type StayRule struct {
+	ID        string
+	HotelID   string
+	RoomID    string
+	Name      string
+	Kind      string
+	DateFrom  string
+	DateUntil string
+	MinNights int
+	MaxNights int
+	Percent   float64
+	IsActive  bool
+}
+
+// StayViolation is the error a stay breaking a restriction is refused with, Reason is one of
+// the reasons above
+type StayViolation struct {
+	RuleID  string
+	Reason  string
+	Message string
+}
+
+func (v *StayViolation) Error() string {
+	return v.Message
+}
+
+// Matches reports whether the rule applies to a stay in room, stays are matched by the date
+// they arrive on
+func (r *StayRule) Matches(room *Room, stay Stay) bool {
+	if !r.IsActive || r.HotelID != room.HotelID {
+		return false
+	}
+	if r.RoomID != "" && r.RoomID != room.ID {
+		return false
+	}
+
+	arrival := stay.CheckIn.Format(DateLayout)
+	if r.DateFrom != "" && arrival < r.DateFrom {
+		return false
+	}
+	return r.DateUntil == "" || arrival < r.DateUntil
+}
+
+// CheckStay returns the violation of the first restriction the stay breaks, nil when it breaks
+// none
+func CheckStay(room *Room, stay Stay, rules []StayRule) *StayViolation {
+	nights := len(stay.Nights())
+	for _, rule := range rules {
+		if rule.Kind != StayRuleRestriction || !rule.Matches(room, stay) {
+			continue
+		}
+
+		switch {
+		case nights < rule.MinNights:
+			return &StayViolation{
+				RuleID:  rule.ID,
+				Reason:  StayBelowMinimum,
+				Message: fmt.Sprintf("stays arriving on %s have to be at least %d nights", stay.CheckIn.Format(DateLayout), rule.MinNights),
+			}
+		case rule.MaxNights > 0 && nights > rule.MaxNights:
+			return &StayViolation{
+				RuleID:  rule.ID,
+				Reason:  StayAboveMaximum,
+				Message: fmt.Sprintf("stays arriving on %s can be at most %d nights", stay.CheckIn.Format(DateLayout), rule.MaxNights),
+			}
+		}
+	}
+	return nil
+}
+
+// ApplyStayDiscount takes the percentage of the length of stay discount tier the stay reached off
+// the subtotal of the quote, the nights and the extra guest charges. When a room and a hotel tier
+// need the same number of nights, the room's applies.
+func (q *Quote) ApplyStayDiscount(room *Room, stay Stay, rules []StayRule) {
+	nights := len(stay.Nights())
+
+	var tiers []StayRule
+	for _, rule := range rules {
+		if rule.Kind == StayRuleDiscount && rule.Matches(room, stay) && nights >= rule.MinNights {
+			tiers = append(tiers, rule)
+		}
+	}
+	if len(tiers) == 0 {
+		return
+	}
+
+	sort.SliceStable(tiers, func(i, j int) bool {
+		if tiers[i].MinNights != tiers[j].MinNights {
+			return tiers[i].MinNights > tiers[j].MinNights
+		}
+		return tiers[i].RoomID != "" && tiers[j].RoomID == ""
+	})
+
+	tier := tiers[0]
+	q.Discounts = append(q.Discounts, QuoteLine{Name: tier.Name, Amount: q.Subtotal().Percent(tier.Percent), StayRuleID: tier.ID})
+}
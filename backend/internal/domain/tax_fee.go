@@ -0,0 +1,148 @@
+package domain
+
+import (
+	"fmt"
+	"math/big"
+	"sort"
+)
+
+// Tax and fee kinds, taxes and fees are reported separately on a quote
+const (
+	KindTax = "tax"
+	KindFee = "fee"
+)
+
+// How a tax or fee is calculated
+const (
+	CalculationPercent = "percent"
+	CalculationFixed   = "fixed"
+)
+
+// What a fixed tax or fee is charged for
+const (
+	BasisNight  = "per_night"
+	BasisStay   = "per_stay"
+	BasisPerson = "per_person"
+)
+
+// TaxFee is a tax or fee a hotel charges on top of the room, such as a 10% service charge or
+// 7% VAT. Percentages are taken of the room price after the cancellation surcharge and discounts,
+// a Compound one also of the taxes and fees applied before it. Fixed amounts are charged per
+// night, per stay or per guest for the stay.
+//
+// Inclusive taxes and fees are already part of the room price, quotes report what they amount
+// to without adding them to the total. Taxes and fees are applied by ascending Sequence.
+type TaxFee struct {
+	ID          string
+	HotelID     string
+	Name        string
+	Kind        string
+	Calculation string
+	Percent     float64
+	Amount      Money
+	Basis       string
+	Inclusive   bool
+	Compound    bool
+	Sequence    int
+	IsActive    bool
+}
+
+// ApplyTaxesAndFees adds the lines of the given taxes and fees to the quote
+func (q *Quote) ApplyTaxesAndFees(taxFees []TaxFee, guests int) error {
+	var inclusive, exclusive []TaxFee
+	for _, taxFee := range taxFees {
+		if taxFee.Calculation == CalculationFixed && taxFee.Amount.Currency != q.Currency {
+			return fmt.Errorf("%s %q is charged in %s, not in the room currency %s: %w", taxFee.Kind, taxFee.Name, taxFee.Amount.Currency, q.Currency, ErrCurrencyMismatch)
+		}
+		if taxFee.Inclusive {
+			inclusive = append(inclusive, taxFee)
+		} else {
+			exclusive = append(exclusive, taxFee)
+		}
+	}
+
+	room := q.roomAmount()
+	nights := len(q.Nights)
+
+	// the room price is a net price with the inclusive taxes and fees applied to it, their fixed
+	// amounts plus a share of the net price. The last of them takes up the rounding so that
+	// they add up to the room price exactly.
+	if len(inclusive) > 0 {
+		fixed := sumAmounts(chargeAmounts(Zero(q.Currency), inclusive, nights, guests))
+		share := percentShare(inclusive)
+		net := room.Sub(fixed).mul(share.Inv(share.Add(share, big.NewRat(1, 1))))
+
+		amounts := chargeAmounts(net, inclusive, nights, guests)
+		last := len(amounts) - 1
+		amounts[last] = amounts[last].Add(room.Sub(net).Sub(sumAmounts(amounts)))
+		q.addLines(inclusive, amounts, true)
+	}
+
+	q.addLines(exclusive, chargeAmounts(room, exclusive, nights, guests), false)
+	return nil
+}
+
+func (q *Quote) addLines(taxFees []TaxFee, amounts []Money, inclusive bool) {
+	for i, taxFee := range sortedTaxFees(taxFees) {
+		line := QuoteLine{Name: taxFee.Name, Amount: amounts[i], Inclusive: inclusive}
+		if taxFee.Kind == KindTax {
+			q.Taxes = append(q.Taxes, line)
+		} else {
+			q.Fees = append(q.Fees, line)
+		}
+	}
+}
+
+// chargeAmounts works out the taxes and fees on price in the order they are applied
+func chargeAmounts(price Money, taxFees []TaxFee, nights, guests int) []Money {
+	amounts := make([]Money, 0, len(taxFees))
+	for _, taxFee := range sortedTaxFees(taxFees) {
+		var amount Money
+		switch {
+		case taxFee.Calculation == CalculationFixed && taxFee.Basis == BasisNight:
+			amount = taxFee.Amount.Times(nights)
+		case taxFee.Calculation == CalculationFixed && taxFee.Basis == BasisPerson:
+			amount = taxFee.Amount.Times(guests)
+		case taxFee.Calculation == CalculationFixed:
+			amount = taxFee.Amount
+		case taxFee.Compound:
+			amount = price.Add(sumAmounts(amounts)).Percent(taxFee.Percent)
+		default:
+			amount = price.Percent(taxFee.Percent)
+		}
+		amounts = append(amounts, amount)
+	}
+	return amounts
+}
+
+// percentShare is the part of a price the percentage taxes and fees add up to, e.g. 0.177 for a
+// 10% service charge with 7% VAT compounded on it
+func percentShare(taxFees []TaxFee) *big.Rat {
+	share := new(big.Rat)
+	for _, taxFee := range sortedTaxFees(taxFees) {
+		if taxFee.Calculation != CalculationPercent {
+			continue
+		}
+		rate := percentOf(taxFee.Percent)
+		if taxFee.Compound {
+			rate.Mul(rate, new(big.Rat).Add(share, big.NewRat(1, 1)))
+		}
+		share.Add(share, rate)
+	}
+	return share
+}
+
+func sortedTaxFees(taxFees []TaxFee) []TaxFee {
+	sorted := make([]TaxFee, len(taxFees))
+	copy(sorted, taxFees)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Sequence < sorted[j].Sequence })
+	return sorted
+}
+
+func sumAmounts(amounts []Money) Money {
+	var sum Money
+	for _, amount := range amounts {
+		sum = sum.Add(amount)
+	}
+	return sum
+}
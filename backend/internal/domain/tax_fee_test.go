@@ -0,0 +1,156 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/chayutK/hotel-property-service/internal/constants/currency"
+)
+
+// quoteOf is a quote of nights at the same rate and nothing else
+func quoteOf(rate float64, code string, nights int) *Quote {
+	q := &Quote{Currency: code}
+	for range nights {
+		q.Nights = append(q.Nights, NightlyPrice{Rate: NewMoney(rate, code)})
+	}
+	return q
+}
+
+func TestApplyTaxesAndFees(t *testing.T) {
+	thaiServiceCharge := TaxFee{Name: "Service charge", Kind: KindFee, Calculation: CalculationPercent, Percent: 10, Sequence: 1}
+	thaiVAT := TaxFee{Name: "VAT", Kind: KindTax, Calculation: CalculationPercent, Percent: 7, Compound: true, Sequence: 2}
+	inclusive := func(taxFee TaxFee) TaxFee {
+		taxFee.Inclusive = true
+		return taxFee
+	}
+
+	tests := []struct {
+		name      string
+		quote     *Quote
+		taxFees   []TaxFee
+		guests    int
+		wantTaxes []string
+		wantFees  []string
+		wantTotal string
+	}{
+		{
+			name:      "Thai service charge and compounded VAT on top",
+			quote:     quoteOf(1000, currency.THB, 2),
+			taxFees:   []TaxFee{thaiVAT, thaiServiceCharge},
+			guests:    2,
+			wantTaxes: []string{"154.00"},
+			wantFees:  []string{"200.00"},
+			wantTotal: "2354.00",
+		},
+		{
+			name:      "Thai service charge and compounded VAT included",
+			quote:     quoteOf(1177, currency.THB, 2),
+			taxFees:   []TaxFee{inclusive(thaiServiceCharge), inclusive(thaiVAT)},
+			guests:    2,
+			wantTaxes: []string{"154.00"},
+			wantFees:  []string{"200.00"},
+			wantTotal: "2354.00",
+		},
+		{
+			name:      "Thai service charge and compounded VAT included, rounded to the satang",
+			quote:     quoteOf(1000, currency.THB, 1),
+			taxFees:   []TaxFee{inclusive(thaiServiceCharge), inclusive(thaiVAT)},
+			guests:    2,
+			wantTaxes: []string{"65.42"},
+			wantFees:  []string{"84.96"},
+			wantTotal: "1000.00",
+		},
+		{
+			name:      "JPY rounds to the whole yen",
+			quote:     quoteOf(12345, currency.JPY, 3),
+			taxFees:   []TaxFee{thaiServiceCharge, thaiVAT},
+			guests:    2,
+			wantTaxes: []string{"2852"},
+			wantFees:  []string{"3704"},
+			wantTotal: "43591",
+		},
+		{
+			name:  "fixed per night, per person and per stay with a percentage",
+			quote: quoteOf(150, currency.USD, 2),
+			taxFees: []TaxFee{
+				{Name: "City tax", Kind: KindTax, Calculation: CalculationFixed, Amount: NewMoney(5, currency.USD), Basis: BasisNight, Sequence: 1},
+				{Name: "Resort fee", Kind: KindFee, Calculation: CalculationFixed, Amount: NewMoney(2.5, currency.USD), Basis: BasisPerson, Sequence: 2},
+				{Name: "Cleaning fee", Kind: KindFee, Calculation: CalculationFixed, Amount: NewMoney(20, currency.USD), Basis: BasisStay, Sequence: 3},
+				{Name: "Sales tax", Kind: KindTax, Calculation: CalculationPercent, Percent: 7, Sequence: 4},
+			},
+			guests:    3,
+			wantTaxes: []string{"10.00", "21.00"},
+			wantFees:  []string{"7.50", "20.00"},
+			wantTotal: "358.50",
+		},
+		{
+			name:  "fixed per night included with a percentage",
+			quote: quoteOf(100, currency.USD, 2),
+			taxFees: []TaxFee{
+				{Name: "City tax", Kind: KindTax, Calculation: CalculationFixed, Amount: NewMoney(5, currency.USD), Basis: BasisNight, Inclusive: true, Sequence: 1},
+				{Name: "Sales tax", Kind: KindTax, Calculation: CalculationPercent, Percent: 7, Inclusive: true, Sequence: 2},
+			},
+			guests:    2,
+			wantTaxes: []string{"10.00", "12.43"},
+			wantTotal: "200.00",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.quote.ApplyTaxesAndFees(tt.taxFees, tt.guests); err != nil {
+				t.Fatalf("ApplyTaxesAndFees() error = %v", err)
+			}
+
+			assertLines(t, "taxes", tt.quote.Taxes, tt.wantTaxes)
+			assertLines(t, "fees", tt.quote.Fees, tt.wantFees)
+			if got := tt.quote.Total().String(); got != tt.wantTotal {
+				t.Errorf("Total() = %s, want %s", got, tt.wantTotal)
+			}
+		})
+	}
+}
+
+func TestApplyTaxesAndFeesInclusiveAddUpToRoomPrice(t *testing.T) {
+	taxFees := []TaxFee{
+		{Name: "Service charge", Kind: KindFee, Calculation: CalculationPercent, Percent: 10, Inclusive: true, Sequence: 1},
+		{Name: "VAT", Kind: KindTax, Calculation: CalculationPercent, Percent: 7, Compound: true, Inclusive: true, Sequence: 2},
+	}
+
+	for _, rate := range []float64{0.01, 99.99, 1000, 1234.56, 33333.33} {
+		q := quoteOf(rate, currency.THB, 1)
+		if err := q.ApplyTaxesAndFees(taxFees, 1); err != nil {
+			t.Fatalf("ApplyTaxesAndFees() error = %v", err)
+		}
+
+		included := q.Fees[0].Amount.Add(q.Taxes[0].Amount)
+		net := q.Subtotal().Sub(included)
+		if want := net.Percent(17.7); included.Sub(want).Amount > 1 || want.Sub(included).Amount > 1 {
+			t.Errorf("rate %v includes %s of taxes and fees, want about %s", rate, included, want)
+		}
+		if q.Total() != q.Subtotal() {
+			t.Errorf("rate %v totals %s, want the room price %s", rate, q.Total(), q.Subtotal())
+		}
+	}
+}
+
+func TestApplyTaxesAndFeesOtherCurrency(t *testing.T) {
+	q := quoteOf(1000, currency.THB, 1)
+	fee := TaxFee{Name: "Resort fee", Kind: KindFee, Calculation: CalculationFixed, Amount: NewMoney(10, currency.USD), Basis: BasisStay}
+
+	if err := q.ApplyTaxesAndFees([]TaxFee{fee}, 1); err == nil {
+		t.Error("ApplyTaxesAndFees() charged a USD fee on a THB quote")
+	}
+}
+
+func assertLines(t *testing.T, kind string, lines []QuoteLine, want []string) {
+	t.Helper()
+
+	if len(lines) != len(want) {
+		t.Fatalf("%d %s, want %d", len(lines), kind, len(want))
+	}
+	for i, line := range lines {
+		if got := line.Amount.String(); got != want[i] {
+			t.Errorf("%s %q = %s, want %s", kind, line.Name, got, want[i])
+		}
+	}
+}
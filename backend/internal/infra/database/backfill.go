@@ -0,0 +1,379 @@
+package database
+
+import (
+	"fmt"
+	"log/slog"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/chayutK/hotel-property-service/internal/adapter/entity"
+	"github.com/chayutK/hotel-property-service/internal/adapter/mapper"
+	"github.com/chayutK/hotel-property-service/internal/constants/cancellationpolicy"
+	"github.com/chayutK/hotel-property-service/internal/constants/currency"
+	"github.com/chayutK/hotel-property-service/internal/constants/roomtype"
+	"github.com/chayutK/hotel-property-service/internal/domain"
+	"github.com/google/uuid"
+	"golang.org/x/text/unicode/norm"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// facilityCodePattern is what the facility_code validator accepts, codes the API cannot
+// address must not be backfilled
+var facilityCodePattern = regexp.MustCompile(`^[A-Z][A-Z0-9_]{0,63}$`)
+
+// backfillFacilityCodes links facility rows created before the facility catalog existed
+// to catalog entries derived from their free-text name. Rows sharing a name end up on
+// the same catalog code, so older databases keep working without manual fixes. Names
+// without any latin letter or digit get a numbered FACILITY_<n> code instead.
+func backfillFacilityCodes(db *gorm.DB) error {
+	if !db.Migrator().HasColumn(&entity.Facility{}, "name") {
+		return nil
+	}
+
+	var legacy []struct {
+		Name        string
+		Description string
+	}
+	if err := db.Raw("SELECT name, MAX(description) AS description FROM facilities WHERE (code IS NULL OR code = '') AND name <> '' GROUP BY name").Scan(&legacy).Error; err != nil {
+		return err
+	}
+	if len(legacy) == 0 {
+		return nil
+	}
+
+	taken, err := facilityCodes(db)
+	if err != nil {
+		return err
+	}
+
+	return db.Transaction(func(tx *gorm.DB) error {
+		for _, l := range legacy {
+			code := toFacilityCode(l.Name)
+			if code == "" {
+				code = nextFacilityCode(taken)
+			}
+			taken[code] = true
+
+			entry := entity.FacilityCatalog{Code: code, Name: l.Name, Description: l.Description, IsActive: true}
+			if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&entry).Error; err != nil {
+				return err
+			}
+
+			// descriptions identical to the catalog wording are dropped so catalog edits show through
+			if err := tx.Exec(
+				"UPDATE facilities SET code = ?, description = CASE WHEN description = ? THEN '' ELSE description END WHERE (code IS NULL OR code = '') AND name = ?",
+				code, l.Description, l.Name,
+			).Error; err != nil {
+				return err
+			}
+		}
+
+		slog.Info("[INFRA]", "message", "Backfilled facility catalog codes", "facilities", len(legacy))
+		return nil
+	})
+}
+
+// repairFacilityCodes renames the catalog codes earlier backfills derived from names with
+// non-latin letters or a leading digit, the attach, describe and detach routes and catalog
+// imports reject them. The facilities of a renamed entry follow it to the new code.
+func repairFacilityCodes(db *gorm.DB) error {
+	taken, err := facilityCodes(db)
+	if err != nil {
+		return err
+	}
+
+	var invalid []entity.FacilityCatalog
+	if err := db.Find(&invalid).Error; err != nil {
+		return err
+	}
+	invalid = slices.DeleteFunc(invalid, func(entry entity.FacilityCatalog) bool {
+		return facilityCodePattern.MatchString(entry.Code)
+	})
+	if len(invalid) == 0 {
+		return nil
+	}
+
+	return db.Transaction(func(tx *gorm.DB) error {
+		for _, entry := range invalid {
+			code := toFacilityCode(entry.Name)
+			if code == "" || taken[code] {
+				code = nextFacilityCode(taken)
+			}
+			taken[code] = true
+
+			if err := tx.Exec("UPDATE facility_catalogs SET code = ? WHERE code = ?", code, entry.Code).Error; err != nil {
+				return err
+			}
+			if err := tx.Exec("UPDATE facilities SET code = ? WHERE code = ?", code, entry.Code).Error; err != nil {
+				return err
+			}
+		}
+
+		slog.Info("[INFRA]", "message", "Repaired facility catalog codes", "codes", len(invalid))
+		return nil
+	})
+}
+
+// facilityCodes returns the codes of the facility catalog
+func facilityCodes(db *gorm.DB) (map[string]bool, error) {
+	var codes []string
+	if err := db.Model(&entity.FacilityCatalog{}).Pluck("code", &codes).Error; err != nil {
+		return nil, err
+	}
+
+	taken := make(map[string]bool, len(codes))
+	for _, code := range codes {
+		taken[code] = true
+	}
+	return taken, nil
+}
+
+// nextFacilityCode returns the first FACILITY_<n> code that is not taken
+func nextFacilityCode(taken map[string]bool) string {
+	for n := 1; ; n++ {
+		if code := fmt.Sprintf("FACILITY_%d", n); !taken[code] {
+			return code
+		}
+	}
+}
+
+// toFacilityCode turns a facility name such as "Swimming Pool" into "SWIMMING_POOL". Accents
+// are dropped, so "Café" becomes "CAFE", and any other character outside A-Z and 0-9 separates
+// words. A name starting with a digit, such as "24h Gym", becomes "FACILITY_24H_GYM". Names
+// without any latin letter or digit give an empty code.
+func toFacilityCode(name string) string {
+	var b strings.Builder
+	underscore := false
+	for _, r := range norm.NFD.String(strings.TrimSpace(name)) {
+		r = unicode.ToUpper(r)
+		switch {
+		case unicode.Is(unicode.Mn, r):
+			// the accent of a decomposed letter
+		case 'A' <= r && r <= 'Z', '0' <= r && r <= '9':
+			b.WriteRune(r)
+			underscore = false
+		case !underscore && b.Len() > 0:
+			b.WriteByte('_')
+			underscore = true
+		}
+	}
+
+	code := strings.TrimRight(b.String(), "_")
+	if code != "" && !unicode.IsLetter(rune(code[0])) {
+		code = "FACILITY_" + code
+	}
+	if len(code) > 64 {
+		code = strings.TrimRight(code[:64], "_")
+	}
+	return code
+}
+
+// backfillPhysicalRooms creates the physical room of offers that only carried a bare
+// physical_room_id before physical rooms were modelled, taking name, type and description
+// from one of its offers.
+func backfillPhysicalRooms(db *gorm.DB) error {
+	result := db.Exec(`INSERT INTO physical_rooms (physical_room_id, hotel_id, name, description, type, size_sqm, bed_type, bed_count, unit_count, is_active, created_at, updated_at)
+		SELECT physical_room_id, MIN(hotel_id), MIN(name), MIN(description), MIN(type), 0, '', 1, 1, MAX(is_active), MIN(created_at), MAX(updated_at)
+		FROM rooms
+		WHERE physical_room_id <> '' AND physical_room_id NOT IN (SELECT physical_room_id FROM physical_rooms)
+		GROUP BY physical_room_id`)
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected > 0 {
+		slog.Info("[INFRA]", "message", "Backfilled physical rooms", "physical_rooms", result.RowsAffected)
+	}
+	return nil
+}
+
+// backfillCancellationPolicies creates the built-in cancellation policies and points rooms that
+// still carry a bare policy code at the policy with that ID. The built-ins keep the terms prices
+// used before policies were configurable: free cancellation until arrival for a 20% surcharge,
+// or no refund at all.
+func backfillCancellationPolicies(db *gorm.DB) error {
+	untilArrival := 0
+	builtIn := []entity.CancellationPolicy{
+		{
+			PolicyID:              cancellationpolicy.FreeCancellation,
+			Name:                  "Free cancellation",
+			Description:           "Free cancellation until arrival",
+			FreeCancellationHours: &untilArrival,
+			SurchargePercent:      20,
+			IsActive:              true,
+		},
+		{
+			PolicyID:    cancellationpolicy.NonRefundable,
+			Name:        "Non-refundable",
+			Description: "The stay is charged in full when cancelled",
+			IsActive:    true,
+		},
+	}
+	if err := db.Clauses(clause.OnConflict{DoNothing: true}).Create(&builtIn).Error; err != nil {
+		return err
+	}
+
+	if !db.Migrator().HasColumn(&entity.Room{}, "cancellation_policy") {
+		return nil
+	}
+
+	result := db.Exec("UPDATE rooms SET cancellation_policy_id = cancellation_policy WHERE (cancellation_policy_id IS NULL OR cancellation_policy_id = '') AND cancellation_policy <> ''")
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected > 0 {
+		slog.Info("[INFRA]", "message", "Backfilled room cancellation policies", "rooms", result.RowsAffected)
+	}
+	return nil
+}
+
+// backfillMinorUnits converts the prices stored in whole major units before amounts were kept
+// in minor units. Rows still carrying an old price and no minor amount are converted, so the
+// conversion runs once per row. Room rates take the currency of their room.
+func backfillMinorUnits(db *gorm.DB) error {
+	// JPY has no minor unit, every other accepted currency has a hundred
+	const scale = "CASE currency WHEN '" + currency.JPY + "' THEN 1 ELSE 100 END"
+
+	legacy := []struct {
+		model  any
+		table  string
+		column string
+		update string
+	}{
+		{&entity.Room{}, "rooms", "base_price", "UPDATE rooms SET base_price_minor = base_price * " + scale + " WHERE base_price_minor IS NULL AND base_price IS NOT NULL"},
+		{&entity.RoomRate{}, "room_rates", "price", `UPDATE room_rates SET
+			currency = (SELECT rooms.currency FROM rooms WHERE rooms.room_id = room_rates.room_id),
+			price_minor = price * (SELECT ` + scale + ` FROM rooms WHERE rooms.room_id = room_rates.room_id)
+			WHERE price_minor IS NULL AND price IS NOT NULL`},
+		{&entity.TaxFee{}, "tax_fees", "amount", "UPDATE tax_fees SET amount_minor = ROUND(amount * " + scale + ") WHERE amount_minor IS NULL AND amount IS NOT NULL"},
+	}
+
+	return db.Transaction(func(tx *gorm.DB) error {
+		for _, l := range legacy {
+			if !tx.Migrator().HasColumn(l.model, l.column) {
+				continue
+			}
+
+			result := tx.Exec(l.update)
+			if result.Error != nil {
+				return result.Error
+			}
+
+			if result.RowsAffected > 0 {
+				slog.Info("[INFRA]", "message", "Backfilled minor unit amounts", "table", l.table, "rows", result.RowsAffected)
+			}
+		}
+		return nil
+	})
+}
+
+// backfillOccupancy gives rooms created before occupancy was stored the default occupancy of
+// their room type, so they keep being priced for as many guests as their type sleeps.
+func backfillOccupancy(db *gorm.DB) error {
+	result := db.Exec(`UPDATE rooms SET base_occupancy = ?, max_occupancy = CASE type WHEN ? THEN ? WHEN ? THEN ? ELSE ? END
+		WHERE max_occupancy IS NULL OR max_occupancy = 0`,
+		roomtype.DefaultBaseOccupancy,
+		roomtype.Suite, roomtype.DefaultMaxOccupancy(roomtype.Suite),
+		roomtype.Family, roomtype.DefaultMaxOccupancy(roomtype.Family),
+		roomtype.DefaultMaxOccupancy(roomtype.Standard),
+	)
+	if result.Error != nil {
+		return result.Error
+	}
+
+	if result.RowsAffected > 0 {
+		slog.Info("[INFRA]", "message", "Backfilled room occupancy", "rooms", result.RowsAffected)
+	}
+	return nil
+}
+
+// backfillPriceHistory starts the price history of rooms and rates that have none with their
+// current price, effective from their last update
+func backfillPriceHistory(db *gorm.DB) error {
+	var current []struct {
+		RoomID     string
+		Source     string
+		Date       string
+		PriceMinor int64
+		Currency   string
+		UpdatedAt  int64
+	}
+	if err := db.Raw(`SELECT room_id, ? AS source, '' AS date, base_price_minor AS price_minor, currency, updated_at FROM rooms
+		WHERE NOT EXISTS (SELECT 1 FROM price_histories p WHERE p.room_id = rooms.room_id AND p.source = ?)
+		UNION ALL
+		SELECT room_id, ? AS source, date, price_minor, currency, updated_at FROM room_rates
+		WHERE NOT EXISTS (SELECT 1 FROM price_histories p WHERE p.room_id = room_rates.room_id AND p.date = room_rates.date)`,
+		domain.RateSourceBasePrice, domain.RateSourceBasePrice, domain.RateSourceCalendar,
+	).Scan(&current).Error; err != nil {
+		return err
+	}
+	if len(current) == 0 {
+		return nil
+	}
+
+	points := make([]entity.PriceHistory, len(current))
+	for i, c := range current {
+		points[i] = entity.PriceHistory{
+			PriceID:     uuid.NewString(),
+			RoomID:      c.RoomID,
+			Source:      c.Source,
+			Date:        c.Date,
+			PriceMinor:  &c.PriceMinor,
+			Currency:    c.Currency,
+			Actor:       domain.UnknownActor,
+			EffectiveAt: toUnixNano(c.UpdatedAt),
+		}
+	}
+	if err := db.CreateInBatches(&points, 500).Error; err != nil {
+		return err
+	}
+
+	slog.Info("[INFRA]", "message", "Backfilled price history", "prices", len(points))
+	return nil
+}
+
+// toUnixNano reads an updated_at column, rooms are stamped in nanoseconds since optimistic locking
+// while rates and rooms of older databases are stamped in seconds
+func toUnixNano(ts int64) int64 {
+	if ts > 1e12 {
+		return ts
+	}
+	return time.Unix(ts, 0).UnixNano()
+}
+
+// backfillRatePlans gives hotels created before rate plans existed a best available rate plan,
+// the room's own price and cancellation policy they were sold at until then. It only runs when
+// the rate plan table is created, hotels created since get their plan from the service.
+func backfillRatePlans(db *gorm.DB) error {
+	var hotelIDs []string
+	if err := db.Model(&entity.Hotel{}).
+		Where("NOT EXISTS (SELECT 1 FROM rate_plans p WHERE p.hotel_id = hotels.hotel_id)").
+		Pluck("hotel_id", &hotelIDs).Error; err != nil {
+		return err
+	}
+	if len(hotelIDs) == 0 {
+		return nil
+	}
+
+	plans := make([]entity.RatePlan, len(hotelIDs))
+	for i, hotelID := range hotelIDs {
+		plans[i] = bestAvailableRate(hotelID)
+	}
+	if err := db.Create(&plans).Error; err != nil {
+		return err
+	}
+
+	slog.Info("[INFRA]", "message", "Backfilled rate plans", "hotels", len(plans))
+	return nil
+}
+
+func bestAvailableRate(hotelID string) entity.RatePlan {
+	plan := domain.BestAvailableRate(hotelID)
+	plan.ID = uuid.NewString()
+	return *mapper.ToEntityRatePlan(&plan)
+}
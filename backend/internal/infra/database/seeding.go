@@ -8,8 +8,12 @@ import (
 
 	"github.com/chayutK/hotel-property-service/internal/adapter/entity"
 	"github.com/chayutK/hotel-property-service/internal/constants/cancellationpolicy"
+	"github.com/chayutK/hotel-property-service/internal/constants/currency"
+	"github.com/chayutK/hotel-property-service/internal/constants/roomtype"
+	"github.com/chayutK/hotel-property-service/internal/domain"
 	"github.com/google/uuid"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 // RunSeeder seeds the database with sample data for hotels, facilities, rooms and benefits.
@@ -41,21 +45,28 @@ func RunSeeder(db *gorm.DB) error {
 		streets := []string{"Sukhumvit Rd", "Silom Rd", "Rama IV Rd", "Nimmanhemin Rd", "Patong Beach Rd"}
 		cities := []string{"Bangkok", "Chiang Mai", "Phuket", "Pattaya", "Hua Hin"}
 
-		facilityCatalog := []struct{ Name, Desc string }{
-			{"Swimming Pool", "Outdoor heated pool"},
-			{"Fitness Center", "24/7 gym with modern equipment"},
-			{"Spa", "Full service spa and massage"},
-			{"Rooftop Bar", "City views and signature cocktails"},
-			{"Airport Shuttle", "Scheduled airport transfers"},
-			{"Free Parking", "Complimentary on-site parking"},
-			{"Kids Club", "Supervised activities for children"},
+		facilityCatalog := []entity.FacilityCatalog{
+			{Code: "SWIMMING_POOL", Name: "Swimming Pool", Description: "Outdoor heated pool", IsActive: true},
+			{Code: "FITNESS_CENTER", Name: "Fitness Center", Description: "24/7 gym with modern equipment", IsActive: true},
+			{Code: "SPA", Name: "Spa", Description: "Full service spa and massage", IsActive: true},
+			{Code: "ROOFTOP_BAR", Name: "Rooftop Bar", Description: "City views and signature cocktails", IsActive: true},
+			{Code: "AIRPORT_SHUTTLE", Name: "Airport Shuttle", Description: "Scheduled airport transfers", IsActive: true},
+			{Code: "FREE_PARKING", Name: "Free Parking", Description: "Complimentary on-site parking", IsActive: true},
+			{Code: "KIDS_CLUB", Name: "Kids Club", Description: "Supervised activities for children", IsActive: true},
+		}
+		// the catalog may already hold entries backfilled from older data
+		if err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&facilityCatalog).Error; err != nil {
+			return err
 		}
 
-		roomTemplates := []struct{ Name, Type string }{
-			{"Superior King", "standard"},
-			{"Deluxe Twin", "deluxe"},
-			{"Executive Suite", "suite"},
-			{"Family Room", "family"},
+		roomTemplates := []struct {
+			Name, Type, BedType string
+			SizeSqm, BedCount   int
+		}{
+			{"Superior King", roomtype.Standard, "King", 28, 1},
+			{"Deluxe Twin", roomtype.Deluxe, "Twin", 34, 2},
+			{"Executive Suite", roomtype.Suite, "King", 60, 1},
+			{"Family Room", roomtype.Family, "Queen", 45, 2},
 		}
 
 		benefitPool := []struct{ Name, Desc string }{
@@ -100,47 +111,100 @@ func RunSeeder(db *gorm.DB) error {
 					continue
 				}
 				picks[idx] = struct{}{}
-				pick := facilityCatalog[idx]
 				facilities = append(facilities, entity.Facility{
-					FacilityID:  uuid.NewString(),
-					HotelID:     hotelID,
-					Name:        pick.Name,
-					Description: pick.Desc,
-					IsActive:    true,
+					FacilityID: uuid.NewString(),
+					HotelID:    hotelID,
+					Code:       facilityCatalog[idx].Code,
+					IsActive:   true,
 				})
 			}
 			if err := tx.Create(&facilities).Error; err != nil {
 				return err
 			}
 
-			// create 3-6 rooms with varied names/prices
+			// every hotel sells its rooms at the best available rate, to members and ahead
+			ratePlans := []entity.RatePlan{
+				bestAvailableRate(hotelID),
+				{
+					RatePlanID:  uuid.NewString(),
+					HotelID:     hotelID,
+					Code:        "MEMBER",
+					Name:        "Member Rate",
+					Description: "10% off with breakfast for loyalty members",
+					Kind:        domain.RatePlanMember,
+					Multiplier:  0.9,
+					Inclusions:  `["Breakfast"]`,
+					MembersOnly: true,
+					IsActive:    true,
+				},
+				{
+					RatePlanID:           uuid.NewString(),
+					HotelID:              hotelID,
+					Code:                 "ADVANCE14",
+					Name:                 "Advance Purchase",
+					Description:          "15% off stays booked 14 days ahead, non-refundable",
+					Kind:                 domain.RatePlanAdvancePurchase,
+					Multiplier:           0.85,
+					CancellationPolicyID: cancellationpolicy.NonRefundable,
+					MinDaysBeforeArrival: 14,
+					IsActive:             true,
+				},
+			}
+			if err := tx.Create(&ratePlans).Error; err != nil {
+				return err
+			}
+
+			// create 2-3 physical rooms, each sold as a refundable and a non-refundable offer
+			physicalRooms := []entity.PhysicalRoom{}
 			rooms := []entity.Room{}
-			rcount := 3 + rand.Intn(4)
-			for ri := 0; ri < rcount; ri++ {
-				physID := uuid.NewString()
+			pcount := 2 + rand.Intn(2)
+			for ri := 0; ri < pcount; ri++ {
 				tmpl := roomTemplates[rand.Intn(len(roomTemplates))]
+				physicalRoom := entity.PhysicalRoom{
+					PhysicalRoomID: uuid.NewString(),
+					HotelID:        hotelID,
+					Name:           tmpl.Name,
+					Description:    fmt.Sprintf("%s with modern amenities", tmpl.Name),
+					Type:           tmpl.Type,
+					SizeSqm:        tmpl.SizeSqm,
+					BedType:        tmpl.BedType,
+					BedCount:       tmpl.BedCount,
+					UnitCount:      5 + rand.Intn(20),
+					IsActive:       true,
+				}
+				physicalRooms = append(physicalRooms, physicalRoom)
+
 				// base price varies by hotel index and room type
 				base := 1500 + rand.Intn(6000) + i*1000 + ri*500
-				rooms = append(rooms, entity.Room{
-					RoomID:             uuid.NewString(),
-					PhysicalRoomID:     physID,
-					HotelID:            hotelID,
-					Name:               tmpl.Name,
-					Description:        fmt.Sprintf("%s with modern amenities", tmpl.Name),
-					Type:               tmpl.Type,
-					BasePrice:          int64(base),
-					Currency:           "THB",
-					CancellationPolicy: []string{cancellationpolicy.FreeCancellation, cancellationpolicy.NonRefundable}[rand.Intn(2)],
-					IsActive:           true,
-				})
+				for _, policy := range []string{cancellationpolicy.FreeCancellation, cancellationpolicy.NonRefundable} {
+					rooms = append(rooms, entity.Room{
+						RoomID:               uuid.NewString(),
+						PhysicalRoomID:       physicalRoom.PhysicalRoomID,
+						HotelID:              hotelID,
+						Name:                 tmpl.Name,
+						Description:          physicalRoom.Description,
+						Type:                 tmpl.Type,
+						BasePriceMinor:       int64(base) * 100,
+						Currency:             currency.THB,
+						BaseOccupancy:        roomtype.DefaultBaseOccupancy,
+						MaxOccupancy:         roomtype.DefaultMaxOccupancy(tmpl.Type),
+						ExtraAdultMinor:      80000,
+						ChildCharges:         "2:0,11:40000",
+						CancellationPolicyID: policy,
+						IsActive:             true,
+					})
+				}
+			}
+			if err := tx.Create(&physicalRooms).Error; err != nil {
+				return err
 			}
 			if err := tx.Create(&rooms).Error; err != nil {
 				return err
 			}
 
-			// assign 1-3 benefits per room, sampled from pool
+			// assign 1-3 benefits per physical room, sampled from pool
 			benefits := []entity.Benefit{}
-			for _, r := range rooms {
+			for _, r := range physicalRooms {
 				bcount := 1 + rand.Intn(3)
 				// pick bcount unique benefits
 				picks := map[int]struct{}{}
@@ -170,6 +234,11 @@ func RunSeeder(db *gorm.DB) error {
 			}
 		}
 
+		// seeded prices start the price history
+		if err := backfillPriceHistory(tx); err != nil {
+			return err
+		}
+
 		slog.Info("[SEED]", "message", "Database seeding completed")
 		return nil
 	})
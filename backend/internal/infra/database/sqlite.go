@@ -48,11 +48,25 @@ func ensureDir(path string) error {
 func Migrate(db *gorm.DB) error {
 	slog.Info("[INFRA]", "message", "Running database migrations...")
 
+	hasRatePlans := db.Migrator().HasTable(&entity.RatePlan{})
+
 	err := db.AutoMigrate(
+		&entity.FacilityCatalog{},
+		&entity.CancellationPolicy{},
 		&entity.Facility{},
 		&entity.Benefit{},
 		&entity.Hotel{},
+		&entity.PhysicalRoom{},
 		&entity.Room{},
+		&entity.RoomRate{},
+		&entity.PricingRule{},
+		&entity.TaxFee{},
+		&entity.ExchangeRate{},
+		&entity.Promotion{},
+		&entity.StayRule{},
+		&entity.PriceHistory{},
+		&entity.RatePlan{},
+		&entity.AuditLog{},
 	)
 
 	if err != nil {
@@ -60,6 +74,48 @@ func Migrate(db *gorm.DB) error {
 		return err
 	}
 
+	if err := backfillFacilityCodes(db); err != nil {
+		slog.Error("[INFRA]", "message", "Failed to backfill facility codes", "error", err.Error())
+		return err
+	}
+
+	if err := repairFacilityCodes(db); err != nil {
+		slog.Error("[INFRA]", "message", "Failed to repair facility codes", "error", err.Error())
+		return err
+	}
+
+	if err := backfillPhysicalRooms(db); err != nil {
+		slog.Error("[INFRA]", "message", "Failed to backfill physical rooms", "error", err.Error())
+		return err
+	}
+
+	if err := backfillCancellationPolicies(db); err != nil {
+		slog.Error("[INFRA]", "message", "Failed to backfill cancellation policies", "error", err.Error())
+		return err
+	}
+
+	if err := backfillMinorUnits(db); err != nil {
+		slog.Error("[INFRA]", "message", "Failed to backfill minor unit amounts", "error", err.Error())
+		return err
+	}
+
+	if err := backfillOccupancy(db); err != nil {
+		slog.Error("[INFRA]", "message", "Failed to backfill room occupancy", "error", err.Error())
+		return err
+	}
+
+	if err := backfillPriceHistory(db); err != nil {
+		slog.Error("[INFRA]", "message", "Failed to backfill price history", "error", err.Error())
+		return err
+	}
+
+	if !hasRatePlans {
+		if err := backfillRatePlans(db); err != nil {
+			slog.Error("[INFRA]", "message", "Failed to backfill rate plans", "error", err.Error())
+			return err
+		}
+	}
+
 	slog.Info("[INFRA]", "message", "Database migrations completed successfully!")
 	return nil
 }
@@ -0,0 +1,13 @@
+package port
+
+import (
+	"context"
+
+	"github.com/chayutK/hotel-property-service/internal/domain"
+)
+
+type AuditPort interface {
+	Record(ctx context.Context, entries []domain.AuditEntry) error
+	// Find returns matching entries, newest first
+	Find(ctx context.Context, filter domain.AuditFilter) ([]domain.AuditEntry, error)
+}
@@ -0,0 +1,15 @@
+package port
+
+import (
+	"context"
+
+	"github.com/chayutK/hotel-property-service/internal/domain"
+)
+
+type BenefitPort interface {
+	FindByPhysicalRoomID(ctx context.Context, physicalRoomID string) ([]domain.Benefit, error)
+	FindByID(ctx context.Context, benefitID string) (*domain.Benefit, error)
+	Create(ctx context.Context, benefit *domain.Benefit) error
+	Update(ctx context.Context, benefit *domain.Benefit) error
+	Deactivate(ctx context.Context, benefitID string) error
+}
@@ -0,0 +1,17 @@
+package port
+
+import (
+	"context"
+
+	"github.com/chayutK/hotel-property-service/internal/domain"
+)
+
+type CancellationPolicyPort interface {
+	FindAll(ctx context.Context) ([]domain.CancellationPolicy, error)
+	FindByID(ctx context.Context, policyID string) (*domain.CancellationPolicy, error)
+	Create(ctx context.Context, policy *domain.CancellationPolicy) error
+	Update(ctx context.Context, policy *domain.CancellationPolicy) error
+	Deactivate(ctx context.Context, policyID string) error
+	// IsInUse reports whether an active room or rate plan references the policy
+	IsInUse(ctx context.Context, policyID string) (bool, error)
+}
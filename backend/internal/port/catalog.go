@@ -0,0 +1,18 @@
+package port
+
+import (
+	"context"
+
+	"github.com/chayutK/hotel-property-service/internal/domain"
+)
+
+type CatalogPort interface {
+	// Load returns the whole facility catalog, every cancellation policy and every row, active
+	// or not, belonging to the given hotels. A nil hotelIDs loads all hotels.
+	Load(ctx context.Context, hotelIDs []string) (*domain.CatalogRecords, error)
+	// LoadByIDs returns the stored facilities, physical rooms, rooms and benefits that have the ID
+	// of a row of records, whichever hotel they belong to, and the physical rooms of those benefits
+	LoadByIDs(ctx context.Context, records *domain.CatalogRecords) (*domain.CatalogRecords, error)
+	// Upsert inserts or overwrites all given rows in a single transaction
+	Upsert(ctx context.Context, records *domain.CatalogRecords) error
+}
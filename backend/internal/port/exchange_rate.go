@@ -0,0 +1,18 @@
+package port
+
+import (
+	"context"
+
+	"github.com/chayutK/hotel-property-service/internal/domain"
+)
+
+type ExchangeRatePort interface {
+	// FindAll returns the exchange rates of every pair, or only of the pairs from and to when
+	// they are set
+	FindAll(ctx context.Context, from, to string) ([]domain.ExchangeRate, error)
+	// FindEffective returns the rate of the pair effective on date, domain.ErrNoExchangeRate
+	// when there is none
+	FindEffective(ctx context.Context, from, to, date string) (*domain.ExchangeRate, error)
+	Upsert(ctx context.Context, rates []domain.ExchangeRate) error
+	Delete(ctx context.Context, from, to, date string) error
+}
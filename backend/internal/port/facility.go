@@ -0,0 +1,18 @@
+package port
+
+import (
+	"context"
+
+	"github.com/chayutK/hotel-property-service/internal/domain"
+)
+
+type FacilityPort interface {
+	FindCatalog(ctx context.Context) ([]domain.FacilityCatalogEntry, error)
+	FindCatalogByCode(ctx context.Context, code string) (*domain.FacilityCatalogEntry, error)
+	CreateCatalogEntry(ctx context.Context, entry *domain.FacilityCatalogEntry) error
+	UpdateCatalogEntry(ctx context.Context, entry *domain.FacilityCatalogEntry) error
+	// FindByHotelID returns every facility ever attached to the hotel, detached ones included
+	FindByHotelID(ctx context.Context, hotelID string) ([]domain.Facility, error)
+	Create(ctx context.Context, facility *domain.Facility) error
+	Update(ctx context.Context, facility *domain.Facility) error
+}
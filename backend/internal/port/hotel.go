@@ -7,6 +7,10 @@ import (
 )
 
 type HotelPort interface {
-	FindAll(ctx context.Context) ([]domain.Hotel, error)
+	FindAll(ctx context.Context, filter domain.HotelFilter) ([]domain.Hotel, error)
 	FindByID(ctx context.Context, id string) (*domain.Hotel, error)
+	Create(ctx context.Context, hotel *domain.Hotel) error
+	Update(ctx context.Context, hotel *domain.Hotel) error
+	// Deactivate only deactivates a hotel still at updatedAt, when not 0, like Update
+	Deactivate(ctx context.Context, id string, updatedAt int64) error
 }
@@ -0,0 +1,16 @@
+package port
+
+import (
+	"context"
+
+	"github.com/chayutK/hotel-property-service/internal/domain"
+)
+
+type PhysicalRoomPort interface {
+	FindByHotelID(ctx context.Context, hotelID string) ([]domain.PhysicalRoom, error)
+	FindByID(ctx context.Context, physicalRoomID string) (*domain.PhysicalRoom, error)
+	Create(ctx context.Context, physicalRoom *domain.PhysicalRoom) error
+	Update(ctx context.Context, physicalRoom *domain.PhysicalRoom) error
+	// Deactivate retires the physical room together with all of its offers
+	Deactivate(ctx context.Context, physicalRoomID string) error
+}
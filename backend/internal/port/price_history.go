@@ -0,0 +1,13 @@
+package port
+
+import (
+	"context"
+
+	"github.com/chayutK/hotel-property-service/internal/domain"
+)
+
+type PriceHistoryPort interface {
+	Record(ctx context.Context, points []domain.PricePoint) error
+	// Find returns the matching points, oldest first
+	Find(ctx context.Context, filter domain.PriceHistoryFilter) ([]domain.PricePoint, error)
+}
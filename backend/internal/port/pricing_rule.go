@@ -0,0 +1,15 @@
+package port
+
+import (
+	"context"
+
+	"github.com/chayutK/hotel-property-service/internal/domain"
+)
+
+type PricingRulePort interface {
+	FindByHotelID(ctx context.Context, hotelID string) ([]domain.PricingRule, error)
+	FindByID(ctx context.Context, ruleID string) (*domain.PricingRule, error)
+	Create(ctx context.Context, rule *domain.PricingRule) error
+	Update(ctx context.Context, rule *domain.PricingRule) error
+	Deactivate(ctx context.Context, ruleID string) error
+}
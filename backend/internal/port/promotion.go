@@ -0,0 +1,18 @@
+package port
+
+import (
+	"context"
+
+	"github.com/chayutK/hotel-property-service/internal/domain"
+)
+
+type PromotionPort interface {
+	FindAll(ctx context.Context) ([]domain.Promotion, error)
+	FindByID(ctx context.Context, promotionID string) (*domain.Promotion, error)
+	Create(ctx context.Context, promotion *domain.Promotion) error
+	Update(ctx context.Context, promotion *domain.Promotion) error
+	Deactivate(ctx context.Context, promotionID string) error
+	// Redeem counts a use of the promotion, it returns domain.ErrPromotionUsedUp once the usage
+	// cap is reached
+	Redeem(ctx context.Context, promotionID string) error
+}
@@ -0,0 +1,15 @@
+package port
+
+import (
+	"context"
+
+	"github.com/chayutK/hotel-property-service/internal/domain"
+)
+
+type RatePlanPort interface {
+	FindByHotelID(ctx context.Context, hotelID string) ([]domain.RatePlan, error)
+	FindByID(ctx context.Context, ratePlanID string) (*domain.RatePlan, error)
+	Create(ctx context.Context, plan *domain.RatePlan) error
+	Update(ctx context.Context, plan *domain.RatePlan) error
+	Deactivate(ctx context.Context, ratePlanID string) error
+}
@@ -9,4 +9,13 @@ import (
 type RoomPort interface {
 	FindByHotelID(ctx context.Context, hotelID string) ([]domain.Room, error)
 	FindByRoomID(ctx context.Context, roomID string) (*domain.Room, error)
+	// FindByRoomIDs returns the active rooms among roomIDs, missing ones are left out
+	FindByRoomIDs(ctx context.Context, roomIDs []string) ([]domain.Room, error)
+	// FindCurrenciesByHotelID returns the currencies the active rooms of the hotel are sold in,
+	// whether or not their activation window is open
+	FindCurrenciesByHotelID(ctx context.Context, hotelID string) ([]string, error)
+	Create(ctx context.Context, room *domain.Room) error
+	Update(ctx context.Context, room *domain.Room) error
+	// Deactivate only deactivates a room still at updatedAt, when not 0, like Update
+	Deactivate(ctx context.Context, roomID string, updatedAt int64) error
 }
@@ -0,0 +1,17 @@
+package port
+
+import (
+	"context"
+
+	"github.com/chayutK/hotel-property-service/internal/domain"
+)
+
+type RoomRatePort interface {
+	// FindByRoomID returns the rates of the nights from, inclusive, to to, exclusive. An empty
+	// bound leaves that side open.
+	FindByRoomID(ctx context.Context, roomID, from, to string) ([]domain.RoomRate, error)
+	// FindByRoomIDs returns the rates of the rooms of roomIDs like FindByRoomID, by room and date
+	FindByRoomIDs(ctx context.Context, roomIDs []string, from, to string) ([]domain.RoomRate, error)
+	Upsert(ctx context.Context, rates []domain.RoomRate) error
+	Delete(ctx context.Context, roomID, date string) error
+}
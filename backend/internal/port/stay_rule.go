@@ -0,0 +1,15 @@
+package port
+
+import (
+	"context"
+
+	"github.com/chayutK/hotel-property-service/internal/domain"
+)
+
+type StayRulePort interface {
+	FindByHotelID(ctx context.Context, hotelID string) ([]domain.StayRule, error)
+	FindByID(ctx context.Context, ruleID string) (*domain.StayRule, error)
+	Create(ctx context.Context, rule *domain.StayRule) error
+	Update(ctx context.Context, rule *domain.StayRule) error
+	Deactivate(ctx context.Context, ruleID string) error
+}
@@ -0,0 +1,15 @@
+package port
+
+import (
+	"context"
+
+	"github.com/chayutK/hotel-property-service/internal/domain"
+)
+
+type TaxFeePort interface {
+	FindByHotelID(ctx context.Context, hotelID string) ([]domain.TaxFee, error)
+	FindByID(ctx context.Context, taxFeeID string) (*domain.TaxFee, error)
+	Create(ctx context.Context, taxFee *domain.TaxFee) error
+	Update(ctx context.Context, taxFee *domain.TaxFee) error
+	Deactivate(ctx context.Context, taxFeeID string) error
+}
@@ -0,0 +1,10 @@
+package port
+
+import "context"
+
+type TransactionPort interface {
+	// WithinTransaction runs fn in one database transaction, repositories given the ctx fn is
+	// called with take part in it. The transaction is rolled back when fn returns an error and
+	// committed otherwise, fn runs in the transaction of ctx when there already is one.
+	WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+}
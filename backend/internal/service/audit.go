@@ -0,0 +1,71 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/chayutK/hotel-property-service/internal/domain"
+	"github.com/chayutK/hotel-property-service/internal/port"
+	"github.com/google/uuid"
+)
+
+// defaultAuditLimit caps audit queries that do not ask for a size
+const defaultAuditLimit = 100
+
+type AuditService struct {
+	auditRepository port.AuditPort
+}
+
+func NewAuditService(auditRepository port.AuditPort) *AuditService {
+	return &AuditService{
+		auditRepository: auditRepository,
+	}
+}
+
+func (s *AuditService) GetAuditLog(ctx context.Context, filter domain.AuditFilter) ([]domain.AuditEntry, error) {
+	if filter.Limit <= 0 {
+		filter.Limit = defaultAuditLimit
+	}
+
+	return s.auditRepository.Find(ctx, filter)
+}
+
+// recordChange audits one changed row on behalf of the actor in ctx. before is the zero value
+// for created rows; nothing is recorded when no field changed.
+func recordChange(ctx context.Context, auditRepository port.AuditPort, entity, action, id string, before, after any) error {
+	fields := domain.DiffFields(before, after)
+	if len(fields) == 0 {
+		return nil
+	}
+
+	return recordChanges(ctx, auditRepository, []domain.CatalogChange{{Entity: entity, ID: id, Action: action, Fields: fields}})
+}
+
+// deactivation is the change of an active row being deactivated
+func deactivation(entity, id string) domain.CatalogChange {
+	return domain.CatalogChange{
+		Entity: entity,
+		ID:     id,
+		Action: domain.ActionDeactivate,
+		Fields: []domain.FieldChange{{Field: "isActive", Before: true, After: false}},
+	}
+}
+
+func recordChanges(ctx context.Context, auditRepository port.AuditPort, changes []domain.CatalogChange) error {
+	actor, at := domain.ActorFrom(ctx), time.Now().UTC()
+
+	entries := make([]domain.AuditEntry, len(changes))
+	for i, change := range changes {
+		entries[i] = domain.AuditEntry{
+			ID:       uuid.NewString(),
+			Entity:   change.Entity,
+			EntityID: change.ID,
+			Action:   change.Action,
+			Actor:    actor,
+			Fields:   change.Fields,
+			At:       at,
+		}
+	}
+
+	return auditRepository.Record(ctx, entries)
+}
@@ -0,0 +1,154 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/chayutK/hotel-property-service/internal/domain"
+	"github.com/chayutK/hotel-property-service/internal/port"
+	"github.com/google/uuid"
+)
+
+// BenefitService manages benefits of a physical room. Every sellable room offer sharing the
+// physical room shows its benefits, so each use case also reports the affected room IDs.
+type BenefitService struct {
+	physicalRoomRepository port.PhysicalRoomPort
+	benefitRepository      port.BenefitPort
+	auditRepository        port.AuditPort
+	transactor             port.TransactionPort
+}
+
+func NewBenefitService(physicalRoomRepository port.PhysicalRoomPort, benefitRepository port.BenefitPort, auditRepository port.AuditPort, transactor port.TransactionPort) *BenefitService {
+	return &BenefitService{
+		physicalRoomRepository: physicalRoomRepository,
+		benefitRepository:      benefitRepository,
+		auditRepository:        auditRepository,
+		transactor:             transactor,
+	}
+}
+
+func (s *BenefitService) GetBenefits(ctx context.Context, hotelID, physicalRoomID string) ([]domain.Benefit, []string, error) {
+	roomIDs, err := s.getRoomIDs(ctx, hotelID, physicalRoomID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	benefits, err := s.benefitRepository.FindByPhysicalRoomID(ctx, physicalRoomID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return benefits, roomIDs, nil
+}
+
+func (s *BenefitService) CreateBenefit(ctx context.Context, hotelID string, benefit *domain.Benefit) (*domain.Benefit, []string, error) {
+	roomIDs, err := s.getRoomIDs(ctx, hotelID, benefit.PhysicalRoomID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	benefit.ID = uuid.NewString()
+	benefit.IsActive = true
+
+	err = s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.benefitRepository.Create(ctx, benefit); err != nil {
+			return err
+		}
+
+		var err error
+		if benefit, err = s.benefitRepository.FindByID(ctx, benefit.ID); err != nil {
+			return err
+		}
+
+		return recordChange(ctx, s.auditRepository, domain.EntityBenefit, domain.ActionCreate, benefit.ID, &domain.Benefit{}, benefit)
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return benefit, roomIDs, nil
+}
+
+func (s *BenefitService) UpdateBenefit(ctx context.Context, hotelID string, benefit *domain.Benefit) (*domain.Benefit, []string, error) {
+	var roomIDs []string
+	err := s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		before, ids, err := s.getBenefit(ctx, hotelID, benefit.PhysicalRoomID, benefit.ID)
+		if err != nil {
+			return err
+		}
+		roomIDs = ids
+
+		if err := s.benefitRepository.Update(ctx, benefit); err != nil {
+			return err
+		}
+
+		if benefit, err = s.benefitRepository.FindByID(ctx, benefit.ID); err != nil {
+			return err
+		}
+
+		return recordChange(ctx, s.auditRepository, domain.EntityBenefit, domain.ActionUpdate, benefit.ID, before, benefit)
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return benefit, roomIDs, nil
+}
+
+func (s *BenefitService) RetireBenefit(ctx context.Context, hotelID, physicalRoomID, benefitID string) error {
+	return s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		before, _, err := s.getBenefit(ctx, hotelID, physicalRoomID, benefitID)
+		if err != nil {
+			return err
+		}
+
+		if err := s.benefitRepository.Deactivate(ctx, benefitID); err != nil {
+			return err
+		}
+
+		after := *before
+		after.IsActive = false
+		return recordChange(ctx, s.auditRepository, domain.EntityBenefit, domain.ActionDeactivate, benefitID, before, &after)
+	})
+}
+
+// getBenefit checks that the benefit belongs to the physical room before resolving its reach.
+func (s *BenefitService) getBenefit(ctx context.Context, hotelID, physicalRoomID, benefitID string) (*domain.Benefit, []string, error) {
+	roomIDs, err := s.getRoomIDs(ctx, hotelID, physicalRoomID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	current, err := s.benefitRepository.FindByID(ctx, benefitID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if current.PhysicalRoomID != physicalRoomID {
+		slog.Error("[SERVICE]", "message", fmt.Sprintf("physicalRoomID does not match with benefit, benefit.PhysicalRoomID:%s, physicalRoomID:%s", current.PhysicalRoomID, physicalRoomID))
+		return nil, nil, fmt.Errorf("physicalRoomID does not match with benefit")
+	}
+
+	return current, roomIDs, nil
+}
+
+// getRoomIDs returns the active room offers sharing the physical room, which must belong to the hotel.
+func (s *BenefitService) getRoomIDs(ctx context.Context, hotelID, physicalRoomID string) ([]string, error) {
+	physicalRoom, err := s.physicalRoomRepository.FindByID(ctx, physicalRoomID)
+	if err != nil {
+		return nil, err
+	}
+
+	if physicalRoom.HotelID != hotelID {
+		slog.Error("[SERVICE]", "message", fmt.Sprintf("hotelID does not match with physical room, physicalRoom.HotelID:%s, hotelID:%s", physicalRoom.HotelID, hotelID))
+		return nil, fmt.Errorf("hotelID does not match with physical room")
+	}
+
+	roomIDs := make([]string, len(physicalRoom.Offers))
+	for i, room := range physicalRoom.Offers {
+		roomIDs[i] = room.ID
+	}
+
+	return roomIDs, nil
+}
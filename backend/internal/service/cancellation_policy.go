@@ -0,0 +1,110 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/chayutK/hotel-property-service/internal/domain"
+	"github.com/chayutK/hotel-property-service/internal/port"
+	"github.com/google/uuid"
+)
+
+type CancellationPolicyService struct {
+	cancellationPolicyRepository port.CancellationPolicyPort
+	auditRepository              port.AuditPort
+	transactor                   port.TransactionPort
+}
+
+func NewCancellationPolicyService(cancellationPolicyRepository port.CancellationPolicyPort, auditRepository port.AuditPort, transactor port.TransactionPort) *CancellationPolicyService {
+	return &CancellationPolicyService{
+		cancellationPolicyRepository: cancellationPolicyRepository,
+		auditRepository:              auditRepository,
+		transactor:                   transactor,
+	}
+}
+
+func (s *CancellationPolicyService) GetCancellationPolicies(ctx context.Context) ([]domain.CancellationPolicy, error) {
+	return s.cancellationPolicyRepository.FindAll(ctx)
+}
+
+func (s *CancellationPolicyService) GetCancellationPolicy(ctx context.Context, policyID string) (*domain.CancellationPolicy, error) {
+	return s.cancellationPolicyRepository.FindByID(ctx, policyID)
+}
+
+func (s *CancellationPolicyService) CreateCancellationPolicy(ctx context.Context, policy *domain.CancellationPolicy) (*domain.CancellationPolicy, error) {
+	policy.ID = uuid.NewString()
+	policy.IsActive = true
+
+	var created *domain.CancellationPolicy
+	err := s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.cancellationPolicyRepository.Create(ctx, policy); err != nil {
+			return err
+		}
+
+		var err error
+		if created, err = s.cancellationPolicyRepository.FindByID(ctx, policy.ID); err != nil {
+			return err
+		}
+
+		return recordChange(ctx, s.auditRepository, domain.EntityCancellationPolicy, domain.ActionCreate, created.ID, &domain.CancellationPolicy{}, created)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+func (s *CancellationPolicyService) UpdateCancellationPolicy(ctx context.Context, policy *domain.CancellationPolicy) (*domain.CancellationPolicy, error) {
+	var updated *domain.CancellationPolicy
+	err := s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		before, err := s.cancellationPolicyRepository.FindByID(ctx, policy.ID)
+		if err != nil {
+			return err
+		}
+
+		if err := s.cancellationPolicyRepository.Update(ctx, policy); err != nil {
+			return err
+		}
+
+		if updated, err = s.cancellationPolicyRepository.FindByID(ctx, policy.ID); err != nil {
+			return err
+		}
+
+		return recordChange(ctx, s.auditRepository, domain.EntityCancellationPolicy, domain.ActionUpdate, updated.ID, before, updated)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+// DeactivateCancellationPolicy retires a policy no active room or rate plan is sold with anymore
+func (s *CancellationPolicyService) DeactivateCancellationPolicy(ctx context.Context, policyID string) error {
+	return s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		before, err := s.cancellationPolicyRepository.FindByID(ctx, policyID)
+		if err != nil {
+			return err
+		}
+
+		inUse, err := s.cancellationPolicyRepository.IsInUse(ctx, policyID)
+		if err != nil {
+			return err
+		}
+
+		if inUse {
+			slog.Error("[SERVICE]", "message", fmt.Sprintf("cancellation policy is still used by active rooms or rate plans, policyID:%s", policyID))
+			return fmt.Errorf("cancellation policy is still used by active rooms or rate plans")
+		}
+
+		if err := s.cancellationPolicyRepository.Deactivate(ctx, policyID); err != nil {
+			return err
+		}
+
+		after := *before
+		after.IsActive = false
+		return recordChange(ctx, s.auditRepository, domain.EntityCancellationPolicy, domain.ActionDeactivate, policyID, before, &after)
+	})
+}
@@ -0,0 +1,371 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/chayutK/hotel-property-service/internal/domain"
+	"github.com/chayutK/hotel-property-service/internal/port"
+	"github.com/google/uuid"
+)
+
+// CatalogValidationError lists every problem found in a catalog before anything is written
+type CatalogValidationError struct {
+	Problems []string
+}
+
+func (e *CatalogValidationError) Error() string {
+	return "invalid catalog: " + strings.Join(e.Problems, "; ")
+}
+
+type CatalogService struct {
+	catalogRepository      port.CatalogPort
+	auditRepository        port.AuditPort
+	priceHistoryRepository port.PriceHistoryPort
+	transactor             port.TransactionPort
+	ratePlanRepository     port.RatePlanPort
+}
+
+func NewCatalogService(catalogRepository port.CatalogPort, auditRepository port.AuditPort, priceHistoryRepository port.PriceHistoryPort, transactor port.TransactionPort, ratePlanRepository port.RatePlanPort) *CatalogService {
+	return &CatalogService{
+		catalogRepository:      catalogRepository,
+		auditRepository:        auditRepository,
+		priceHistoryRepository: priceHistoryRepository,
+		transactor:             transactor,
+		ratePlanRepository:     ratePlanRepository,
+	}
+}
+
+// ImportCatalog upserts the given rows in one transaction. Every hotel in records is treated
+// as complete: its facilities, physical rooms, offers and benefits that are not listed are
+// deactivated. New hotels start with a best available rate plan, like hotels created through
+// the API. With dryRun the changes are computed but nothing is written.
+func (s *CatalogService) ImportCatalog(ctx context.Context, records *domain.CatalogRecords, dryRun bool) ([]domain.CatalogChange, error) {
+	hotelIDs := make([]string, len(records.Hotels))
+	for i, h := range records.Hotels {
+		hotelIDs[i] = h.ID
+	}
+
+	stored, err := s.catalogRepository.Load(ctx, hotelIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	// rows keep their ID across hotels, a row of another hotel would be moved to the imported one
+	existing, err := s.catalogRepository.LoadByIDs(ctx, records)
+	if err != nil {
+		return nil, err
+	}
+
+	if problems := validateCatalogRecords(stored, existing, records); len(problems) > 0 {
+		slog.Error("[SERVICE]", "message", "invalid catalog import", "problems", problems)
+		return nil, &CatalogValidationError{Problems: problems}
+	}
+
+	writes, changes := planCatalogChanges(stored, records)
+	plans, planChanges := bestAvailableRates(changes)
+	changes = append(changes, planChanges...)
+	if dryRun || len(changes) == 0 {
+		return changes, nil
+	}
+
+	basePrices := make(map[string]domain.Money, len(stored.Rooms))
+	for _, room := range stored.Rooms {
+		basePrices[room.ID] = room.BasePrice
+	}
+
+	err = s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.catalogRepository.Upsert(ctx, writes); err != nil {
+			return err
+		}
+
+		for i := range plans {
+			if err := s.ratePlanRepository.Create(ctx, &plans[i]); err != nil {
+				return err
+			}
+		}
+
+		if err := recordChanges(ctx, s.auditRepository, changes); err != nil {
+			return err
+		}
+
+		return recordPrices(ctx, s.priceHistoryRepository, basePricePoints(basePrices, writes.Rooms))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return changes, nil
+}
+
+// ExportCatalog returns every catalog row, inactive ones included
+func (s *CatalogService) ExportCatalog(ctx context.Context) (*domain.CatalogRecords, error) {
+	return s.catalogRepository.Load(ctx, nil)
+}
+
+// validateCatalogRecords checks records against the stored rows of their hotels and the
+// existing rows with the IDs of records, which must not belong to a hotel records leaves out
+func validateCatalogRecords(stored, existing, records *domain.CatalogRecords) []string {
+	var problems []string
+
+	duplicates := func(entity string, ids []string) {
+		seen := make(map[string]bool, len(ids))
+		for _, id := range ids {
+			if seen[id] {
+				problems = append(problems, fmt.Sprintf("%s %s is listed more than once", entity, id))
+			}
+			seen[id] = true
+		}
+	}
+
+	codes := make(map[string]bool)
+	catalogCodes := make([]string, len(records.FacilityCatalog))
+	for i, e := range records.FacilityCatalog {
+		catalogCodes[i] = e.Code
+		codes[e.Code] = true
+	}
+	for _, e := range stored.FacilityCatalog {
+		codes[e.Code] = true
+	}
+	duplicates(domain.EntityFacilityCatalog, catalogCodes)
+
+	// imported policies replace stored ones of the same ID
+	policies := make(map[string]bool)
+	for _, p := range stored.CancellationPolicies {
+		policies[p.ID] = p.IsActive
+	}
+	policyIDs := make([]string, len(records.CancellationPolicies))
+	for i, p := range records.CancellationPolicies {
+		policyIDs[i] = p.ID
+		policies[p.ID] = p.IsActive
+	}
+	duplicates(domain.EntityCancellationPolicy, policyIDs)
+
+	hotelIDs := make([]string, len(records.Hotels))
+	imported := make(map[string]bool, len(records.Hotels))
+	for i, h := range records.Hotels {
+		hotelIDs[i] = h.ID
+		imported[h.ID] = true
+	}
+	duplicates(domain.EntityHotel, hotelIDs)
+
+	foreign := func(entity, id, hotelID string) {
+		if !imported[hotelID] {
+			problems = append(problems, fmt.Sprintf("%s %s belongs to hotel %s, which is not in the catalog", entity, id, hotelID))
+		}
+	}
+
+	// facilities are matched by hotel and code first, only the ID of a new one is kept
+	storedFacilities := make(map[string]bool, len(stored.Facilities))
+	for _, f := range stored.Facilities {
+		storedFacilities[f.HotelID+"/"+f.Code] = true
+	}
+	facilityIDs := make(map[string]bool, len(records.Facilities))
+	for _, f := range records.Facilities {
+		if !storedFacilities[f.HotelID+"/"+f.Code] {
+			facilityIDs[f.ID] = true
+		}
+	}
+	for _, f := range existing.Facilities {
+		if facilityIDs[f.ID] {
+			foreign(domain.EntityFacility, f.ID, f.HotelID)
+		}
+	}
+
+	physicalRoomHotels := make(map[string]string, len(existing.PhysicalRooms))
+	for _, p := range existing.PhysicalRooms {
+		physicalRoomHotels[p.ID] = p.HotelID
+	}
+	importedPhysicalRooms := make(map[string]bool, len(records.PhysicalRooms))
+	for _, p := range records.PhysicalRooms {
+		importedPhysicalRooms[p.ID] = true
+	}
+	for _, p := range existing.PhysicalRooms {
+		if importedPhysicalRooms[p.ID] {
+			foreign(domain.EntityPhysicalRoom, p.ID, p.HotelID)
+		}
+	}
+	for _, r := range existing.Rooms {
+		foreign(domain.EntityRoom, r.ID, r.HotelID)
+	}
+	for _, b := range existing.Benefits {
+		if hotelID, ok := physicalRoomHotels[b.PhysicalRoomID]; ok {
+			foreign(domain.EntityBenefit, b.ID, hotelID)
+		}
+	}
+
+	facilityKeys := make([]string, len(records.Facilities))
+	for i, f := range records.Facilities {
+		facilityKeys[i] = f.HotelID + "/" + f.Code
+		if !codes[f.Code] {
+			problems = append(problems, fmt.Sprintf("facility %s of hotel %s is not in the facility catalog", f.Code, f.HotelID))
+		}
+	}
+	duplicates(domain.EntityFacility, facilityKeys)
+
+	physicalRoomIDs := make([]string, len(records.PhysicalRooms))
+	physicalRooms := make(map[string]*domain.PhysicalRoom, len(records.PhysicalRooms))
+	for i := range records.PhysicalRooms {
+		physicalRoomIDs[i] = records.PhysicalRooms[i].ID
+		physicalRooms[records.PhysicalRooms[i].ID] = &records.PhysicalRooms[i]
+	}
+	duplicates(domain.EntityPhysicalRoom, physicalRoomIDs)
+
+	roomIDs := make([]string, len(records.Rooms))
+	for i, r := range records.Rooms {
+		roomIDs[i] = r.ID
+		if r.IsActive && !policies[r.CancellationPolicyID] {
+			problems = append(problems, fmt.Sprintf("room %s does not use an active cancellation policy", r.ID))
+		}
+		// an offer sells its physical room, so it is of the same type
+		if p, ok := physicalRooms[r.PhysicalRoomID]; ok && r.IsActive && p.Type != r.Type {
+			problems = append(problems, fmt.Sprintf("room %s is a %s offer of physical room %s, which is a %s", r.ID, r.Type, p.ID, p.Type))
+		}
+	}
+	duplicates(domain.EntityRoom, roomIDs)
+
+	benefitIDs := make([]string, len(records.Benefits))
+	for i, b := range records.Benefits {
+		benefitIDs[i] = b.ID
+	}
+	duplicates(domain.EntityBenefit, benefitIDs)
+
+	return problems
+}
+
+// planCatalogChanges compares imported rows with stored ones and returns the rows to write
+// together with the change each of them makes. Unchanged rows are left out.
+func planCatalogChanges(stored, records *domain.CatalogRecords) (*domain.CatalogRecords, []domain.CatalogChange) {
+	var (
+		writes  domain.CatalogRecords
+		changes []domain.CatalogChange
+		c       []domain.CatalogChange
+	)
+
+	// hotels identify their facilities by code, stored facilities keep their ID and new ones
+	// keep the ID they were exported with
+	facilityIDs := make(map[string]string, len(stored.Facilities))
+	for _, f := range stored.Facilities {
+		facilityIDs[f.HotelID+"/"+f.Code] = f.ID
+	}
+	facilities := make([]domain.Facility, len(records.Facilities))
+	for i, f := range records.Facilities {
+		if id, ok := facilityIDs[f.HotelID+"/"+f.Code]; ok {
+			f.ID = id
+		} else if f.ID == "" {
+			f.ID = uuid.NewString()
+		}
+		facilities[i] = f
+	}
+
+	writes.FacilityCatalog, c = diffRows(domain.EntityFacilityCatalog, stored.FacilityCatalog, records.FacilityCatalog,
+		func(e *domain.FacilityCatalogEntry) (string, *bool) { return e.Code, &e.IsActive }, false)
+	changes = append(changes, c...)
+
+	writes.CancellationPolicies, c = diffRows(domain.EntityCancellationPolicy, stored.CancellationPolicies, records.CancellationPolicies,
+		func(p *domain.CancellationPolicy) (string, *bool) { return p.ID, &p.IsActive }, false)
+	changes = append(changes, c...)
+
+	writes.Hotels, c = diffRows(domain.EntityHotel, stored.Hotels, records.Hotels,
+		func(h *domain.Hotel) (string, *bool) { return h.ID, &h.IsActive }, false)
+	changes = append(changes, c...)
+
+	writes.Facilities, c = diffRows(domain.EntityFacility, stored.Facilities, facilities,
+		func(f *domain.Facility) (string, *bool) { return f.ID, &f.IsActive }, true)
+	changes = append(changes, c...)
+
+	writes.PhysicalRooms, c = diffRows(domain.EntityPhysicalRoom, stored.PhysicalRooms, records.PhysicalRooms,
+		func(p *domain.PhysicalRoom) (string, *bool) { return p.ID, &p.IsActive }, true)
+	changes = append(changes, c...)
+
+	writes.Rooms, c = diffRows(domain.EntityRoom, stored.Rooms, records.Rooms,
+		func(r *domain.Room) (string, *bool) { return r.ID, &r.IsActive }, true)
+	changes = append(changes, c...)
+
+	writes.Benefits, c = diffRows(domain.EntityBenefit, stored.Benefits, records.Benefits,
+		func(b *domain.Benefit) (string, *bool) { return b.ID, &b.IsActive }, true)
+	changes = append(changes, c...)
+
+	return &writes, changes
+}
+
+// bestAvailableRates returns the best available rate plans of the hotels changes create together
+// with the change creating each of them
+func bestAvailableRates(changes []domain.CatalogChange) ([]domain.RatePlan, []domain.CatalogChange) {
+	var (
+		plans       []domain.RatePlan
+		planChanges []domain.CatalogChange
+	)
+	for _, change := range changes {
+		if change.Entity != domain.EntityHotel || change.Action != domain.ActionCreate {
+			continue
+		}
+
+		plan := domain.BestAvailableRate(change.ID)
+		plan.ID = uuid.NewString()
+		plans = append(plans, plan)
+		planChanges = append(planChanges, domain.CatalogChange{Entity: domain.EntityRatePlan, ID: plan.ID, Action: domain.ActionCreate, Fields: domain.DiffFields(&domain.RatePlan{}, &plan)})
+	}
+	return plans, planChanges
+}
+
+// diffRows matches imported rows to stored rows by key. With deactivateMissing, active stored
+// rows that are not imported are deactivated.
+func diffRows[T any](entity string, stored, imported []T, key func(*T) (string, *bool), deactivateMissing bool) ([]T, []domain.CatalogChange) {
+	var (
+		writes  []T
+		changes []domain.CatalogChange
+	)
+
+	byKey := make(map[string]*T, len(stored))
+	for i := range stored {
+		id, _ := key(&stored[i])
+		byKey[id] = &stored[i]
+	}
+
+	seen := make(map[string]bool, len(imported))
+	for i := range imported {
+		row := imported[i]
+		id, isActive := key(&row)
+		seen[id] = true
+
+		before, ok := byKey[id]
+		if !ok {
+			writes = append(writes, row)
+			changes = append(changes, domain.CatalogChange{Entity: entity, ID: id, Action: domain.ActionCreate, Fields: domain.DiffFields(new(T), &row)})
+			continue
+		}
+
+		fields := domain.DiffFields(before, &row)
+		if len(fields) == 0 {
+			continue
+		}
+
+		action := domain.ActionUpdate
+		if _, wasActive := key(before); *wasActive && !*isActive {
+			action = domain.ActionDeactivate
+		}
+		writes = append(writes, row)
+		changes = append(changes, domain.CatalogChange{Entity: entity, ID: id, Action: action, Fields: fields})
+	}
+
+	if !deactivateMissing {
+		return writes, changes
+	}
+
+	for i := range stored {
+		row := stored[i]
+		id, isActive := key(&row)
+		if seen[id] || !*isActive {
+			continue
+		}
+
+		*isActive = false
+		writes = append(writes, row)
+		changes = append(changes, domain.CatalogChange{Entity: entity, ID: id, Action: domain.ActionDeactivate, Fields: domain.DiffFields(&stored[i], &row)})
+	}
+
+	return writes, changes
+}
@@ -0,0 +1,117 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/chayutK/hotel-property-service/internal/domain"
+	"github.com/chayutK/hotel-property-service/internal/port"
+)
+
+type ExchangeRateService struct {
+	exchangeRateRepository port.ExchangeRatePort
+	auditRepository        port.AuditPort
+	transactor             port.TransactionPort
+}
+
+func NewExchangeRateService(exchangeRateRepository port.ExchangeRatePort, auditRepository port.AuditPort, transactor port.TransactionPort) *ExchangeRateService {
+	return &ExchangeRateService{
+		exchangeRateRepository: exchangeRateRepository,
+		auditRepository:        auditRepository,
+		transactor:             transactor,
+	}
+}
+
+// GetExchangeRates returns the exchange rates of every pair, empty from and to match any
+// currency
+func (s *ExchangeRateService) GetExchangeRates(ctx context.Context, from, to string) ([]domain.ExchangeRate, error) {
+	return s.exchangeRateRepository.FindAll(ctx, from, to)
+}
+
+// SetExchangeRates creates or replaces the given rates, rates of other dates and pairs are left
+// as they are. Every rate of the pairs that were set is returned.
+func (s *ExchangeRateService) SetExchangeRates(ctx context.Context, rates []domain.ExchangeRate) ([]domain.ExchangeRate, error) {
+	stored, err := s.exchangeRateRepository.FindAll(ctx, "", "")
+	if err != nil {
+		return nil, err
+	}
+
+	before := make(map[string]domain.ExchangeRate, len(stored))
+	for _, rate := range stored {
+		before[exchangeRateID(rate)] = rate
+	}
+
+	var changes []domain.CatalogChange
+	for _, rate := range rates {
+		action := domain.ActionUpdate
+		previous, ok := before[exchangeRateID(rate)]
+		if !ok {
+			action = domain.ActionCreate
+		}
+		if fields := domain.DiffFields(&previous, &rate); len(fields) > 0 {
+			changes = append(changes, domain.CatalogChange{Entity: domain.EntityExchangeRate, ID: exchangeRateID(rate), Action: action, Fields: fields})
+		}
+	}
+
+	if len(changes) > 0 {
+		err := s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+			if err := s.exchangeRateRepository.Upsert(ctx, rates); err != nil {
+				return err
+			}
+
+			return recordChanges(ctx, s.auditRepository, changes)
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	stored, err = s.exchangeRateRepository.FindAll(ctx, "", "")
+	if err != nil {
+		return nil, err
+	}
+
+	pairs := make(map[[2]string]bool, len(rates))
+	for _, rate := range rates {
+		pairs[[2]string{rate.From, rate.To}] = true
+	}
+
+	var set []domain.ExchangeRate
+	for _, rate := range stored {
+		if pairs[[2]string{rate.From, rate.To}] {
+			set = append(set, rate)
+		}
+	}
+	return set, nil
+}
+
+// DeleteExchangeRate removes the rate of a pair effective from date, the rate before it applies
+// again
+func (s *ExchangeRateService) DeleteExchangeRate(ctx context.Context, from, to, date string) error {
+	return s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		stored, err := s.exchangeRateRepository.FindEffective(ctx, from, to, date)
+		if err != nil && !errors.Is(err, domain.ErrNoExchangeRate) {
+			return err
+		}
+
+		if err := s.exchangeRateRepository.Delete(ctx, from, to, date); err != nil {
+			return err
+		}
+
+		before := domain.ExchangeRate{From: from, To: to, EffectiveDate: date}
+		if stored != nil && stored.EffectiveDate == date {
+			before = *stored
+		}
+		return recordChanges(ctx, s.auditRepository, []domain.CatalogChange{{
+			Entity: domain.EntityExchangeRate,
+			ID:     exchangeRateID(before),
+			Action: domain.ActionDelete,
+			Fields: domain.DiffFields(&before, &domain.ExchangeRate{}),
+		}})
+	})
+}
+
+// exchangeRateID identifies a rate in the audit log, e.g. "THB/USD/2026-10-01"
+func exchangeRateID(rate domain.ExchangeRate) string {
+	return rate.From + "/" + rate.To + "/" + rate.EffectiveDate
+}
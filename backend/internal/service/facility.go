@@ -0,0 +1,206 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/chayutK/hotel-property-service/internal/domain"
+	"github.com/chayutK/hotel-property-service/internal/port"
+	"github.com/google/uuid"
+)
+
+type FacilityService struct {
+	hotelRepository    port.HotelPort
+	facilityRepository port.FacilityPort
+	auditRepository    port.AuditPort
+	transactor         port.TransactionPort
+}
+
+func NewFacilityService(hotelRepository port.HotelPort, facilityRepository port.FacilityPort, auditRepository port.AuditPort, transactor port.TransactionPort) *FacilityService {
+	return &FacilityService{
+		hotelRepository:    hotelRepository,
+		facilityRepository: facilityRepository,
+		auditRepository:    auditRepository,
+		transactor:         transactor,
+	}
+}
+
+func (s *FacilityService) GetCatalog(ctx context.Context) ([]domain.FacilityCatalogEntry, error) {
+	return s.facilityRepository.FindCatalog(ctx)
+}
+
+func (s *FacilityService) CreateCatalogEntry(ctx context.Context, entry *domain.FacilityCatalogEntry) (*domain.FacilityCatalogEntry, error) {
+	entry.IsActive = true
+
+	var created *domain.FacilityCatalogEntry
+	err := s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.facilityRepository.CreateCatalogEntry(ctx, entry); err != nil {
+			return err
+		}
+
+		var err error
+		if created, err = s.facilityRepository.FindCatalogByCode(ctx, entry.Code); err != nil {
+			return err
+		}
+
+		return recordChange(ctx, s.auditRepository, domain.EntityFacilityCatalog, domain.ActionCreate, created.Code, &domain.FacilityCatalogEntry{}, created)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+func (s *FacilityService) UpdateCatalogEntry(ctx context.Context, entry *domain.FacilityCatalogEntry) (*domain.FacilityCatalogEntry, error) {
+	var updated *domain.FacilityCatalogEntry
+	err := s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		before, err := s.facilityRepository.FindCatalogByCode(ctx, entry.Code)
+		if err != nil {
+			return err
+		}
+
+		if err := s.facilityRepository.UpdateCatalogEntry(ctx, entry); err != nil {
+			return err
+		}
+
+		if updated, err = s.facilityRepository.FindCatalogByCode(ctx, entry.Code); err != nil {
+			return err
+		}
+
+		return recordChange(ctx, s.auditRepository, domain.EntityFacilityCatalog, domain.ActionUpdate, updated.Code, before, updated)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+// AttachFacility adds a catalog facility to a hotel, reactivating it if it was detached before.
+func (s *FacilityService) AttachFacility(ctx context.Context, attach *domain.Facility) (*domain.Hotel, error) {
+	hotelID, code := attach.HotelID, attach.Code
+	if _, err := s.hotelRepository.FindByID(ctx, hotelID); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.facilityRepository.FindCatalogByCode(ctx, code); err != nil {
+		return nil, err
+	}
+
+	err := s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		facility, err := s.findHotelFacility(ctx, hotelID, code)
+		switch {
+		case err != nil:
+			return err
+		case facility == nil:
+			facility = &domain.Facility{
+				ID:          uuid.NewString(),
+				HotelID:     hotelID,
+				Code:        code,
+				Description: attach.Description,
+				IsActive:    true,
+				ActiveFrom:  attach.ActiveFrom,
+				ActiveUntil: attach.ActiveUntil,
+			}
+			if err := s.facilityRepository.Create(ctx, facility); err != nil {
+				return err
+			}
+			return recordChange(ctx, s.auditRepository, domain.EntityFacility, domain.ActionCreate, facility.ID, &domain.Facility{}, facility)
+		case facility.IsActive:
+			slog.Error("[SERVICE]", "message", fmt.Sprintf("facility already attached to hotel, code:%s, hotelID:%s", code, hotelID))
+			return fmt.Errorf("facility already attached to hotel")
+		default:
+			before := *facility
+			facility.Description = attach.Description
+			facility.IsActive = true
+			facility.ActiveFrom = attach.ActiveFrom
+			facility.ActiveUntil = attach.ActiveUntil
+			if err := s.facilityRepository.Update(ctx, facility); err != nil {
+				return err
+			}
+			return recordChange(ctx, s.auditRepository, domain.EntityFacility, domain.ActionUpdate, facility.ID, &before, facility)
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.hotelRepository.FindByID(ctx, hotelID)
+}
+
+func (s *FacilityService) DescribeFacility(ctx context.Context, describe *domain.Facility) (*domain.Hotel, error) {
+	err := s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		facility, err := s.getAttachedFacility(ctx, describe.HotelID, describe.Code)
+		if err != nil {
+			return err
+		}
+
+		before := *facility
+		facility.Description = describe.Description
+		facility.ActiveFrom = describe.ActiveFrom
+		facility.ActiveUntil = describe.ActiveUntil
+		if err := s.facilityRepository.Update(ctx, facility); err != nil {
+			return err
+		}
+
+		return recordChange(ctx, s.auditRepository, domain.EntityFacility, domain.ActionUpdate, facility.ID, &before, facility)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.hotelRepository.FindByID(ctx, describe.HotelID)
+}
+
+func (s *FacilityService) DetachFacility(ctx context.Context, hotelID, code string) error {
+	return s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		facility, err := s.getAttachedFacility(ctx, hotelID, code)
+		if err != nil {
+			return err
+		}
+
+		before := *facility
+		facility.IsActive = false
+		if err := s.facilityRepository.Update(ctx, facility); err != nil {
+			return err
+		}
+
+		return recordChange(ctx, s.auditRepository, domain.EntityFacility, domain.ActionDeactivate, facility.ID, &before, facility)
+	})
+}
+
+func (s *FacilityService) getAttachedFacility(ctx context.Context, hotelID, code string) (*domain.Facility, error) {
+	if _, err := s.hotelRepository.FindByID(ctx, hotelID); err != nil {
+		return nil, err
+	}
+
+	facility, err := s.findHotelFacility(ctx, hotelID, code)
+	if err != nil {
+		return nil, err
+	}
+
+	if facility == nil || !facility.IsActive {
+		slog.Error("[SERVICE]", "message", fmt.Sprintf("facility is not attached to hotel, code:%s, hotelID:%s", code, hotelID))
+		return nil, fmt.Errorf("facility is not attached to hotel")
+	}
+
+	return facility, nil
+}
+
+// findHotelFacility returns the hotel facility for a catalog code, or nil if it was never attached.
+func (s *FacilityService) findHotelFacility(ctx context.Context, hotelID, code string) (*domain.Facility, error) {
+	facilities, err := s.facilityRepository.FindByHotelID(ctx, hotelID)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range facilities {
+		if facilities[i].Code == code {
+			return &facilities[i], nil
+		}
+	}
+
+	return nil, nil
+}
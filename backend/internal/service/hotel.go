@@ -2,23 +2,33 @@ package service
 
 import (
 	"context"
+	"fmt"
+	"log/slog"
+	"time"
 
 	"github.com/chayutK/hotel-property-service/internal/domain"
 	"github.com/chayutK/hotel-property-service/internal/port"
+	"github.com/google/uuid"
 )
 
 type HotelService struct {
-	hotelRepository port.HotelPort
+	hotelRepository    port.HotelPort
+	auditRepository    port.AuditPort
+	transactor         port.TransactionPort
+	ratePlanRepository port.RatePlanPort
 }
 
-func NewHotelService(hotelRepository port.HotelPort) *HotelService {
+func NewHotelService(hotelRepository port.HotelPort, auditRepository port.AuditPort, transactor port.TransactionPort, ratePlanRepository port.RatePlanPort) *HotelService {
 	return &HotelService{
-		hotelRepository: hotelRepository,
+		hotelRepository:    hotelRepository,
+		auditRepository:    auditRepository,
+		transactor:         transactor,
+		ratePlanRepository: ratePlanRepository,
 	}
 }
 
-func (s *HotelService) GetAllHotels(ctx context.Context) ([]domain.Hotel, error) {
-	hotels, err := s.hotelRepository.FindAll(ctx)
+func (s *HotelService) GetAllHotels(ctx context.Context, filter domain.HotelFilter) ([]domain.Hotel, error) {
+	hotels, err := s.hotelRepository.FindAll(ctx, filter)
 	if err != nil {
 		return nil, err
 	}
@@ -32,5 +42,88 @@ func (s *HotelService) GetHotelByID(ctx context.Context, hotelID string) (*domai
 		return nil, err
 	}
 
+	if !hotel.IsLiveAt(time.Now()) {
+		slog.Error("[SERVICE]", "message", fmt.Sprintf("hotel is outside its activation window, hotelID:%s", hotelID))
+		return nil, fmt.Errorf("hotel is not live: %w", domain.ErrNotFound)
+	}
+
 	return hotel, nil
 }
+
+// GetHotelForUpdate returns an active hotel whether or not its activation window is open,
+// so scheduled hotels can be edited before they go live.
+func (s *HotelService) GetHotelForUpdate(ctx context.Context, hotelID string) (*domain.Hotel, error) {
+	return s.hotelRepository.FindByID(ctx, hotelID)
+}
+
+func (s *HotelService) CreateHotel(ctx context.Context, hotel *domain.Hotel) (*domain.Hotel, error) {
+	hotel.ID = uuid.NewString()
+	hotel.IsActive = true
+
+	var created *domain.Hotel
+	err := s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.hotelRepository.Create(ctx, hotel); err != nil {
+			return err
+		}
+
+		var err error
+		if created, err = s.hotelRepository.FindByID(ctx, hotel.ID); err != nil {
+			return err
+		}
+
+		if err := recordChange(ctx, s.auditRepository, domain.EntityHotel, domain.ActionCreate, created.ID, &domain.Hotel{}, created); err != nil {
+			return err
+		}
+
+		return createBestAvailableRate(ctx, s.ratePlanRepository, s.auditRepository, created.ID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+func (s *HotelService) UpdateHotel(ctx context.Context, hotel *domain.Hotel) (*domain.Hotel, error) {
+	var updated *domain.Hotel
+	err := s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		// only active hotels can be edited; a deactivated hotel behaves as deleted
+		before, err := s.hotelRepository.FindByID(ctx, hotel.ID)
+		if err != nil {
+			return err
+		}
+
+		if err := s.hotelRepository.Update(ctx, hotel); err != nil {
+			return err
+		}
+
+		if updated, err = s.hotelRepository.FindByID(ctx, hotel.ID); err != nil {
+			return err
+		}
+
+		return recordChange(ctx, s.auditRepository, domain.EntityHotel, domain.ActionUpdate, updated.ID, before, updated)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+// DeactivateHotel deactivates the hotel, only while it is still at updatedAt when that is not 0
+func (s *HotelService) DeactivateHotel(ctx context.Context, hotelID string, updatedAt int64) error {
+	return s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		before, err := s.hotelRepository.FindByID(ctx, hotelID)
+		if err != nil {
+			return err
+		}
+
+		if err := s.hotelRepository.Deactivate(ctx, hotelID, updatedAt); err != nil {
+			return err
+		}
+
+		after := *before
+		after.IsActive = false
+		return recordChange(ctx, s.auditRepository, domain.EntityHotel, domain.ActionDeactivate, hotelID, before, &after)
+	})
+}
@@ -0,0 +1,127 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/chayutK/hotel-property-service/internal/domain"
+	"github.com/chayutK/hotel-property-service/internal/port"
+	"github.com/google/uuid"
+)
+
+type PhysicalRoomService struct {
+	hotelRepository        port.HotelPort
+	physicalRoomRepository port.PhysicalRoomPort
+	auditRepository        port.AuditPort
+	transactor             port.TransactionPort
+}
+
+func NewPhysicalRoomService(hotelRepository port.HotelPort, physicalRoomRepository port.PhysicalRoomPort, auditRepository port.AuditPort, transactor port.TransactionPort) *PhysicalRoomService {
+	return &PhysicalRoomService{
+		hotelRepository:        hotelRepository,
+		physicalRoomRepository: physicalRoomRepository,
+		auditRepository:        auditRepository,
+		transactor:             transactor,
+	}
+}
+
+func (s *PhysicalRoomService) GetPhysicalRoomsByHotelID(ctx context.Context, hotelID string) ([]domain.PhysicalRoom, error) {
+	return s.physicalRoomRepository.FindByHotelID(ctx, hotelID)
+}
+
+func (s *PhysicalRoomService) GetPhysicalRoomByID(ctx context.Context, hotelID, physicalRoomID string) (*domain.PhysicalRoom, error) {
+	physicalRoom, err := s.physicalRoomRepository.FindByID(ctx, physicalRoomID)
+	if err != nil {
+		return nil, err
+	}
+
+	if physicalRoom.HotelID != hotelID {
+		slog.Error("[SERVICE]", "message", fmt.Sprintf("hotelID does not match with physical room, physicalRoom.HotelID:%s, hotelID:%s", physicalRoom.HotelID, hotelID))
+		return nil, fmt.Errorf("hotelID does not match with physical room")
+	}
+
+	return physicalRoom, nil
+}
+
+func (s *PhysicalRoomService) CreatePhysicalRoom(ctx context.Context, physicalRoom *domain.PhysicalRoom) (*domain.PhysicalRoom, error) {
+	if _, err := s.hotelRepository.FindByID(ctx, physicalRoom.HotelID); err != nil {
+		return nil, err
+	}
+
+	physicalRoom.ID = uuid.NewString()
+	physicalRoom.IsActive = true
+
+	var created *domain.PhysicalRoom
+	err := s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.physicalRoomRepository.Create(ctx, physicalRoom); err != nil {
+			return err
+		}
+
+		var err error
+		if created, err = s.physicalRoomRepository.FindByID(ctx, physicalRoom.ID); err != nil {
+			return err
+		}
+
+		return recordChange(ctx, s.auditRepository, domain.EntityPhysicalRoom, domain.ActionCreate, created.ID, &domain.PhysicalRoom{}, created)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+func (s *PhysicalRoomService) UpdatePhysicalRoom(ctx context.Context, physicalRoom *domain.PhysicalRoom) (*domain.PhysicalRoom, error) {
+	var updated *domain.PhysicalRoom
+	err := s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		before, err := s.GetPhysicalRoomByID(ctx, physicalRoom.HotelID, physicalRoom.ID)
+		if err != nil {
+			return err
+		}
+
+		// the offers sold on the physical room are of its type
+		for _, offer := range before.Offers {
+			if offer.Type != physicalRoom.Type {
+				slog.Error("[SERVICE]", "message", fmt.Sprintf("physical room type does not match with its offers, offer.Type:%s, physicalRoom.Type:%s", offer.Type, physicalRoom.Type))
+				return fmt.Errorf("physical room type does not match with its offers")
+			}
+		}
+
+		if err := s.physicalRoomRepository.Update(ctx, physicalRoom); err != nil {
+			return err
+		}
+
+		if updated, err = s.physicalRoomRepository.FindByID(ctx, physicalRoom.ID); err != nil {
+			return err
+		}
+
+		return recordChange(ctx, s.auditRepository, domain.EntityPhysicalRoom, domain.ActionUpdate, updated.ID, before, updated)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+func (s *PhysicalRoomService) DeletePhysicalRoom(ctx context.Context, hotelID, physicalRoomID string) error {
+	return s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		before, err := s.GetPhysicalRoomByID(ctx, hotelID, physicalRoomID)
+		if err != nil {
+			return err
+		}
+
+		if err := s.physicalRoomRepository.Deactivate(ctx, physicalRoomID); err != nil {
+			return err
+		}
+
+		// the offers sold on the physical room are deactivated with it
+		changes := []domain.CatalogChange{deactivation(domain.EntityPhysicalRoom, physicalRoomID)}
+		for _, offer := range before.Offers {
+			changes = append(changes, deactivation(domain.EntityRoom, offer.ID))
+		}
+
+		return recordChanges(ctx, s.auditRepository, changes)
+	})
+}
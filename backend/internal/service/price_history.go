@@ -0,0 +1,88 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/chayutK/hotel-property-service/internal/domain"
+	"github.com/chayutK/hotel-property-service/internal/port"
+	"github.com/google/uuid"
+)
+
+type PriceHistoryService struct {
+	roomRepository         port.RoomPort
+	priceHistoryRepository port.PriceHistoryPort
+}
+
+func NewPriceHistoryService(roomRepository port.RoomPort, priceHistoryRepository port.PriceHistoryPort) *PriceHistoryService {
+	return &PriceHistoryService{
+		roomRepository:         roomRepository,
+		priceHistoryRepository: priceHistoryRepository,
+	}
+}
+
+// GetPriceHistory returns how the base price and rate calendar of a room moved, oldest first
+func (s *PriceHistoryService) GetPriceHistory(ctx context.Context, hotelID string, filter domain.PriceHistoryFilter) ([]domain.PricePoint, error) {
+	if err := s.checkRoom(ctx, hotelID, filter.RoomID); err != nil {
+		return nil, err
+	}
+
+	return s.priceHistoryRepository.Find(ctx, filter)
+}
+
+// GetNightPriceAt returns the point that set the price of a night of the room at t, before
+// pricing rules, nil when the room had no price yet
+func (s *PriceHistoryService) GetNightPriceAt(ctx context.Context, hotelID, roomID, night string, t time.Time) (*domain.PricePoint, error) {
+	if err := s.checkRoom(ctx, hotelID, roomID); err != nil {
+		return nil, err
+	}
+
+	history, err := s.priceHistoryRepository.Find(ctx, domain.PriceHistoryFilter{RoomID: roomID, Night: night})
+	if err != nil {
+		return nil, err
+	}
+
+	return domain.NightPriceAt(history, night, t), nil
+}
+
+func (s *PriceHistoryService) checkRoom(ctx context.Context, hotelID, roomID string) error {
+	room, err := s.roomRepository.FindByRoomID(ctx, roomID)
+	if err != nil {
+		return err
+	}
+
+	if room.HotelID != hotelID {
+		slog.Error("[SERVICE]", "message", fmt.Sprintf("hotelID does not match with room, room.HotelID:%s, hotelID:%s", room.HotelID, hotelID))
+		return fmt.Errorf("hotelID does not match with room")
+	}
+
+	return nil
+}
+
+// recordPrices adds points to the price history, they take effect now and are made by the actor
+// of ctx
+func recordPrices(ctx context.Context, priceHistoryRepository port.PriceHistoryPort, points []domain.PricePoint) error {
+	actor, at := domain.ActorFrom(ctx), time.Now().UTC()
+	for i := range points {
+		points[i].ID = uuid.NewString()
+		points[i].EffectiveAt = at
+		points[i].Actor = actor
+	}
+
+	return priceHistoryRepository.Record(ctx, points)
+}
+
+// basePricePoints lists the base prices of rooms that are new or changed from before
+func basePricePoints(before map[string]domain.Money, rooms []domain.Room) []domain.PricePoint {
+	var points []domain.PricePoint
+	for _, room := range rooms {
+		if previous, ok := before[room.ID]; ok && previous == room.BasePrice {
+			continue
+		}
+		price := room.BasePrice
+		points = append(points, domain.PricePoint{RoomID: room.ID, Source: domain.RateSourceBasePrice, Price: &price})
+	}
+	return points
+}
@@ -2,36 +2,449 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
+	"slices"
+	"sync"
+	"time"
 
+	"github.com/chayutK/hotel-property-service/internal/domain"
 	"github.com/chayutK/hotel-property-service/internal/port"
 )
 
 type PricingService struct {
-	hotelRepository port.HotelPort
-	roomRepository  port.RoomPort
+	hotelRepository              port.HotelPort
+	roomRepository               port.RoomPort
+	roomRateRepository           port.RoomRatePort
+	pricingRuleRepository        port.PricingRulePort
+	cancellationPolicyRepository port.CancellationPolicyPort
+	taxFeeRepository             port.TaxFeePort
+	exchangeRateRepository       port.ExchangeRatePort
+	promotionRepository          port.PromotionPort
+	stayRuleRepository           port.StayRulePort
+	ratePlanRepository           port.RatePlanPort
+	quoteSigner                  *QuoteSigner
 }
 
-func NewPricingService(hotelRepository port.HotelPort, roomRepository port.RoomPort) *PricingService {
+func NewPricingService(hotelRepository port.HotelPort, roomRepository port.RoomPort, roomRateRepository port.RoomRatePort, pricingRuleRepository port.PricingRulePort, cancellationPolicyRepository port.CancellationPolicyPort, taxFeeRepository port.TaxFeePort, exchangeRateRepository port.ExchangeRatePort, promotionRepository port.PromotionPort, stayRuleRepository port.StayRulePort, ratePlanRepository port.RatePlanPort, quoteSigner *QuoteSigner) *PricingService {
 	return &PricingService{
-		hotelRepository: hotelRepository,
-		roomRepository:  roomRepository,
+		hotelRepository:              hotelRepository,
+		roomRepository:               roomRepository,
+		roomRateRepository:           roomRateRepository,
+		pricingRuleRepository:        pricingRuleRepository,
+		cancellationPolicyRepository: cancellationPolicyRepository,
+		taxFeeRepository:             taxFeeRepository,
+		exchangeRateRepository:       exchangeRateRepository,
+		promotionRepository:          promotionRepository,
+		stayRuleRepository:           stayRuleRepository,
+		ratePlanRepository:           ratePlanRepository,
+		quoteSigner:                  quoteSigner,
 	}
 }
 
-func (s *PricingService) CalculateRoomPrice(ctx context.Context, hotelID, roomID string, nights int) (float64, error) {
-	room, err := s.roomRepository.FindByRoomID(ctx, roomID)
+// QuoteRequest is a stay to price, see CalculateRoomPrice. Without a RatePlanID the stay is priced
+// at the room's own price, Guest is what makes the guest eligible for the rate plan.
+type QuoteRequest struct {
+	HotelID    string
+	RoomID     string
+	RatePlanID string
+	Guest      domain.RatePlanGuest
+	Stay       domain.Stay
+	Currency   string
+	PromoCode  string
+}
+
+// QuoteResult is the quote of a QuoteRequest, or the error it could not be priced with
+type QuoteResult struct {
+	Quote *domain.Quote
+	Err   error
+}
+
+// batchConcurrency bounds how many quotes of a batch are priced at the same time
+const batchConcurrency = 8
+
+// hotelPricing is what pricing the rooms of a hotel needs besides the room itself
+type hotelPricing struct {
+	hotel     *domain.Hotel
+	stayRules []domain.StayRule
+	rules     []domain.PricingRule
+	taxFees   []domain.TaxFee
+	ratePlans []domain.RatePlan
+}
+
+// CalculateRoomPrice quotes a stay in the room currency, a stay with more guests than the room
+// sleeps or breaking a length of stay restriction is refused with a *domain.StayViolation and a
+// stay that cannot be booked on the rate plan with a *domain.RatePlanViolation. The length of
+// stay discount and the discounts of the promotion with the promo code and of the eligible
+// campaigns are taken off before taxes and fees. When a currency is set to another one, the total
+// is also converted at the exchange rate effective today. The quote is signed, see VerifyQuote.
+func (s *PricingService) CalculateRoomPrice(ctx context.Context, req QuoteRequest) (*domain.Quote, error) {
+	room, err := s.roomRepository.FindByRoomID(ctx, req.RoomID)
+	if errors.Is(err, domain.ErrNotFound) {
+		return nil, fmt.Errorf("room not found: %w", domain.ErrNotForSale)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if room.HotelID != req.HotelID {
+		slog.Error("[SERVICE]", "message", fmt.Sprintf("hotelID does not match with room, room.HotelID:%s, hotelID:%s", room.HotelID, req.HotelID))
+		return nil, fmt.Errorf("hotelID does not match with room: %w", domain.ErrNotForSale)
+	}
+
+	pricing, err := s.loadHotelPricing(ctx, req.HotelID)
+	if err != nil {
+		return nil, err
+	}
+
+	plan, err := findRatePlan(pricing, req.RatePlanID)
+	if err != nil {
+		return nil, err
+	}
+
+	policy, err := s.cancellationPolicyRepository.FindByID(ctx, policyID(room, plan))
+	if err != nil {
+		return nil, err
+	}
+
+	promotions, err := s.promotionRepository.FindAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.quote(ctx, req, room, pricing, plan, policy, promotions, time.Now())
+}
+
+// CalculateRoomPrices quotes many stays like CalculateRoomPrice, the results are in the order of
+// reqs and a stay that cannot be priced has the error instead of a quote. Rooms are loaded at
+// once and what the rooms of a hotel share only once per hotel, at most batchConcurrency stays
+// are priced at the same time. The error is only returned when the rooms or the promotions
+// cannot be loaded.
+func (s *PricingService) CalculateRoomPrices(ctx context.Context, reqs []QuoteRequest) ([]QuoteResult, error) {
+	roomIDs := make([]string, 0, len(reqs))
+	for _, req := range reqs {
+		roomIDs = append(roomIDs, req.RoomID)
+	}
+
+	found, err := s.roomRepository.FindByRoomIDs(ctx, roomIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	promotions, err := s.promotionRepository.FindAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		rooms       = make(map[string]*domain.Room, len(found))
+		pricings    = map[string]*hotelPricing{}
+		pricingErrs = map[string]error{}
+		policies    = map[string]*domain.CancellationPolicy{}
+		policyErrs  = map[string]error{}
+		plans       = make([]*domain.RatePlan, len(reqs))
+		results     = make([]QuoteResult, len(reqs))
+		pending     []int
+	)
+	for i := range found {
+		rooms[found[i].ID] = &found[i]
+	}
+
+	// what stays share is loaded one after the other, only the stays themselves are priced
+	// concurrently
+	for i, req := range reqs {
+		room, ok := rooms[req.RoomID]
+		if !ok {
+			slog.Error("[SERVICE]", "message", fmt.Sprintf("room not found, roomID:%s", req.RoomID))
+			results[i].Err = fmt.Errorf("room not found: %w", domain.ErrNotForSale)
+			continue
+		}
+		if room.HotelID != req.HotelID {
+			slog.Error("[SERVICE]", "message", fmt.Sprintf("hotelID does not match with room, room.HotelID:%s, hotelID:%s", room.HotelID, req.HotelID))
+			results[i].Err = fmt.Errorf("hotelID does not match with room: %w", domain.ErrNotForSale)
+			continue
+		}
+		if _, ok := pricings[req.HotelID]; !ok {
+			pricings[req.HotelID], pricingErrs[req.HotelID] = s.loadHotelPricing(ctx, req.HotelID)
+		}
+		if err := pricingErrs[req.HotelID]; err != nil {
+			results[i].Err = err
+			continue
+		}
+		plan, err := findRatePlan(pricings[req.HotelID], req.RatePlanID)
+		if err != nil {
+			results[i].Err = err
+			continue
+		}
+		cancellationPolicyID := policyID(room, plan)
+		if _, ok := policies[cancellationPolicyID]; !ok {
+			policies[cancellationPolicyID], policyErrs[cancellationPolicyID] = s.cancellationPolicyRepository.FindByID(ctx, cancellationPolicyID)
+		}
+		if err := policyErrs[cancellationPolicyID]; err != nil {
+			results[i].Err = err
+			continue
+		}
+		plans[i], pending = plan, append(pending, i)
+	}
+
+	var (
+		wg        sync.WaitGroup
+		semaphore = make(chan struct{}, batchConcurrency)
+		now       = time.Now()
+	)
+	for _, i := range pending {
+		req := reqs[i]
+		room, plan := rooms[req.RoomID], plans[i]
+		pricing, policy := pricings[req.HotelID], policies[policyID(room, plan)]
+
+		semaphore <- struct{}{}
+		wg.Go(func() {
+			defer func() { <-semaphore }()
+			results[i].Quote, results[i].Err = s.quote(ctx, req, room, pricing, plan, policy, promotions, now)
+		})
+	}
+	wg.Wait()
+
+	return results, nil
+}
+
+func (s *PricingService) loadHotelPricing(ctx context.Context, hotelID string) (*hotelPricing, error) {
+	hotel, err := s.hotelRepository.FindByID(ctx, hotelID)
+	if err != nil {
+		return nil, err
+	}
+
+	stayRules, err := s.stayRuleRepository.FindByHotelID(ctx, hotelID)
+	if err != nil {
+		return nil, err
+	}
+
+	rules, err := s.pricingRuleRepository.FindByHotelID(ctx, hotelID)
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
 
-	if room.HotelID != hotelID {
-		slog.Error("[SERVICE]", "message", fmt.Sprintf("hotelID does not match with room, room.HotelID:%s, hotelID:%s", room.HotelID, hotelID))
-		return 0, fmt.Errorf("hotelID does not match with room")
+	taxFees, err := s.taxFeeRepository.FindByHotelID(ctx, hotelID)
+	if err != nil {
+		return nil, err
 	}
 
-	// Example pricing logic: base price multiplied by number of nights
-	totalPrice := room.CalculatePrice(nights)
-	return totalPrice, nil
+	ratePlans, err := s.ratePlanRepository.FindByHotelID(ctx, hotelID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &hotelPricing{hotel: hotel, stayRules: stayRules, rules: rules, taxFees: taxFees, ratePlans: ratePlans}, nil
+}
+
+// findRatePlan returns the rate plan a stay is priced on, nil without ratePlanID
+func findRatePlan(pricing *hotelPricing, ratePlanID string) (*domain.RatePlan, error) {
+	if ratePlanID == "" {
+		return nil, nil
+	}
+
+	plan, violation := domain.FindRatePlan(pricing.ratePlans, ratePlanID)
+	if violation != nil {
+		slog.Error("[SERVICE]", "message", fmt.Sprintf("rate plan not found, hotelID:%s, ratePlanID:%s", pricing.hotel.ID, ratePlanID))
+		return nil, violation
+	}
+	return plan, nil
+}
+
+// policyID is the cancellation policy a stay in room on plan is sold with
+func policyID(room *domain.Room, plan *domain.RatePlan) string {
+	if plan != nil && plan.CancellationPolicyID != "" {
+		return plan.CancellationPolicyID
+	}
+	return room.CancellationPolicyID
+}
+
+// quote prices a stay in a room of the hotel of pricing on plan, only rooms of a live hotel
+// inside their own activation window are sold
+func (s *PricingService) quote(ctx context.Context, req QuoteRequest, room *domain.Room, pricing *hotelPricing, plan *domain.RatePlan, policy *domain.CancellationPolicy, promotions []domain.Promotion, now time.Time) (*domain.Quote, error) {
+	stay := req.Stay
+
+	if !pricing.hotel.IsLiveAt(now) || !room.IsLiveAt(now) {
+		slog.Error("[SERVICE]", "message", fmt.Sprintf("room is not on sale, hotelID:%s, roomID:%s", req.HotelID, req.RoomID))
+		return nil, fmt.Errorf("room is not live: %w", domain.ErrNotForSale)
+	}
+
+	if plan != nil {
+		if violation := plan.Check(room, stay, req.Guest, now.UTC()); violation != nil {
+			slog.Error("[SERVICE]", "message", fmt.Sprintf("stay is not eligible for the rate plan, roomID:%s, ratePlanID:%s", req.RoomID, plan.ID), "reason", violation.Reason)
+			return nil, violation
+		}
+	}
+
+	if violation := room.CheckOccupancy(stay); violation != nil {
+		slog.Error("[SERVICE]", "message", fmt.Sprintf("stay exceeds room occupancy, roomID:%s, guests:%d", req.RoomID, stay.Guests()), "reason", violation.Reason)
+		return nil, violation
+	}
+
+	if violation := domain.CheckStay(room, stay, pricing.stayRules); violation != nil {
+		slog.Error("[SERVICE]", "message", fmt.Sprintf("stay breaks a restriction, roomID:%s, ruleID:%s", req.RoomID, violation.RuleID), "reason", violation.Reason)
+		return nil, violation
+	}
+
+	rates, err := s.roomRateRepository.FindByRoomID(ctx, req.RoomID, stay.CheckIn.Format(domain.DateLayout), stay.CheckOut.Format(domain.DateLayout))
+	if err != nil {
+		return nil, err
+	}
+
+	// rates are entered in the room currency and a room with rates cannot move to another one,
+	// rooms moved by a catalog import still need new ones
+	nightly := make(map[string]domain.Money, len(rates))
+	for _, rate := range rates {
+		if rate.Price.Currency != room.BasePrice.Currency {
+			slog.Error("[SERVICE]", "message", fmt.Sprintf("room rate currency does not match with room, rate.Currency:%s, room.Currency:%s", rate.Price.Currency, room.BasePrice.Currency), "room_id", req.RoomID, "date", rate.Date)
+			return nil, fmt.Errorf("room rate of %s is in %s, the room is sold in %s: %w", rate.Date, rate.Price.Currency, room.BasePrice.Currency, domain.ErrCurrencyMismatch)
+		}
+		nightly[rate.Date] = rate.Price
+	}
+
+	quote := room.Quote(stay, nightly, pricing.rules, plan, policy)
+	quote.ApplyStayDiscount(room, stay, pricing.stayRules)
+	quote.ApplyPromotions(req.PromoCode, promotions, room, stay, now.UTC())
+	if err := quote.ApplyTaxesAndFees(pricing.taxFees, stay.Guests()); err != nil {
+		slog.Error("[SERVICE]", "message", "error while applying taxes and fees", "hotel_id", req.HotelID, "room_id", req.RoomID, "error", err.Error())
+		return nil, err
+	}
+
+	if req.Currency != "" && req.Currency != quote.Currency {
+		rate, err := s.exchangeRateRepository.FindEffective(ctx, quote.Currency, req.Currency, now.UTC().Format(domain.DateLayout))
+		if err != nil {
+			return nil, err
+		}
+		quote.ConvertTo(*rate)
+	}
+
+	s.quoteSigner.Sign(quote, req, now)
+	return quote, nil
+}
+
+// PriceSimulation is a draft of the prices of a hotel to preview for the nights from From up to
+// To, exclusive. Without RoomIDs every room on sale is previewed.
+type PriceSimulation struct {
+	HotelID string
+	RoomIDs []string
+	From    time.Time
+	To      time.Time
+	Draft   domain.PriceDraft
+}
+
+// SimulatePrices returns the room by night grid of the current prices of the hotel and the prices
+// the draft would give them. Nothing is stored, the draft can only change the prices of rooms of
+// the hotel in their own currency and replace or remove pricing rules of the hotel.
+func (s *PricingService) SimulatePrices(ctx context.Context, sim PriceSimulation) ([]domain.SimulatedRoom, error) {
+	if _, err := s.hotelRepository.FindByID(ctx, sim.HotelID); err != nil {
+		return nil, err
+	}
+
+	hotelRooms, err := s.roomRepository.FindByHotelID(ctx, sim.HotelID)
+	if err != nil {
+		return nil, err
+	}
+
+	rules, err := s.pricingRuleRepository.FindByHotelID(ctx, sim.HotelID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := checkPriceDraft(sim.HotelID, hotelRooms, rules, &sim.Draft); err != nil {
+		return nil, err
+	}
+
+	var rooms []domain.Room
+	for _, room := range hotelRooms {
+		if len(sim.RoomIDs) == 0 || slices.Contains(sim.RoomIDs, room.ID) {
+			rooms = append(rooms, room)
+		}
+	}
+
+	roomIDs := make([]string, len(rooms))
+	rates := make(map[string]map[string]domain.Money, len(rooms))
+	currencies := make(map[string]string, len(rooms))
+	for i, room := range rooms {
+		roomIDs[i] = room.ID
+		rates[room.ID] = map[string]domain.Money{}
+		currencies[room.ID] = room.BasePrice.Currency
+	}
+
+	roomRates, err := s.roomRateRepository.FindByRoomIDs(ctx, roomIDs, sim.From.Format(domain.DateLayout), sim.To.Format(domain.DateLayout))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, rate := range roomRates {
+		if rate.Price.Currency != currencies[rate.RoomID] {
+			slog.Error("[SERVICE]", "message", fmt.Sprintf("room rate currency does not match with room, rate.Currency:%s, room.Currency:%s", rate.Price.Currency, currencies[rate.RoomID]), "room_id", rate.RoomID, "date", rate.Date)
+			return nil, fmt.Errorf("room rate of %s is in %s, the room is sold in %s: %w", rate.Date, rate.Price.Currency, currencies[rate.RoomID], domain.ErrCurrencyMismatch)
+		}
+		rates[rate.RoomID][rate.Date] = rate.Price
+	}
+
+	nights := domain.Stay{CheckIn: sim.From, CheckOut: sim.To}.Nights()
+	return sim.Draft.Simulate(rooms, nights, rates, rules), nil
+}
+
+// checkPriceDraft makes sure the draft prices rooms of the hotel in their currency and only
+// replaces or removes pricing rules of the hotel, draft rules are made rules of the hotel. It
+// returns an error wrapping domain.ErrInvalidDraft otherwise.
+func checkPriceDraft(hotelID string, rooms []domain.Room, rules []domain.PricingRule, draft *domain.PriceDraft) error {
+	currencies := make(map[string]string, len(rooms))
+	for _, room := range rooms {
+		currencies[room.ID] = room.BasePrice.Currency
+	}
+	checkPrice := func(roomID string, price domain.Money) error {
+		roomCurrency, ok := currencies[roomID]
+		if !ok {
+			slog.Error("[SERVICE]", "message", fmt.Sprintf("room is not a room of the hotel on sale, roomID:%s, hotelID:%s", roomID, hotelID))
+			return fmt.Errorf("room %s is not a room of the hotel on sale: %w", roomID, domain.ErrInvalidDraft)
+		}
+		if price.Currency != roomCurrency {
+			slog.Error("[SERVICE]", "message", fmt.Sprintf("draft price currency does not match with room, price.Currency:%s, room.Currency:%s", price.Currency, roomCurrency), "room_id", roomID)
+			return fmt.Errorf("price of room %s is in %s, the room is sold in %s: %w", roomID, price.Currency, roomCurrency, domain.ErrInvalidDraft)
+		}
+		return nil
+	}
+
+	for roomID, basePrice := range draft.BasePrices {
+		if err := checkPrice(roomID, basePrice); err != nil {
+			return err
+		}
+	}
+	for _, rate := range draft.Rates {
+		if err := checkPrice(rate.RoomID, rate.Price); err != nil {
+			return err
+		}
+	}
+
+	ruleIDs := make([]string, 0, len(draft.RemovedRuleIDs)+len(draft.Rules))
+	ruleIDs = append(ruleIDs, draft.RemovedRuleIDs...)
+	for i := range draft.Rules {
+		draft.Rules[i].HotelID, draft.Rules[i].IsActive = hotelID, true
+		if draft.Rules[i].ID != "" {
+			ruleIDs = append(ruleIDs, draft.Rules[i].ID)
+		}
+	}
+	for _, ruleID := range ruleIDs {
+		if !slices.ContainsFunc(rules, func(rule domain.PricingRule) bool { return rule.ID == ruleID }) {
+			slog.Error("[SERVICE]", "message", fmt.Sprintf("pricing rule is not a rule of the hotel, ruleID:%s, hotelID:%s", ruleID, hotelID))
+			return fmt.Errorf("pricing rule %s is not a rule of the hotel: %w", ruleID, domain.ErrInvalidDraft)
+		}
+	}
+
+	return nil
+}
+
+// VerifyQuote confirms a signed quote was priced by this service as is and has not expired, so
+// its total can be honoured without pricing the stay again. It returns domain.ErrQuoteSignature
+// or domain.ErrQuoteExpired otherwise.
+func (s *PricingService) VerifyQuote(ctx context.Context, signed *domain.SignedQuote) error {
+	if err := s.quoteSigner.Verify(signed, time.Now()); err != nil {
+		slog.Error("[SERVICE]", "message", fmt.Sprintf("quote not verified, quoteID:%s", signed.ID), "error", err.Error())
+		return err
+	}
+	return nil
 }
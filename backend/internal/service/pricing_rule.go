@@ -0,0 +1,148 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/chayutK/hotel-property-service/internal/domain"
+	"github.com/chayutK/hotel-property-service/internal/port"
+	"github.com/google/uuid"
+)
+
+type PricingRuleService struct {
+	hotelRepository       port.HotelPort
+	roomRepository        port.RoomPort
+	pricingRuleRepository port.PricingRulePort
+	auditRepository       port.AuditPort
+	transactor            port.TransactionPort
+}
+
+func NewPricingRuleService(hotelRepository port.HotelPort, roomRepository port.RoomPort, pricingRuleRepository port.PricingRulePort, auditRepository port.AuditPort, transactor port.TransactionPort) *PricingRuleService {
+	return &PricingRuleService{
+		hotelRepository:       hotelRepository,
+		roomRepository:        roomRepository,
+		pricingRuleRepository: pricingRuleRepository,
+		auditRepository:       auditRepository,
+		transactor:            transactor,
+	}
+}
+
+func (s *PricingRuleService) GetPricingRules(ctx context.Context, hotelID string) ([]domain.PricingRule, error) {
+	if _, err := s.hotelRepository.FindByID(ctx, hotelID); err != nil {
+		return nil, err
+	}
+
+	return s.pricingRuleRepository.FindByHotelID(ctx, hotelID)
+}
+
+func (s *PricingRuleService) CreatePricingRule(ctx context.Context, rule *domain.PricingRule) (*domain.PricingRule, error) {
+	if err := s.checkScope(ctx, rule); err != nil {
+		return nil, err
+	}
+
+	rule.ID = uuid.NewString()
+	rule.IsActive = true
+
+	var created *domain.PricingRule
+	err := s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.pricingRuleRepository.Create(ctx, rule); err != nil {
+			return err
+		}
+
+		var err error
+		if created, err = s.pricingRuleRepository.FindByID(ctx, rule.ID); err != nil {
+			return err
+		}
+
+		return recordChange(ctx, s.auditRepository, domain.EntityPricingRule, domain.ActionCreate, created.ID, &domain.PricingRule{}, created)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+func (s *PricingRuleService) UpdatePricingRule(ctx context.Context, rule *domain.PricingRule) (*domain.PricingRule, error) {
+	var updated *domain.PricingRule
+	err := s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		before, err := s.getPricingRule(ctx, rule.HotelID, rule.ID)
+		if err != nil {
+			return err
+		}
+
+		if err := s.checkScope(ctx, rule); err != nil {
+			return err
+		}
+
+		if err := s.pricingRuleRepository.Update(ctx, rule); err != nil {
+			return err
+		}
+
+		if updated, err = s.pricingRuleRepository.FindByID(ctx, rule.ID); err != nil {
+			return err
+		}
+
+		return recordChange(ctx, s.auditRepository, domain.EntityPricingRule, domain.ActionUpdate, updated.ID, before, updated)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+func (s *PricingRuleService) DeactivatePricingRule(ctx context.Context, hotelID, ruleID string) error {
+	return s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		before, err := s.getPricingRule(ctx, hotelID, ruleID)
+		if err != nil {
+			return err
+		}
+
+		if err := s.pricingRuleRepository.Deactivate(ctx, ruleID); err != nil {
+			return err
+		}
+
+		after := *before
+		after.IsActive = false
+		return recordChange(ctx, s.auditRepository, domain.EntityPricingRule, domain.ActionDeactivate, ruleID, before, &after)
+	})
+}
+
+func (s *PricingRuleService) getPricingRule(ctx context.Context, hotelID, ruleID string) (*domain.PricingRule, error) {
+	rule, err := s.pricingRuleRepository.FindByID(ctx, ruleID)
+	if err != nil {
+		return nil, err
+	}
+
+	if rule.HotelID != hotelID {
+		slog.Error("[SERVICE]", "message", fmt.Sprintf("hotelID does not match with pricing rule, rule.HotelID:%s, hotelID:%s", rule.HotelID, hotelID))
+		return nil, fmt.Errorf("hotelID does not match with pricing rule")
+	}
+
+	return rule, nil
+}
+
+// checkScope makes sure the hotel exists and a rule narrowed to one room targets a room of it
+func (s *PricingRuleService) checkScope(ctx context.Context, rule *domain.PricingRule) error {
+	if _, err := s.hotelRepository.FindByID(ctx, rule.HotelID); err != nil {
+		return err
+	}
+
+	if rule.RoomID == "" {
+		return nil
+	}
+
+	room, err := s.roomRepository.FindByRoomID(ctx, rule.RoomID)
+	if err != nil {
+		return err
+	}
+
+	if room.HotelID != rule.HotelID {
+		slog.Error("[SERVICE]", "message", fmt.Sprintf("hotelID does not match with room, room.HotelID:%s, hotelID:%s", room.HotelID, rule.HotelID))
+		return fmt.Errorf("hotelID does not match with room")
+	}
+
+	return nil
+}
@@ -0,0 +1,138 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/chayutK/hotel-property-service/internal/domain"
+	"github.com/chayutK/hotel-property-service/internal/port"
+	"github.com/google/uuid"
+)
+
+type PromotionService struct {
+	promotionRepository port.PromotionPort
+	auditRepository     port.AuditPort
+	transactor          port.TransactionPort
+}
+
+func NewPromotionService(promotionRepository port.PromotionPort, auditRepository port.AuditPort, transactor port.TransactionPort) *PromotionService {
+	return &PromotionService{
+		promotionRepository: promotionRepository,
+		auditRepository:     auditRepository,
+		transactor:          transactor,
+	}
+}
+
+func (s *PromotionService) GetPromotions(ctx context.Context) ([]domain.Promotion, error) {
+	return s.promotionRepository.FindAll(ctx)
+}
+
+func (s *PromotionService) GetPromotion(ctx context.Context, promotionID string) (*domain.Promotion, error) {
+	return s.promotionRepository.FindByID(ctx, promotionID)
+}
+
+func (s *PromotionService) CreatePromotion(ctx context.Context, promotion *domain.Promotion) (*domain.Promotion, error) {
+	promotion.ID = uuid.NewString()
+	promotion.IsActive = true
+
+	if err := s.checkCode(ctx, promotion); err != nil {
+		return nil, err
+	}
+
+	var created *domain.Promotion
+	err := s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.promotionRepository.Create(ctx, promotion); err != nil {
+			return err
+		}
+
+		var err error
+		if created, err = s.promotionRepository.FindByID(ctx, promotion.ID); err != nil {
+			return err
+		}
+
+		return recordChange(ctx, s.auditRepository, domain.EntityPromotion, domain.ActionCreate, created.ID, &domain.Promotion{}, created)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+func (s *PromotionService) UpdatePromotion(ctx context.Context, promotion *domain.Promotion) (*domain.Promotion, error) {
+	var updated *domain.Promotion
+	err := s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		before, err := s.promotionRepository.FindByID(ctx, promotion.ID)
+		if err != nil {
+			return err
+		}
+
+		if err := s.checkCode(ctx, promotion); err != nil {
+			return err
+		}
+
+		if err := s.promotionRepository.Update(ctx, promotion); err != nil {
+			return err
+		}
+
+		if updated, err = s.promotionRepository.FindByID(ctx, promotion.ID); err != nil {
+			return err
+		}
+
+		return recordChange(ctx, s.auditRepository, domain.EntityPromotion, domain.ActionUpdate, updated.ID, before, updated)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+func (s *PromotionService) DeactivatePromotion(ctx context.Context, promotionID string) error {
+	return s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		before, err := s.promotionRepository.FindByID(ctx, promotionID)
+		if err != nil {
+			return err
+		}
+
+		if err := s.promotionRepository.Deactivate(ctx, promotionID); err != nil {
+			return err
+		}
+
+		after := *before
+		after.IsActive = false
+		return recordChange(ctx, s.auditRepository, domain.EntityPromotion, domain.ActionDeactivate, promotionID, before, &after)
+	})
+}
+
+// RedeemPromotion counts a booking made with the promotion towards its usage cap
+func (s *PromotionService) RedeemPromotion(ctx context.Context, promotionID string) (*domain.Promotion, error) {
+	if err := s.promotionRepository.Redeem(ctx, promotionID); err != nil {
+		return nil, err
+	}
+
+	return s.promotionRepository.FindByID(ctx, promotionID)
+}
+
+// checkCode makes sure a guest entering a code gets exactly one promotion
+func (s *PromotionService) checkCode(ctx context.Context, promotion *domain.Promotion) error {
+	if promotion.Code == "" {
+		return nil
+	}
+
+	promotions, err := s.promotionRepository.FindAll(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, other := range promotions {
+		if other.ID != promotion.ID && strings.EqualFold(other.Code, promotion.Code) {
+			slog.Error("[SERVICE]", "message", fmt.Sprintf("promotion code is taken, code:%s, promotionID:%s", promotion.Code, other.ID))
+			return fmt.Errorf("%w: %s", domain.ErrPromotionCodeTaken, promotion.Code)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,59 @@
+package service
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"time"
+
+	"github.com/chayutK/hotel-property-service/internal/domain"
+	"github.com/google/uuid"
+)
+
+// QuoteSigner signs quotes with HMAC-SHA256 so they can be honoured until they expire, ttl after
+// they were priced
+type QuoteSigner struct {
+	key []byte
+	ttl time.Duration
+}
+
+func NewQuoteSigner(key []byte, ttl time.Duration) *QuoteSigner {
+	return &QuoteSigner{
+		key: key,
+		ttl: ttl,
+	}
+}
+
+// Sign gives the quote of req an ID, an expiry and the signature over both, the room, the rate
+// plan, the stay and the total
+func (s *QuoteSigner) Sign(quote *domain.Quote, req QuoteRequest, now time.Time) {
+	signed := &domain.SignedQuote{
+		ID:         uuid.NewString(),
+		HotelID:    req.HotelID,
+		RoomID:     req.RoomID,
+		RatePlanID: req.RatePlanID,
+		Stay:       req.Stay,
+		Total:      quote.Total(),
+		ExpiresAt:  now.Add(s.ttl).UTC().Truncate(time.Second),
+	}
+	signed.Signature = s.signature(signed)
+	quote.Signed = signed
+}
+
+// Verify returns domain.ErrQuoteSignature when the quote was not signed with this key or was
+// changed since, and domain.ErrQuoteExpired when it is authentic but expired at now
+func (s *QuoteSigner) Verify(signed *domain.SignedQuote, now time.Time) error {
+	if !hmac.Equal([]byte(signed.Signature), []byte(s.signature(signed))) {
+		return domain.ErrQuoteSignature
+	}
+	if !now.Before(signed.ExpiresAt) {
+		return domain.ErrQuoteExpired
+	}
+	return nil
+}
+
+func (s *QuoteSigner) signature(signed *domain.SignedQuote) string {
+	mac := hmac.New(sha256.New, s.key)
+	mac.Write([]byte(signed.Payload()))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
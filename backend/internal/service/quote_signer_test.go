@@ -0,0 +1,105 @@
+package service
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/chayutK/hotel-property-service/internal/constants/currency"
+	"github.com/chayutK/hotel-property-service/internal/domain"
+)
+
+var signedAt = time.Date(2026, 11, 1, 10, 0, 0, 0, time.UTC)
+
+// signedQuote signs a quote of two nights at 1000 THB for two adults and two children
+func signedQuote(t *testing.T, signer *QuoteSigner) domain.SignedQuote {
+	t.Helper()
+
+	quote := &domain.Quote{Currency: currency.THB}
+	for range 2 {
+		quote.Nights = append(quote.Nights, domain.NightlyPrice{Rate: domain.NewMoney(1000, currency.THB)})
+	}
+
+	signer.Sign(quote, QuoteRequest{
+		HotelID:    "hotel-1",
+		RoomID:     "room-1",
+		RatePlanID: "plan-1",
+		Stay: domain.Stay{
+			CheckIn:   time.Date(2026, 12, 10, 0, 0, 0, 0, time.UTC),
+			CheckOut:  time.Date(2026, 12, 12, 0, 0, 0, 0, time.UTC),
+			Adults:    2,
+			ChildAges: []int{10, 4},
+		},
+	}, signedAt)
+
+	if quote.Signed == nil {
+		t.Fatal("Sign() left the quote unsigned")
+	}
+	return *quote.Signed
+}
+
+func TestQuoteSignerSign(t *testing.T) {
+	signer := NewQuoteSigner([]byte("secret"), 15*time.Minute)
+	signed := signedQuote(t, signer)
+
+	if signed.ID == "" || signed.Signature == "" {
+		t.Errorf("signed quote has ID %q and signature %q", signed.ID, signed.Signature)
+	}
+	if want := signedAt.Add(15 * time.Minute); !signed.ExpiresAt.Equal(want) {
+		t.Errorf("ExpiresAt = %s, want %s", signed.ExpiresAt, want)
+	}
+	if got := signed.Total.String() + " " + signed.Total.Currency; got != "2000.00 THB" {
+		t.Errorf("Total = %s, want 2000.00 THB", got)
+	}
+	if other := signedQuote(t, signer); other.ID == signed.ID {
+		t.Errorf("two quotes share the ID %s", signed.ID)
+	}
+}
+
+func TestQuoteSignerVerify(t *testing.T) {
+	signer := NewQuoteSigner([]byte("secret"), 15*time.Minute)
+
+	tests := []struct {
+		name   string
+		change func(*domain.SignedQuote)
+		signer *QuoteSigner
+		at     time.Time
+		want   error
+	}{
+		{name: "as signed", at: signedAt},
+		{name: "children listed in another order", change: func(q *domain.SignedQuote) { q.Stay.ChildAges = []int{4, 10} }, at: signedAt},
+		{name: "a second before expiry", at: signedAt.Add(15*time.Minute - time.Second)},
+		{name: "at expiry", at: signedAt.Add(15 * time.Minute), want: domain.ErrQuoteExpired},
+		{name: "signed with another key", signer: NewQuoteSigner([]byte("other"), 15*time.Minute), at: signedAt, want: domain.ErrQuoteSignature},
+		{name: "tampered quote ID", change: func(q *domain.SignedQuote) { q.ID = "quote-2" }, at: signedAt, want: domain.ErrQuoteSignature},
+		{name: "tampered hotel", change: func(q *domain.SignedQuote) { q.HotelID = "hotel-2" }, at: signedAt, want: domain.ErrQuoteSignature},
+		{name: "tampered room", change: func(q *domain.SignedQuote) { q.RoomID = "room-2" }, at: signedAt, want: domain.ErrQuoteSignature},
+		{name: "tampered rate plan", change: func(q *domain.SignedQuote) { q.RatePlanID = "" }, at: signedAt, want: domain.ErrQuoteSignature},
+		{name: "tampered check-in", change: func(q *domain.SignedQuote) { q.Stay.CheckIn = q.Stay.CheckIn.AddDate(0, 0, -1) }, at: signedAt, want: domain.ErrQuoteSignature},
+		{name: "tampered check-out", change: func(q *domain.SignedQuote) { q.Stay.CheckOut = q.Stay.CheckOut.AddDate(0, 0, 1) }, at: signedAt, want: domain.ErrQuoteSignature},
+		{name: "tampered adults", change: func(q *domain.SignedQuote) { q.Stay.Adults = 3 }, at: signedAt, want: domain.ErrQuoteSignature},
+		{name: "tampered child ages", change: func(q *domain.SignedQuote) { q.Stay.ChildAges = []int{4, 11} }, at: signedAt, want: domain.ErrQuoteSignature},
+		{name: "tampered total", change: func(q *domain.SignedQuote) { q.Total.Amount = 100 }, at: signedAt, want: domain.ErrQuoteSignature},
+		{name: "tampered currency", change: func(q *domain.SignedQuote) { q.Total.Currency = currency.USD }, at: signedAt, want: domain.ErrQuoteSignature},
+		{name: "extended expiry", change: func(q *domain.SignedQuote) { q.ExpiresAt = q.ExpiresAt.Add(time.Hour) }, at: signedAt.Add(time.Hour), want: domain.ErrQuoteSignature},
+		{name: "no signature", change: func(q *domain.SignedQuote) { q.Signature = "" }, at: signedAt, want: domain.ErrQuoteSignature},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			verifier := signer
+			if tt.signer != nil {
+				verifier = tt.signer
+			}
+
+			signed := signedQuote(t, signer)
+			if tt.change != nil {
+				tt.change(&signed)
+			}
+
+			if err := verifier.Verify(&signed, tt.at); !errors.Is(err, tt.want) {
+				t.Errorf("Verify() = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
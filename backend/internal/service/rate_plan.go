@@ -0,0 +1,188 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/chayutK/hotel-property-service/internal/domain"
+	"github.com/chayutK/hotel-property-service/internal/port"
+	"github.com/google/uuid"
+)
+
+type RatePlanService struct {
+	hotelRepository              port.HotelPort
+	roomRepository               port.RoomPort
+	cancellationPolicyRepository port.CancellationPolicyPort
+	ratePlanRepository           port.RatePlanPort
+	auditRepository              port.AuditPort
+	transactor                   port.TransactionPort
+}
+
+func NewRatePlanService(hotelRepository port.HotelPort, roomRepository port.RoomPort, cancellationPolicyRepository port.CancellationPolicyPort, ratePlanRepository port.RatePlanPort, auditRepository port.AuditPort, transactor port.TransactionPort) *RatePlanService {
+	return &RatePlanService{
+		hotelRepository:              hotelRepository,
+		roomRepository:               roomRepository,
+		cancellationPolicyRepository: cancellationPolicyRepository,
+		ratePlanRepository:           ratePlanRepository,
+		auditRepository:              auditRepository,
+		transactor:                   transactor,
+	}
+}
+
+func (s *RatePlanService) GetRatePlans(ctx context.Context, hotelID string) ([]domain.RatePlan, error) {
+	if _, err := s.hotelRepository.FindByID(ctx, hotelID); err != nil {
+		return nil, err
+	}
+
+	return s.ratePlanRepository.FindByHotelID(ctx, hotelID)
+}
+
+func (s *RatePlanService) CreateRatePlan(ctx context.Context, plan *domain.RatePlan) (*domain.RatePlan, error) {
+	if err := s.checkRatePlan(ctx, plan); err != nil {
+		return nil, err
+	}
+
+	plan.ID = uuid.NewString()
+	plan.IsActive = true
+
+	var created *domain.RatePlan
+	err := s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.ratePlanRepository.Create(ctx, plan); err != nil {
+			return err
+		}
+
+		var err error
+		if created, err = s.ratePlanRepository.FindByID(ctx, plan.ID); err != nil {
+			return err
+		}
+
+		return recordChange(ctx, s.auditRepository, domain.EntityRatePlan, domain.ActionCreate, created.ID, &domain.RatePlan{}, created)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+// createBestAvailableRate gives a new hotel its best available rate plan, audited like the plans
+// created through the API
+func createBestAvailableRate(ctx context.Context, ratePlanRepository port.RatePlanPort, auditRepository port.AuditPort, hotelID string) error {
+	plan := domain.BestAvailableRate(hotelID)
+	plan.ID = uuid.NewString()
+
+	if err := ratePlanRepository.Create(ctx, &plan); err != nil {
+		return err
+	}
+
+	return recordChange(ctx, auditRepository, domain.EntityRatePlan, domain.ActionCreate, plan.ID, &domain.RatePlan{}, &plan)
+}
+
+func (s *RatePlanService) UpdateRatePlan(ctx context.Context, plan *domain.RatePlan) (*domain.RatePlan, error) {
+	var updated *domain.RatePlan
+	err := s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		before, err := s.getRatePlan(ctx, plan.HotelID, plan.ID)
+		if err != nil {
+			return err
+		}
+
+		if err := s.checkRatePlan(ctx, plan); err != nil {
+			return err
+		}
+
+		if err := s.ratePlanRepository.Update(ctx, plan); err != nil {
+			return err
+		}
+
+		if updated, err = s.ratePlanRepository.FindByID(ctx, plan.ID); err != nil {
+			return err
+		}
+
+		return recordChange(ctx, s.auditRepository, domain.EntityRatePlan, domain.ActionUpdate, updated.ID, before, updated)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+func (s *RatePlanService) DeactivateRatePlan(ctx context.Context, hotelID, ratePlanID string) error {
+	return s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		before, err := s.getRatePlan(ctx, hotelID, ratePlanID)
+		if err != nil {
+			return err
+		}
+
+		if err := s.ratePlanRepository.Deactivate(ctx, ratePlanID); err != nil {
+			return err
+		}
+
+		after := *before
+		after.IsActive = false
+		return recordChange(ctx, s.auditRepository, domain.EntityRatePlan, domain.ActionDeactivate, ratePlanID, before, &after)
+	})
+}
+
+func (s *RatePlanService) getRatePlan(ctx context.Context, hotelID, ratePlanID string) (*domain.RatePlan, error) {
+	plan, err := s.ratePlanRepository.FindByID(ctx, ratePlanID)
+	if err != nil {
+		return nil, err
+	}
+
+	if plan.HotelID != hotelID {
+		slog.Error("[SERVICE]", "message", fmt.Sprintf("hotelID does not match with rate plan, plan.HotelID:%s, hotelID:%s", plan.HotelID, hotelID))
+		return nil, fmt.Errorf("hotelID does not match with rate plan")
+	}
+
+	return plan, nil
+}
+
+// checkRatePlan makes sure the hotel exists, the plan is sold with an active cancellation policy
+// and on rooms of the hotel, and no other plan of the hotel has its code
+func (s *RatePlanService) checkRatePlan(ctx context.Context, plan *domain.RatePlan) error {
+	if _, err := s.hotelRepository.FindByID(ctx, plan.HotelID); err != nil {
+		return err
+	}
+
+	// plans are only sold with an active cancellation policy, without one they use the room's
+	if plan.CancellationPolicyID != "" {
+		if _, err := s.cancellationPolicyRepository.FindByID(ctx, plan.CancellationPolicyID); err != nil {
+			return err
+		}
+	}
+
+	if len(plan.RoomIDs) > 0 {
+		rooms, err := s.roomRepository.FindByRoomIDs(ctx, plan.RoomIDs)
+		if err != nil {
+			return err
+		}
+
+		hotelRooms := make(map[string]bool, len(rooms))
+		for _, room := range rooms {
+			hotelRooms[room.ID] = room.HotelID == plan.HotelID
+		}
+		for _, roomID := range plan.RoomIDs {
+			if !hotelRooms[roomID] {
+				slog.Error("[SERVICE]", "message", fmt.Sprintf("room is not a room of the hotel, roomID:%s, hotelID:%s", roomID, plan.HotelID))
+				return fmt.Errorf("hotelID does not match with room")
+			}
+		}
+	}
+
+	plans, err := s.ratePlanRepository.FindByHotelID(ctx, plan.HotelID)
+	if err != nil {
+		return err
+	}
+
+	for _, other := range plans {
+		if other.ID != plan.ID && strings.EqualFold(other.Code, plan.Code) {
+			slog.Error("[SERVICE]", "message", fmt.Sprintf("rate plan code is taken, code:%s, ratePlanID:%s", plan.Code, other.ID))
+			return fmt.Errorf("%w: %s", domain.ErrRatePlanCodeTaken, plan.Code)
+		}
+	}
+
+	return nil
+}
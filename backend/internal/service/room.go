@@ -4,31 +4,81 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"time"
 
 	"github.com/chayutK/hotel-property-service/internal/domain"
 	"github.com/chayutK/hotel-property-service/internal/port"
+	"github.com/google/uuid"
 )
 
 type RoomService struct {
-	roomRepository port.RoomPort
+	hotelRepository              port.HotelPort
+	roomRepository               port.RoomPort
+	physicalRoomRepository       port.PhysicalRoomPort
+	cancellationPolicyRepository port.CancellationPolicyPort
+	auditRepository              port.AuditPort
+	priceHistoryRepository       port.PriceHistoryPort
+	ratePlanRepository           port.RatePlanPort
+	transactor                   port.TransactionPort
+	roomRateRepository           port.RoomRatePort
 }
 
-func NewRoomService(roomRepository port.RoomPort) *RoomService {
+func NewRoomService(hotelRepository port.HotelPort, roomRepository port.RoomPort, physicalRoomRepository port.PhysicalRoomPort, cancellationPolicyRepository port.CancellationPolicyPort, auditRepository port.AuditPort, priceHistoryRepository port.PriceHistoryPort, ratePlanRepository port.RatePlanPort, transactor port.TransactionPort, roomRateRepository port.RoomRatePort) *RoomService {
 	return &RoomService{
-		roomRepository: roomRepository,
+		hotelRepository:              hotelRepository,
+		roomRepository:               roomRepository,
+		physicalRoomRepository:       physicalRoomRepository,
+		cancellationPolicyRepository: cancellationPolicyRepository,
+		auditRepository:              auditRepository,
+		priceHistoryRepository:       priceHistoryRepository,
+		ratePlanRepository:           ratePlanRepository,
+		transactor:                   transactor,
+		roomRateRepository:           roomRateRepository,
 	}
 }
 
+// GetRoomsByHotelID returns the rooms of a hotel with the rate plans each is sold on
 func (s *RoomService) GetRoomsByHotelID(ctx context.Context, hotelID string) ([]domain.Room, error) {
 	rooms, err := s.roomRepository.FindByHotelID(ctx, hotelID)
 	if err != nil {
 		return nil, err
 	}
 
+	plans, err := s.ratePlanRepository.FindByHotelID(ctx, hotelID)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range rooms {
+		rooms[i].RatePlans = domain.RatePlansFor(&rooms[i], plans)
+	}
+
 	return rooms, nil
 }
 
 func (s *RoomService) GetRoomByRoomID(ctx context.Context, hotelID, roomID string) (*domain.Room, error) {
+	room, err := s.GetRoomForUpdate(ctx, hotelID, roomID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !room.IsLiveAt(time.Now()) {
+		slog.Error("[SERVICE]", "message", fmt.Sprintf("room is outside its activation window, roomID:%s", roomID))
+		return nil, fmt.Errorf("room is not live: %w", domain.ErrNotFound)
+	}
+
+	plans, err := s.ratePlanRepository.FindByHotelID(ctx, hotelID)
+	if err != nil {
+		return nil, err
+	}
+	room.RatePlans = domain.RatePlansFor(room, plans)
+
+	return room, nil
+}
+
+// GetRoomForUpdate returns an active room of the hotel whether or not its activation window
+// is open, so scheduled rooms can be edited before they go live.
+func (s *RoomService) GetRoomForUpdate(ctx context.Context, hotelID, roomID string) (*domain.Room, error) {
 	room, err := s.roomRepository.FindByRoomID(ctx, roomID)
 	if err != nil {
 		return nil, err
@@ -36,8 +86,142 @@ func (s *RoomService) GetRoomByRoomID(ctx context.Context, hotelID, roomID strin
 
 	if room.HotelID != hotelID {
 		slog.Error("[SERVICE]", "message", fmt.Sprintf("hotelID does not match with room, room.HotelID:%s, hotelID:%s", room.HotelID, hotelID))
-		return nil, fmt.Errorf("hotelID does not match with room")
+		return nil, fmt.Errorf("hotelID does not match with room: %w", domain.ErrNotFound)
 	}
 
 	return room, nil
 }
+
+func (s *RoomService) CreateRoom(ctx context.Context, room *domain.Room) (*domain.Room, error) {
+	if _, err := s.hotelRepository.FindByID(ctx, room.HotelID); err != nil {
+		return nil, err
+	}
+
+	// every offer is sold on a physical room of the same hotel
+	physicalRoom, err := s.physicalRoomRepository.FindByID(ctx, room.PhysicalRoomID)
+	if err != nil {
+		return nil, err
+	}
+
+	if physicalRoom.HotelID != room.HotelID {
+		slog.Error("[SERVICE]", "message", fmt.Sprintf("hotelID does not match with physical room, physicalRoom.HotelID:%s, hotelID:%s", physicalRoom.HotelID, room.HotelID))
+		return nil, fmt.Errorf("hotelID does not match with physical room")
+	}
+
+	if err := checkOfferType(room, physicalRoom); err != nil {
+		return nil, err
+	}
+
+	// rooms are only sold with an active cancellation policy
+	if _, err := s.cancellationPolicyRepository.FindByID(ctx, room.CancellationPolicyID); err != nil {
+		return nil, err
+	}
+
+	room.ID = uuid.NewString()
+	room.IsActive = true
+
+	var created *domain.Room
+	err = s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.roomRepository.Create(ctx, room); err != nil {
+			return err
+		}
+
+		var err error
+		if created, err = s.roomRepository.FindByRoomID(ctx, room.ID); err != nil {
+			return err
+		}
+
+		if err := recordChange(ctx, s.auditRepository, domain.EntityRoom, domain.ActionCreate, created.ID, &domain.Room{}, created); err != nil {
+			return err
+		}
+
+		return recordPrices(ctx, s.priceHistoryRepository, basePricePoints(nil, []domain.Room{*created}))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+func (s *RoomService) UpdateRoom(ctx context.Context, room *domain.Room) (*domain.Room, error) {
+	var updated *domain.Room
+	err := s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		before, err := s.GetRoomForUpdate(ctx, room.HotelID, room.ID)
+		if err != nil {
+			return err
+		}
+
+		physicalRoom, err := s.physicalRoomRepository.FindByID(ctx, before.PhysicalRoomID)
+		if err != nil {
+			return err
+		}
+
+		if err := checkOfferType(room, physicalRoom); err != nil {
+			return err
+		}
+
+		if _, err := s.cancellationPolicyRepository.FindByID(ctx, room.CancellationPolicyID); err != nil {
+			return err
+		}
+
+		// rate calendar prices are in the room currency, they have to go before it changes
+		if room.BasePrice.Currency != before.BasePrice.Currency {
+			rates, err := s.roomRateRepository.FindByRoomID(ctx, room.ID, "", "")
+			if err != nil {
+				return err
+			}
+			if len(rates) > 0 {
+				slog.Error("[SERVICE]", "message", fmt.Sprintf("room has rates in its currency, roomID:%s, currency:%s, rates:%d", room.ID, before.BasePrice.Currency, len(rates)))
+				return fmt.Errorf("room has %d rate calendar prices in %s, delete them before selling it in %s: %w", len(rates), before.BasePrice.Currency, room.BasePrice.Currency, domain.ErrCurrencyMismatch)
+			}
+		}
+
+		if err := s.roomRepository.Update(ctx, room); err != nil {
+			return err
+		}
+
+		if updated, err = s.roomRepository.FindByRoomID(ctx, room.ID); err != nil {
+			return err
+		}
+
+		if err := recordChange(ctx, s.auditRepository, domain.EntityRoom, domain.ActionUpdate, updated.ID, before, updated); err != nil {
+			return err
+		}
+
+		return recordPrices(ctx, s.priceHistoryRepository, basePricePoints(map[string]domain.Money{before.ID: before.BasePrice}, []domain.Room{*updated}))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+// DeleteRoom deactivates the room, only while it is still at updatedAt when that is not 0
+func (s *RoomService) DeleteRoom(ctx context.Context, hotelID, roomID string, updatedAt int64) error {
+	return s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		before, err := s.GetRoomForUpdate(ctx, hotelID, roomID)
+		if err != nil {
+			return err
+		}
+
+		if err := s.roomRepository.Deactivate(ctx, roomID, updatedAt); err != nil {
+			return err
+		}
+
+		after := *before
+		after.IsActive = false
+		return recordChange(ctx, s.auditRepository, domain.EntityRoom, domain.ActionDeactivate, roomID, before, &after)
+	})
+}
+
+// checkOfferType makes sure an offer is of the type of the physical room it sells
+func checkOfferType(room *domain.Room, physicalRoom *domain.PhysicalRoom) error {
+	if room.Type != physicalRoom.Type {
+		slog.Error("[SERVICE]", "message", fmt.Sprintf("room type does not match with physical room, room.Type:%s, physicalRoom.Type:%s", room.Type, physicalRoom.Type))
+		return fmt.Errorf("room type does not match with physical room")
+	}
+
+	return nil
+}
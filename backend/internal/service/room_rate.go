@@ -0,0 +1,180 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"time"
+
+	"github.com/chayutK/hotel-property-service/internal/domain"
+	"github.com/chayutK/hotel-property-service/internal/port"
+)
+
+type RoomRateService struct {
+	roomRepository         port.RoomPort
+	roomRateRepository     port.RoomRatePort
+	auditRepository        port.AuditPort
+	priceHistoryRepository port.PriceHistoryPort
+	transactor             port.TransactionPort
+}
+
+func NewRoomRateService(roomRepository port.RoomPort, roomRateRepository port.RoomRatePort, auditRepository port.AuditPort, priceHistoryRepository port.PriceHistoryPort, transactor port.TransactionPort) *RoomRateService {
+	return &RoomRateService{
+		roomRepository:         roomRepository,
+		roomRateRepository:     roomRateRepository,
+		auditRepository:        auditRepository,
+		priceHistoryRepository: priceHistoryRepository,
+		transactor:             transactor,
+	}
+}
+
+// GetRoomRates returns the rate calendar of a room for the nights from, inclusive, to to,
+// exclusive. Empty bounds are open.
+func (s *RoomRateService) GetRoomRates(ctx context.Context, hotelID, roomID, from, to string) ([]domain.RoomRate, error) {
+	if err := s.checkRoom(ctx, hotelID, roomID); err != nil {
+		return nil, err
+	}
+
+	return s.roomRateRepository.FindByRoomID(ctx, roomID, from, to)
+}
+
+// SetRoomRates creates or replaces the rates of the given nights, other nights are left as they
+// are. Rates are in the currency of the room. The stored rates of the nights between the first
+// and last given date are returned.
+func (s *RoomRateService) SetRoomRates(ctx context.Context, hotelID, roomID string, rates []domain.RoomRate) ([]domain.RoomRate, error) {
+	room, err := s.getRoom(ctx, hotelID, roomID)
+	if err != nil {
+		return nil, err
+	}
+
+	dates := make([]string, len(rates))
+	for i := range rates {
+		if rates[i].Price.Currency != room.BasePrice.Currency {
+			slog.Error("[SERVICE]", "message", fmt.Sprintf("room rate currency does not match with room, rate.Currency:%s, room.Currency:%s", rates[i].Price.Currency, room.BasePrice.Currency))
+			return nil, fmt.Errorf("room rate currency does not match with room")
+		}
+		rates[i].RoomID = roomID
+		dates[i] = rates[i].Date
+	}
+	from, to := slices.Min(dates), nextDate(slices.Max(dates))
+
+	stored, err := s.roomRateRepository.FindByRoomID(ctx, roomID, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	before := make(map[string]domain.RoomRate, len(stored))
+	for _, rate := range stored {
+		before[rate.Date] = rate
+	}
+
+	var (
+		changes []domain.CatalogChange
+		points  []domain.PricePoint
+	)
+	for _, rate := range rates {
+		action := domain.ActionUpdate
+		previous, ok := before[rate.Date]
+		if !ok {
+			action = domain.ActionCreate
+		}
+		if fields := domain.DiffFields(&previous, &rate); len(fields) > 0 {
+			changes = append(changes, domain.CatalogChange{Entity: domain.EntityRoomRate, ID: roomRateID(rate), Action: action, Fields: fields})
+			points = append(points, domain.PricePoint{RoomID: roomID, Source: domain.RateSourceCalendar, Date: rate.Date, Price: &rate.Price})
+		}
+	}
+
+	if len(changes) == 0 {
+		return stored, nil
+	}
+
+	err = s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.roomRateRepository.Upsert(ctx, rates); err != nil {
+			return err
+		}
+
+		if err := recordChanges(ctx, s.auditRepository, changes); err != nil {
+			return err
+		}
+
+		return recordPrices(ctx, s.priceHistoryRepository, points)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return s.roomRateRepository.FindByRoomID(ctx, roomID, from, to)
+}
+
+// DeleteRoomRate removes the rate of one night, the night goes back to the base price
+func (s *RoomRateService) DeleteRoomRate(ctx context.Context, hotelID, roomID, date string) error {
+	if err := s.checkRoom(ctx, hotelID, roomID); err != nil {
+		return err
+	}
+
+	return s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		stored, err := s.roomRateRepository.FindByRoomID(ctx, roomID, date, nextDate(date))
+		if err != nil {
+			return err
+		}
+
+		if err := s.roomRateRepository.Delete(ctx, roomID, date); err != nil {
+			return err
+		}
+
+		before := domain.RoomRate{RoomID: roomID, Date: date}
+		if len(stored) > 0 {
+			before = stored[0]
+		}
+		if err := recordChanges(ctx, s.auditRepository, []domain.CatalogChange{{
+			Entity: domain.EntityRoomRate,
+			ID:     roomRateID(before),
+			Action: domain.ActionDelete,
+			Fields: domain.DiffFields(&before, &domain.RoomRate{}),
+		}}); err != nil {
+			return err
+		}
+
+		// the night is back at the base price, unless it had no rate to begin with
+		if len(stored) == 0 {
+			return nil
+		}
+		return recordPrices(ctx, s.priceHistoryRepository, []domain.PricePoint{{RoomID: roomID, Source: domain.RateSourceCalendar, Date: date}})
+	})
+}
+
+// checkRoom makes sure the room is active and belongs to the hotel, rates of scheduled rooms
+// can be loaded before they go live
+func (s *RoomRateService) checkRoom(ctx context.Context, hotelID, roomID string) error {
+	_, err := s.getRoom(ctx, hotelID, roomID)
+	return err
+}
+
+func (s *RoomRateService) getRoom(ctx context.Context, hotelID, roomID string) (*domain.Room, error) {
+	room, err := s.roomRepository.FindByRoomID(ctx, roomID)
+	if err != nil {
+		return nil, err
+	}
+
+	if room.HotelID != hotelID {
+		slog.Error("[SERVICE]", "message", fmt.Sprintf("hotelID does not match with room, room.HotelID:%s, hotelID:%s", room.HotelID, hotelID))
+		return nil, fmt.Errorf("hotelID does not match with room")
+	}
+
+	return room, nil
+}
+
+// roomRateID identifies a rate in the audit log
+func roomRateID(rate domain.RoomRate) string {
+	return rate.RoomID + "/" + rate.Date
+}
+
+// nextDate returns the date after a valid date, it is the exclusive end of a one night range
+func nextDate(date string) string {
+	t, err := time.Parse(domain.DateLayout, date)
+	if err != nil {
+		return date
+	}
+	return t.AddDate(0, 0, 1).Format(domain.DateLayout)
+}
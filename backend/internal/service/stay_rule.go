@@ -0,0 +1,148 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/chayutK/hotel-property-service/internal/domain"
+	"github.com/chayutK/hotel-property-service/internal/port"
+	"github.com/google/uuid"
+)
+
+type StayRuleService struct {
+	hotelRepository    port.HotelPort
+	roomRepository     port.RoomPort
+	stayRuleRepository port.StayRulePort
+	auditRepository    port.AuditPort
+	transactor         port.TransactionPort
+}
+
+func NewStayRuleService(hotelRepository port.HotelPort, roomRepository port.RoomPort, stayRuleRepository port.StayRulePort, auditRepository port.AuditPort, transactor port.TransactionPort) *StayRuleService {
+	return &StayRuleService{
+		hotelRepository:    hotelRepository,
+		roomRepository:     roomRepository,
+		stayRuleRepository: stayRuleRepository,
+		auditRepository:    auditRepository,
+		transactor:         transactor,
+	}
+}
+
+func (s *StayRuleService) GetStayRules(ctx context.Context, hotelID string) ([]domain.StayRule, error) {
+	if _, err := s.hotelRepository.FindByID(ctx, hotelID); err != nil {
+		return nil, err
+	}
+
+	return s.stayRuleRepository.FindByHotelID(ctx, hotelID)
+}
+
+func (s *StayRuleService) CreateStayRule(ctx context.Context, rule *domain.StayRule) (*domain.StayRule, error) {
+	if err := s.checkScope(ctx, rule); err != nil {
+		return nil, err
+	}
+
+	rule.ID = uuid.NewString()
+	rule.IsActive = true
+
+	var created *domain.StayRule
+	err := s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.stayRuleRepository.Create(ctx, rule); err != nil {
+			return err
+		}
+
+		var err error
+		if created, err = s.stayRuleRepository.FindByID(ctx, rule.ID); err != nil {
+			return err
+		}
+
+		return recordChange(ctx, s.auditRepository, domain.EntityStayRule, domain.ActionCreate, created.ID, &domain.StayRule{}, created)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+func (s *StayRuleService) UpdateStayRule(ctx context.Context, rule *domain.StayRule) (*domain.StayRule, error) {
+	var updated *domain.StayRule
+	err := s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		before, err := s.getStayRule(ctx, rule.HotelID, rule.ID)
+		if err != nil {
+			return err
+		}
+
+		if err := s.checkScope(ctx, rule); err != nil {
+			return err
+		}
+
+		if err := s.stayRuleRepository.Update(ctx, rule); err != nil {
+			return err
+		}
+
+		if updated, err = s.stayRuleRepository.FindByID(ctx, rule.ID); err != nil {
+			return err
+		}
+
+		return recordChange(ctx, s.auditRepository, domain.EntityStayRule, domain.ActionUpdate, updated.ID, before, updated)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+func (s *StayRuleService) DeactivateStayRule(ctx context.Context, hotelID, ruleID string) error {
+	return s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		before, err := s.getStayRule(ctx, hotelID, ruleID)
+		if err != nil {
+			return err
+		}
+
+		if err := s.stayRuleRepository.Deactivate(ctx, ruleID); err != nil {
+			return err
+		}
+
+		after := *before
+		after.IsActive = false
+		return recordChange(ctx, s.auditRepository, domain.EntityStayRule, domain.ActionDeactivate, ruleID, before, &after)
+	})
+}
+
+func (s *StayRuleService) getStayRule(ctx context.Context, hotelID, ruleID string) (*domain.StayRule, error) {
+	rule, err := s.stayRuleRepository.FindByID(ctx, ruleID)
+	if err != nil {
+		return nil, err
+	}
+
+	if rule.HotelID != hotelID {
+		slog.Error("[SERVICE]", "message", fmt.Sprintf("hotelID does not match with stay rule, rule.HotelID:%s, hotelID:%s", rule.HotelID, hotelID))
+		return nil, fmt.Errorf("hotelID does not match with stay rule")
+	}
+
+	return rule, nil
+}
+
+// checkScope makes sure the hotel exists and a rule narrowed to one room targets a room of it
+func (s *StayRuleService) checkScope(ctx context.Context, rule *domain.StayRule) error {
+	if _, err := s.hotelRepository.FindByID(ctx, rule.HotelID); err != nil {
+		return err
+	}
+
+	if rule.RoomID == "" {
+		return nil
+	}
+
+	room, err := s.roomRepository.FindByRoomID(ctx, rule.RoomID)
+	if err != nil {
+		return err
+	}
+
+	if room.HotelID != rule.HotelID {
+		slog.Error("[SERVICE]", "message", fmt.Sprintf("hotelID does not match with room, room.HotelID:%s, hotelID:%s", room.HotelID, rule.HotelID))
+		return fmt.Errorf("hotelID does not match with room")
+	}
+
+	return nil
+}
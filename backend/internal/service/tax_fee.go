@@ -0,0 +1,150 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+
+	"github.com/chayutK/hotel-property-service/internal/domain"
+	"github.com/chayutK/hotel-property-service/internal/port"
+	"github.com/google/uuid"
+)
+
+type TaxFeeService struct {
+	hotelRepository  port.HotelPort
+	taxFeeRepository port.TaxFeePort
+	auditRepository  port.AuditPort
+	transactor       port.TransactionPort
+	roomRepository   port.RoomPort
+}
+
+func NewTaxFeeService(hotelRepository port.HotelPort, taxFeeRepository port.TaxFeePort, auditRepository port.AuditPort, transactor port.TransactionPort, roomRepository port.RoomPort) *TaxFeeService {
+	return &TaxFeeService{
+		hotelRepository:  hotelRepository,
+		taxFeeRepository: taxFeeRepository,
+		auditRepository:  auditRepository,
+		transactor:       transactor,
+		roomRepository:   roomRepository,
+	}
+}
+
+func (s *TaxFeeService) GetTaxesAndFees(ctx context.Context, hotelID string) ([]domain.TaxFee, error) {
+	if _, err := s.hotelRepository.FindByID(ctx, hotelID); err != nil {
+		return nil, err
+	}
+
+	return s.taxFeeRepository.FindByHotelID(ctx, hotelID)
+}
+
+func (s *TaxFeeService) CreateTaxFee(ctx context.Context, taxFee *domain.TaxFee) (*domain.TaxFee, error) {
+	if _, err := s.hotelRepository.FindByID(ctx, taxFee.HotelID); err != nil {
+		return nil, err
+	}
+
+	if err := s.checkCurrency(ctx, taxFee); err != nil {
+		return nil, err
+	}
+
+	taxFee.ID = uuid.NewString()
+	taxFee.IsActive = true
+
+	var created *domain.TaxFee
+	err := s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := s.taxFeeRepository.Create(ctx, taxFee); err != nil {
+			return err
+		}
+
+		var err error
+		if created, err = s.taxFeeRepository.FindByID(ctx, taxFee.ID); err != nil {
+			return err
+		}
+
+		return recordChange(ctx, s.auditRepository, domain.EntityTaxFee, domain.ActionCreate, created.ID, &domain.TaxFee{}, created)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+func (s *TaxFeeService) UpdateTaxFee(ctx context.Context, taxFee *domain.TaxFee) (*domain.TaxFee, error) {
+	var updated *domain.TaxFee
+	err := s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		before, err := s.getTaxFee(ctx, taxFee.HotelID, taxFee.ID)
+		if err != nil {
+			return err
+		}
+
+		if err := s.checkCurrency(ctx, taxFee); err != nil {
+			return err
+		}
+
+		if err := s.taxFeeRepository.Update(ctx, taxFee); err != nil {
+			return err
+		}
+
+		if updated, err = s.taxFeeRepository.FindByID(ctx, taxFee.ID); err != nil {
+			return err
+		}
+
+		return recordChange(ctx, s.auditRepository, domain.EntityTaxFee, domain.ActionUpdate, updated.ID, before, updated)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+func (s *TaxFeeService) DeactivateTaxFee(ctx context.Context, hotelID, taxFeeID string) error {
+	return s.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		before, err := s.getTaxFee(ctx, hotelID, taxFeeID)
+		if err != nil {
+			return err
+		}
+
+		if err := s.taxFeeRepository.Deactivate(ctx, taxFeeID); err != nil {
+			return err
+		}
+
+		after := *before
+		after.IsActive = false
+		return recordChange(ctx, s.auditRepository, domain.EntityTaxFee, domain.ActionDeactivate, taxFeeID, before, &after)
+	})
+}
+
+func (s *TaxFeeService) getTaxFee(ctx context.Context, hotelID, taxFeeID string) (*domain.TaxFee, error) {
+	taxFee, err := s.taxFeeRepository.FindByID(ctx, taxFeeID)
+	if err != nil {
+		return nil, err
+	}
+
+	if taxFee.HotelID != hotelID {
+		slog.Error("[SERVICE]", "message", fmt.Sprintf("hotelID does not match with tax or fee, taxFee.HotelID:%s, hotelID:%s", taxFee.HotelID, hotelID))
+		return nil, fmt.Errorf("hotelID does not match with tax or fee")
+	}
+
+	return taxFee, nil
+}
+
+// checkCurrency makes sure a fixed tax or fee is charged in a currency a room of the hotel is
+// sold in, quotes of rooms in another currency are refused with domain.ErrCurrencyMismatch
+func (s *TaxFeeService) checkCurrency(ctx context.Context, taxFee *domain.TaxFee) error {
+	if taxFee.Calculation != domain.CalculationFixed {
+		return nil
+	}
+
+	currencies, err := s.roomRepository.FindCurrenciesByHotelID(ctx, taxFee.HotelID)
+	if err != nil {
+		return err
+	}
+
+	if !slices.Contains(currencies, taxFee.Amount.Currency) {
+		slog.Error("[SERVICE]", "message", fmt.Sprintf("no room of the hotel is sold in the currency of the tax or fee, hotelID:%s, currency:%s", taxFee.HotelID, taxFee.Amount.Currency))
+		return fmt.Errorf("no room of the hotel is sold in %s: %w", taxFee.Amount.Currency, domain.ErrCurrencyMismatch)
+	}
+
+	return nil
+}
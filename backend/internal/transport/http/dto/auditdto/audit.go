@@ -0,0 +1,17 @@
+package auditdto
+
+import (
+	"time"
+
+	"github.com/chayutK/hotel-property-service/internal/transport/http/dto/catalogdto"
+)
+
+type AuditEntryDTO struct {
+	AuditID string                      `json:"auditID"`
+	Entity  string                      `json:"entity"`
+	ID      string                      `json:"id"`
+	Action  string                      `json:"action"`
+	Actor   string                      `json:"actor"`
+	At      time.Time                   `json:"at"`
+	Fields  []catalogdto.FieldChangeDTO `json:"fields"`
+}
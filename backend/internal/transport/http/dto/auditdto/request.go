@@ -0,0 +1,9 @@
+package auditdto
+
+type InquiryAuditRequest struct {
+	Entity string `query:"entity" validate:"omitempty,oneof=facility_catalog hotel facility physical_room room benefit room_rate pricing_rule cancellation_policy tax_fee exchange_rate promotion stay_rule rate_plan"`
+	ID     string `query:"id" validate:"max=64"`
+	Actor  string `query:"actor" validate:"max=255"`
+	Field  string `query:"field" validate:"omitempty,alphanum,max=64"`
+	Limit  int    `query:"limit" validate:"omitempty,min=1,max=1000"`
+}
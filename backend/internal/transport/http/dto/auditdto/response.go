@@ -0,0 +1,5 @@
+package auditdto
+
+type InquiryAuditResponse struct {
+	Entries []AuditEntryDTO `json:"entries"`
+}
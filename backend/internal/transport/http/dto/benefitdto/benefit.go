@@ -0,0 +1,13 @@
+package benefitdto
+
+import "time"
+
+type BenefitDTO struct {
+	BenefitID      string     `json:"benefitID"`
+	PhysicalRoomID string     `json:"physicalRoomID"`
+	Name           string     `json:"name"`
+	Description    string     `json:"description"`
+	ActiveFrom     *time.Time `json:"activeFrom,omitempty"`
+	ActiveUntil    *time.Time `json:"activeUntil,omitempty"`
+	RoomIDs        []string   `json:"roomIDs"`
+}
@@ -0,0 +1,33 @@
+package benefitdto
+
+import "time"
+
+type InquiryBenefitsRequest struct {
+	HotelID        string `param:"hotelID" validate:"required,uuid4"`
+	PhysicalRoomID string `param:"physicalRoomID" validate:"required,uuid4"`
+}
+
+type CreateBenefitRequest struct {
+	HotelID        string     `param:"hotelID" json:"-" validate:"required,uuid4"`
+	PhysicalRoomID string     `param:"physicalRoomID" json:"-" validate:"required,uuid4"`
+	Name           string     `json:"name" validate:"required,max=255"`
+	Description    string     `json:"description" validate:"max=1000"`
+	ActiveFrom     *time.Time `json:"activeFrom"`
+	ActiveUntil    *time.Time `json:"activeUntil" validate:"omitempty,active_until"`
+}
+
+type UpdateBenefitRequest struct {
+	HotelID        string     `param:"hotelID" json:"-" validate:"required,uuid4"`
+	PhysicalRoomID string     `param:"physicalRoomID" json:"-" validate:"required,uuid4"`
+	BenefitID      string     `param:"benefitID" json:"-" validate:"required,uuid4"`
+	Name           string     `json:"name" validate:"required,max=255"`
+	Description    string     `json:"description" validate:"max=1000"`
+	ActiveFrom     *time.Time `json:"activeFrom"`
+	ActiveUntil    *time.Time `json:"activeUntil" validate:"omitempty,active_until"`
+}
+
+type RetireBenefitRequest struct {
+	HotelID        string `param:"hotelID" validate:"required,uuid4"`
+	PhysicalRoomID string `param:"physicalRoomID" validate:"required,uuid4"`
+	BenefitID      string `param:"benefitID" validate:"required,uuid4"`
+}
@@ -0,0 +1,9 @@
+package benefitdto
+
+type InquiryBenefitsResponse struct {
+	Benefits []BenefitDTO `json:"benefits"`
+}
+
+type BenefitResponse struct {
+	Benefit BenefitDTO `json:"benefit"`
+}
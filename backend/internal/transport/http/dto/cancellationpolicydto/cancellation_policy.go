@@ -0,0 +1,15 @@
+package cancellationpolicydto
+
+type CancellationPolicyDTO struct {
+	PolicyID              string           `json:"policyID"`
+	Name                  string           `json:"name"`
+	Description           string           `json:"description"`
+	FreeCancellationHours *int             `json:"freeCancellationHours"`
+	PenaltyTiers          []PenaltyTierDTO `json:"penaltyTiers"`
+	SurchargePercent      float64          `json:"surchargePercent"`
+}
+
+type PenaltyTierDTO struct {
+	HoursBeforeArrival int     `json:"hoursBeforeArrival" validate:"min=0,max=8760"`
+	PenaltyPercent     float64 `json:"penaltyPercent" validate:"gte=0,lte=100"`
+}
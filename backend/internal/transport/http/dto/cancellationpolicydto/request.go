@@ -0,0 +1,28 @@
+package cancellationpolicydto
+
+type InquiryCancellationPolicyRequest struct {
+	PolicyID string `param:"policyID" validate:"required,max=64"`
+}
+
+// CreateCancellationPolicyRequest leaves FreeCancellationHours out for a policy that never
+// cancels for free
+type CreateCancellationPolicyRequest struct {
+	Name                  string           `json:"name" validate:"required,max=255"`
+	Description           string           `json:"description" validate:"max=1000"`
+	FreeCancellationHours *int             `json:"freeCancellationHours" validate:"omitempty,min=0,max=8760"`
+	PenaltyTiers          []PenaltyTierDTO `json:"penaltyTiers" validate:"max=10,unique=HoursBeforeArrival,dive"`
+	SurchargePercent      float64          `json:"surchargePercent" validate:"gte=0,lte=100"`
+}
+
+type UpdateCancellationPolicyRequest struct {
+	PolicyID              string           `param:"policyID" json:"-" validate:"required,max=64"`
+	Name                  string           `json:"name" validate:"required,max=255"`
+	Description           string           `json:"description" validate:"max=1000"`
+	FreeCancellationHours *int             `json:"freeCancellationHours" validate:"omitempty,min=0,max=8760"`
+	PenaltyTiers          []PenaltyTierDTO `json:"penaltyTiers" validate:"max=10,unique=HoursBeforeArrival,dive"`
+	SurchargePercent      float64          `json:"surchargePercent" validate:"gte=0,lte=100"`
+}
+
+type DeactivateCancellationPolicyRequest struct {
+	PolicyID string `param:"policyID" validate:"required,max=64"`
+}
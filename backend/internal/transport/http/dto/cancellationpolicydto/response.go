@@ -0,0 +1,9 @@
+package cancellationpolicydto
+
+type InquiryCancellationPoliciesResponse struct {
+	Policies []CancellationPolicyDTO `json:"policies"`
+}
+
+type CancellationPolicyResponse struct {
+	Policy CancellationPolicyDTO `json:"policy"`
+}
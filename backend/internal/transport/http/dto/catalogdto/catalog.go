@@ -0,0 +1,130 @@
+package catalogdto
+
+import "time"
+
+// SnapshotVersion is bumped whenever the snapshot layout changes. Version 2 added activation
+// windows, version 3 cancellation policies and version 4 occupancy, older snapshots simply have
+// none, their offers use the built-in policies and the occupancy of their room type.
+const SnapshotVersion = 4
+
+// IsSupportedVersion reports whether a snapshot of the given version can be restored
+func IsSupportedVersion(version int) bool {
+	return version >= 1 && version <= SnapshotVersion
+}
+
+// CatalogSnapshot is a full export of the catalog, inactive rows included, that can be
+// restored into an empty or existing database.
+type CatalogSnapshot struct {
+	Version    int       `json:"version" validate:"required"`
+	ExportedAt time.Time `json:"exportedAt"`
+	CatalogDocument
+}
+
+// CatalogDocument describes hotels together with everything below them. Each listed hotel is
+// complete: facilities, physical rooms, offers and benefits missing from it are deactivated.
+type CatalogDocument struct {
+	FacilityCatalog      []CatalogEntryDTO       `json:"facilityCatalog" validate:"dive"`
+	CancellationPolicies []CancellationPolicyDTO `json:"cancellationPolicies,omitempty" validate:"dive"`
+	Hotels               []HotelDTO              `json:"hotels" validate:"dive"`
+}
+
+type CatalogEntryDTO struct {
+	Code        string `json:"code" validate:"required,facility_code"`
+	Name        string `json:"name" validate:"required,max=255"`
+	Description string `json:"description" validate:"max=1000"`
+	IsActive    *bool  `json:"isActive,omitempty"`
+}
+
+type CancellationPolicyDTO struct {
+	PolicyID              string           `json:"policyID" validate:"required,max=64"`
+	Name                  string           `json:"name" validate:"required,max=255"`
+	Description           string           `json:"description" validate:"max=1000"`
+	FreeCancellationHours *int             `json:"freeCancellationHours" validate:"omitempty,min=0,max=8760"`
+	PenaltyTiers          []PenaltyTierDTO `json:"penaltyTiers,omitempty" validate:"max=10,unique=HoursBeforeArrival,dive"`
+	SurchargePercent      float64          `json:"surchargePercent" validate:"gte=0,lte=100"`
+	IsActive              *bool            `json:"isActive,omitempty"`
+}
+
+type PenaltyTierDTO struct {
+	HoursBeforeArrival int     `json:"hoursBeforeArrival" validate:"min=0,max=8760"`
+	PenaltyPercent     float64 `json:"penaltyPercent" validate:"gte=0,lte=100"`
+}
+
+type HotelDTO struct {
+	HotelID       string            `json:"hotelID" validate:"required,uuid4"`
+	Name          string            `json:"name" validate:"required,max=255"`
+	Address       string            `json:"address" validate:"required,max=500"`
+	IsActive      *bool             `json:"isActive,omitempty"`
+	ActiveFrom    *time.Time        `json:"activeFrom,omitempty"`
+	ActiveUntil   *time.Time        `json:"activeUntil,omitempty" validate:"omitempty,active_until"`
+	Facilities    []FacilityDTO     `json:"facilities" validate:"dive"`
+	PhysicalRooms []PhysicalRoomDTO `json:"physicalRooms" validate:"dive"`
+}
+
+type FacilityDTO struct {
+	FacilityID  string     `json:"facilityID,omitempty" validate:"omitempty,uuid4"`
+	Code        string     `json:"code" validate:"required,facility_code"`
+	Description string     `json:"description" validate:"max=1000"`
+	IsActive    *bool      `json:"isActive,omitempty"`
+	ActiveFrom  *time.Time `json:"activeFrom,omitempty"`
+	ActiveUntil *time.Time `json:"activeUntil,omitempty" validate:"omitempty,active_until"`
+}
+
+type PhysicalRoomDTO struct {
+	PhysicalRoomID string       `json:"physicalRoomID" validate:"required,uuid4"`
+	Name           string       `json:"name" validate:"required,max=255"`
+	Description    string       `json:"description" validate:"max=1000"`
+	Type           string       `json:"type" validate:"required,room_type"`
+	SizeSqm        int          `json:"sizeSqm" validate:"min=0"`
+	BedType        string       `json:"bedType" validate:"max=64"`
+	BedCount       int          `json:"bedCount" validate:"required,min=1"`
+	UnitCount      int          `json:"unitCount" validate:"required,min=1"`
+	IsActive       *bool        `json:"isActive,omitempty"`
+	Benefits       []BenefitDTO `json:"benefits" validate:"dive"`
+	Offers         []OfferDTO   `json:"offers" validate:"dive"`
+}
+
+type BenefitDTO struct {
+	BenefitID   string     `json:"benefitID" validate:"required,uuid4"`
+	Name        string     `json:"name" validate:"required,max=255"`
+	Description string     `json:"description" validate:"max=1000"`
+	IsActive    *bool      `json:"isActive,omitempty"`
+	ActiveFrom  *time.Time `json:"activeFrom,omitempty"`
+	ActiveUntil *time.Time `json:"activeUntil,omitempty" validate:"omitempty,active_until"`
+}
+
+type OfferDTO struct {
+	RoomID             string     `json:"roomID" validate:"required,uuid4"`
+	Name               string     `json:"name" validate:"required,max=255"`
+	Description        string     `json:"description" validate:"max=1000"`
+	Type               string     `json:"type" validate:"required,room_type"`
+	BasePrice          float64    `json:"basePrice" validate:"required,gt=0,money=Currency"`
+	Currency           string     `json:"currency" validate:"required,currency"`
+	CancellationPolicy string     `json:"cancellationPolicy" validate:"required,max=64"`
+	IsActive           *bool      `json:"isActive,omitempty"`
+	ActiveFrom         *time.Time `json:"activeFrom,omitempty"`
+	ActiveUntil        *time.Time `json:"activeUntil,omitempty" validate:"omitempty,active_until"`
+	// the occupancy defaults to that of the room type when left out
+	BaseOccupancy    int              `json:"baseOccupancy,omitempty" validate:"omitempty,min=1,max=20"`
+	MaxOccupancy     int              `json:"maxOccupancy,omitempty" validate:"omitempty,min=1,max=20,gtefield=BaseOccupancy"`
+	ExtraAdultCharge float64          `json:"extraAdultCharge,omitempty" validate:"gte=0,money=Currency"`
+	ChildCharges     []ChildChargeDTO `json:"childCharges,omitempty" validate:"max=5,unique=MaxAge,money=Currency Amount,dive"`
+}
+
+type ChildChargeDTO struct {
+	MaxAge int     `json:"maxAge" validate:"min=0,max=17"`
+	Amount float64 `json:"amount" validate:"gte=0"`
+}
+
+type CatalogChangeDTO struct {
+	Entity string           `json:"entity"`
+	ID     string           `json:"id"`
+	Action string           `json:"action"`
+	Fields []FieldChangeDTO `json:"fields,omitempty"`
+}
+
+type FieldChangeDTO struct {
+	Field  string `json:"field"`
+	Before any    `json:"before"`
+	After  any    `json:"after"`
+}
@@ -0,0 +1,253 @@
+package catalogdto
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ParseCSV reads a catalog CSV into the same document a JSON import uses.
+//
+// Catalog CSVs have one row per entity. The "entity" column says what a row describes and
+// children point at their parent, which must also be listed in the file:
+//
+//	facility_catalog: code, name, description
+//	hotel:            id, name, address
+//	facility:         hotel_id, code, description
+//	physical_room:    id, hotel_id, name, description, type, size_sqm, bed_type, bed_count, unit_count
+//	room:             id, physical_room_id, name, description, type, base_price, currency, cancellation_policy,
+//	                  base_occupancy, max_occupancy, extra_adult_charge
+//	benefit:          id, physical_room_id, name, description
+//
+// The cancellation_policy of a room is the ID of a stored policy, policies themselves are only
+// imported from JSON, and so are child charges. Empty occupancy columns use the occupancy of the
+// room type. Every row may set is_active, an empty value means active. Hotel, facility, room and benefit
+// rows may also set active_from and active_until as RFC 3339 timestamps.
+func ParseCSV(r io.Reader) (*CatalogDocument, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("read csv header: %w", err)
+	}
+
+	index := make(map[string]int, len(header))
+	for i, column := range header {
+		index[strings.ToLower(strings.TrimSpace(column))] = i
+	}
+	if _, ok := index["entity"]; !ok {
+		return nil, fmt.Errorf("csv header has no entity column")
+	}
+
+	var (
+		doc           CatalogDocument
+		hotels        = map[string]int{}
+		physicalRooms = map[string][2]int{}
+	)
+
+	for line := 2; ; line++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("read csv line %d: %w", line, err)
+		}
+
+		row := csvRow{record: record, index: index, line: line}
+		isActive, err := row.boolean("is_active")
+		if err != nil {
+			return nil, err
+		}
+		activeFrom, err := row.timestamp("active_from")
+		if err != nil {
+			return nil, err
+		}
+		activeUntil, err := row.timestamp("active_until")
+		if err != nil {
+			return nil, err
+		}
+
+		switch entity := row.get("entity"); entity {
+		case "facility_catalog":
+			doc.FacilityCatalog = append(doc.FacilityCatalog, CatalogEntryDTO{
+				Code:        row.get("code"),
+				Name:        row.get("name"),
+				Description: row.get("description"),
+				IsActive:    isActive,
+			})
+		case "hotel":
+			hotels[row.get("id")] = len(doc.Hotels)
+			doc.Hotels = append(doc.Hotels, HotelDTO{
+				HotelID:     row.get("id"),
+				Name:        row.get("name"),
+				Address:     row.get("address"),
+				IsActive:    isActive,
+				ActiveFrom:  activeFrom,
+				ActiveUntil: activeUntil,
+			})
+		case "facility":
+			h, ok := hotels[row.get("hotel_id")]
+			if !ok {
+				return nil, fmt.Errorf("csv line %d: hotel %q must be listed before its facilities", line, row.get("hotel_id"))
+			}
+			doc.Hotels[h].Facilities = append(doc.Hotels[h].Facilities, FacilityDTO{
+				Code:        row.get("code"),
+				Description: row.get("description"),
+				IsActive:    isActive,
+				ActiveFrom:  activeFrom,
+				ActiveUntil: activeUntil,
+			})
+		case "physical_room":
+			h, ok := hotels[row.get("hotel_id")]
+			if !ok {
+				return nil, fmt.Errorf("csv line %d: hotel %q must be listed before its physical rooms", line, row.get("hotel_id"))
+			}
+			sizeSqm, err := row.integer("size_sqm")
+			if err != nil {
+				return nil, err
+			}
+			bedCount, err := row.integer("bed_count")
+			if err != nil {
+				return nil, err
+			}
+			unitCount, err := row.integer("unit_count")
+			if err != nil {
+				return nil, err
+			}
+			physicalRooms[row.get("id")] = [2]int{h, len(doc.Hotels[h].PhysicalRooms)}
+			doc.Hotels[h].PhysicalRooms = append(doc.Hotels[h].PhysicalRooms, PhysicalRoomDTO{
+				PhysicalRoomID: row.get("id"),
+				Name:           row.get("name"),
+				Description:    row.get("description"),
+				Type:           row.get("type"),
+				SizeSqm:        sizeSqm,
+				BedType:        row.get("bed_type"),
+				BedCount:       bedCount,
+				UnitCount:      unitCount,
+				IsActive:       isActive,
+			})
+		case "room":
+			p, ok := physicalRooms[row.get("physical_room_id")]
+			if !ok {
+				return nil, fmt.Errorf("csv line %d: physical room %q must be listed before its rooms", line, row.get("physical_room_id"))
+			}
+			basePrice, err := row.float("base_price")
+			if err != nil {
+				return nil, err
+			}
+			baseOccupancy, err := row.integer("base_occupancy")
+			if err != nil {
+				return nil, err
+			}
+			maxOccupancy, err := row.integer("max_occupancy")
+			if err != nil {
+				return nil, err
+			}
+			extraAdultCharge, err := row.float("extra_adult_charge")
+			if err != nil {
+				return nil, err
+			}
+			physicalRoom := &doc.Hotels[p[0]].PhysicalRooms[p[1]]
+			physicalRoom.Offers = append(physicalRoom.Offers, OfferDTO{
+				RoomID:             row.get("id"),
+				Name:               row.get("name"),
+				Description:        row.get("description"),
+				Type:               row.get("type"),
+				BasePrice:          basePrice,
+				Currency:           row.get("currency"),
+				CancellationPolicy: row.get("cancellation_policy"),
+				IsActive:           isActive,
+				ActiveFrom:         activeFrom,
+				ActiveUntil:        activeUntil,
+				BaseOccupancy:      baseOccupancy,
+				MaxOccupancy:       maxOccupancy,
+				ExtraAdultCharge:   extraAdultCharge,
+			})
+		case "benefit":
+			p, ok := physicalRooms[row.get("physical_room_id")]
+			if !ok {
+				return nil, fmt.Errorf("csv line %d: physical room %q must be listed before its benefits", line, row.get("physical_room_id"))
+			}
+			physicalRoom := &doc.Hotels[p[0]].PhysicalRooms[p[1]]
+			physicalRoom.Benefits = append(physicalRoom.Benefits, BenefitDTO{
+				BenefitID:   row.get("id"),
+				Name:        row.get("name"),
+				Description: row.get("description"),
+				IsActive:    isActive,
+				ActiveFrom:  activeFrom,
+				ActiveUntil: activeUntil,
+			})
+		default:
+			return nil, fmt.Errorf("csv line %d: unknown entity %q", line, entity)
+		}
+	}
+
+	return &doc, nil
+}
+
+type csvRow struct {
+	record []string
+	index  map[string]int
+	line   int
+}
+
+func (r csvRow) get(column string) string {
+	i, ok := r.index[column]
+	if !ok || i >= len(r.record) {
+		return ""
+	}
+	return strings.TrimSpace(r.record[i])
+}
+
+func (r csvRow) integer(column string) (int, error) {
+	value := r.get(column)
+	if value == "" {
+		return 0, nil
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("csv line %d: %s must be a whole number", r.line, column)
+	}
+	return n, nil
+}
+
+func (r csvRow) float(column string) (float64, error) {
+	value := r.get(column)
+	if value == "" {
+		return 0, nil
+	}
+	n, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return 0, fmt.Errorf("csv line %d: %s must be a number", r.line, column)
+	}
+	return n, nil
+}
+
+func (r csvRow) boolean(column string) (*bool, error) {
+	value := r.get(column)
+	if value == "" {
+		return nil, nil
+	}
+	b, err := strconv.ParseBool(value)
+	if err != nil {
+		return nil, fmt.Errorf("csv line %d: %s must be true or false", r.line, column)
+	}
+	return &b, nil
+}
+
+func (r csvRow) timestamp(column string) (*time.Time, error) {
+	value := r.get(column)
+	if value == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, fmt.Errorf("csv line %d: %s must be an RFC 3339 timestamp", r.line, column)
+	}
+	return &t, nil
+}
@@ -0,0 +1,11 @@
+package catalogdto
+
+type ImportCatalogRequest struct {
+	DryRun   bool `query:"dry_run"`
+	Document CatalogDocument
+}
+
+type RestoreSnapshotRequest struct {
+	DryRun   bool `query:"dry_run"`
+	Snapshot CatalogSnapshot
+}
@@ -0,0 +1,7 @@
+package catalogdto
+
+type ImportCatalogResponse struct {
+	DryRun  bool                      `json:"dryRun"`
+	Summary map[string]map[string]int `json:"summary"`
+	Changes []CatalogChangeDTO        `json:"changes"`
+}
@@ -0,0 +1,65 @@
+package exchangeratedto
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// ParseCSV reads exchange rates with the columns from, to, rate and effective_date, e.g.
+//
+//	from,to,rate,effective_date
+//	THB,USD,0.0285,2026-10-01
+func ParseCSV(r io.Reader) (*SetExchangeRatesRequest, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("read csv header: %w", err)
+	}
+
+	index := make(map[string]int, len(header))
+	for i, column := range header {
+		index[strings.ToLower(strings.TrimSpace(column))] = i
+	}
+	for _, column := range []string{"from", "to", "rate", "effective_date"} {
+		if _, ok := index[column]; !ok {
+			return nil, fmt.Errorf("csv header has no %s column", column)
+		}
+	}
+
+	var req SetExchangeRatesRequest
+	for line := 2; ; line++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("read csv line %d: %w", line, err)
+		}
+
+		get := func(column string) string {
+			if i := index[column]; i < len(record) {
+				return strings.TrimSpace(record[i])
+			}
+			return ""
+		}
+
+		rate, err := strconv.ParseFloat(get("rate"), 64)
+		if err != nil {
+			return nil, fmt.Errorf("csv line %d: rate must be a number", line)
+		}
+
+		req.Rates = append(req.Rates, ExchangeRateRequest{
+			From:          strings.ToUpper(get("from")),
+			To:            strings.ToUpper(get("to")),
+			EffectiveDate: get("effective_date"),
+			Rate:          rate,
+		})
+	}
+
+	return &req, nil
+}
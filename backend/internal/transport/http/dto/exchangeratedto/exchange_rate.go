@@ -0,0 +1,8 @@
+package exchangeratedto
+
+type ExchangeRateDTO struct {
+	From          string  `json:"from"`
+	To            string  `json:"to"`
+	EffectiveDate string  `json:"effectiveDate"`
+	Rate          float64 `json:"rate"`
+}
@@ -0,0 +1,24 @@
+package exchangeratedto
+
+type InquiryExchangeRatesRequest struct {
+	From string `query:"from" validate:"omitempty,currency"`
+	To   string `query:"to" validate:"omitempty,currency"`
+}
+
+type SetExchangeRatesRequest struct {
+	Rates []ExchangeRateRequest `json:"rates" validate:"required,min=1,max=1000,dive"`
+}
+
+// ExchangeRateRequest sets what one unit of From buys in To from EffectiveDate on
+type ExchangeRateRequest struct {
+	From          string  `json:"from" validate:"required,currency"`
+	To            string  `json:"to" validate:"required,currency,nefield=From"`
+	EffectiveDate string  `json:"effectiveDate" validate:"required,datetime=2006-01-02"`
+	Rate          float64 `json:"rate" validate:"required,gt=0"`
+}
+
+type DeleteExchangeRateRequest struct {
+	From string `param:"from" validate:"required,currency"`
+	To   string `param:"to" validate:"required,currency"`
+	Date string `param:"date" validate:"required,datetime=2006-01-02"`
+}
@@ -0,0 +1,5 @@
+package exchangeratedto
+
+type ExchangeRatesResponse struct {
+	Rates []ExchangeRateDTO `json:"rates"`
+}
@@ -0,0 +1,7 @@
+package facilitydto
+
+type CatalogEntryDTO struct {
+	Code        string `json:"code"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
@@ -0,0 +1,36 @@
+package facilitydto
+
+import "time"
+
+type CreateCatalogEntryRequest struct {
+	Code        string `json:"code" validate:"required,facility_code"`
+	Name        string `json:"name" validate:"required,max=255"`
+	Description string `json:"description" validate:"max=1000"`
+}
+
+type UpdateCatalogEntryRequest struct {
+	Code        string `param:"code" json:"-" validate:"required,facility_code"`
+	Name        string `json:"name" validate:"required,max=255"`
+	Description string `json:"description" validate:"max=1000"`
+}
+
+type AttachFacilityRequest struct {
+	HotelID     string     `param:"hotel_id" json:"-" validate:"required,uuid4"`
+	Code        string     `json:"code" validate:"required,facility_code"`
+	Description string     `json:"description" validate:"max=1000"`
+	ActiveFrom  *time.Time `json:"active_from"`
+	ActiveUntil *time.Time `json:"active_until" validate:"omitempty,active_until"`
+}
+
+type DescribeFacilityRequest struct {
+	HotelID     string     `param:"hotel_id" json:"-" validate:"required,uuid4"`
+	Code        string     `param:"code" json:"-" validate:"required,facility_code"`
+	Description string     `json:"description" validate:"max=1000"`
+	ActiveFrom  *time.Time `json:"active_from"`
+	ActiveUntil *time.Time `json:"active_until" validate:"omitempty,active_until"`
+}
+
+type DetachFacilityRequest struct {
+	HotelID string `param:"hotel_id" validate:"required,uuid4"`
+	Code    string `param:"code" validate:"required,facility_code"`
+}
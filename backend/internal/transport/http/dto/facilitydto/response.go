@@ -0,0 +1,9 @@
+package facilitydto
+
+type InquiryCatalogResponse struct {
+	Facilities []CatalogEntryDTO `json:"facilities"`
+}
+
+type CatalogEntryResponse struct {
+	Facility CatalogEntryDTO `json:"facility"`
+}
@@ -1,14 +1,21 @@
 package hoteldto
 
+import "time"
+
 type HotelDTO struct {
-	HotelID  string        `json:"hotel_id"`
-	Name     string        `json:"name"`
-	Address  string        `json:"address"`
-	Facility []FacilityDTO `json:"facility"`
+	HotelID     string        `json:"hotel_id"`
+	Name        string        `json:"name"`
+	Address     string        `json:"address"`
+	ActiveFrom  *time.Time    `json:"active_from,omitempty"`
+	ActiveUntil *time.Time    `json:"active_until,omitempty"`
+	Facility    []FacilityDTO `json:"facility"`
 }
 
 type FacilityDTO struct {
-	FacilityID  string `json:"facility_id"`
-	Name        string `json:"name"`
-	Description string `json:"description"`
+	FacilityID  string     `json:"facility_id"`
+	Code        string     `json:"code"`
+	Name        string     `json:"name"`
+	Description string     `json:"description"`
+	ActiveFrom  *time.Time `json:"active_from,omitempty"`
+	ActiveUntil *time.Time `json:"active_until,omitempty"`
 }
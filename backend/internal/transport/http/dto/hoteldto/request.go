@@ -1,5 +1,39 @@
 package hoteldto
 
+import "time"
+
+type InquiryHotelsRequest struct {
+	Facility []string `query:"facility" validate:"dive,facility_code"`
+}
+
 type InquiryHotelRequest struct {
 	HotelID string `param:"hotel_id" validate:"required,uuid4"`
 }
+
+type CreateHotelRequest struct {
+	Name        string     `json:"name" validate:"required,max=255"`
+	Address     string     `json:"address" validate:"required,max=500"`
+	ActiveFrom  *time.Time `json:"active_from"`
+	ActiveUntil *time.Time `json:"active_until" validate:"omitempty,active_until"`
+}
+
+type UpdateHotelRequest struct {
+	HotelID     string     `param:"hotel_id" json:"-" validate:"required,uuid4"`
+	Name        string     `json:"name" validate:"required,max=255"`
+	Address     string     `json:"address" validate:"required,max=500"`
+	ActiveFrom  *time.Time `json:"active_from"`
+	ActiveUntil *time.Time `json:"active_until" validate:"omitempty,active_until"`
+}
+
+// PatchHotelRequest can set an activation window but not clear it, merge patches clear with null
+type PatchHotelRequest struct {
+	HotelID     string     `param:"hotel_id" json:"-" validate:"required,uuid4"`
+	Name        *string    `json:"name" validate:"omitempty,min=1,max=255"`
+	Address     *string    `json:"address" validate:"omitempty,min=1,max=500"`
+	ActiveFrom  *time.Time `json:"active_from"`
+	ActiveUntil *time.Time `json:"active_until"`
+}
+
+type DeactivateHotelRequest struct {
+	HotelID string `param:"hotel_id" validate:"required,uuid4"`
+}
@@ -7,3 +7,11 @@ type InquiryHotelResponse struct {
 type InquiryHotelsResponse struct {
 	Hotels []HotelDTO `json:"hotels"`
 }
+
+type CreateHotelResponse struct {
+	Hotel HotelDTO `json:"hotel"`
+}
+
+type UpdateHotelResponse struct {
+	Hotel HotelDTO `json:"hotel"`
+}
@@ -0,0 +1,42 @@
+package mapperdto
+
+import (
+	"github.com/chayutK/hotel-property-service/internal/domain"
+	"github.com/chayutK/hotel-property-service/internal/transport/http/dto/auditdto"
+	"github.com/chayutK/hotel-property-service/internal/transport/http/dto/catalogdto"
+)
+
+func InquiryAuditRequestToDomain(req *auditdto.InquiryAuditRequest) domain.AuditFilter {
+	return domain.AuditFilter{
+		Entity:   req.Entity,
+		EntityID: req.ID,
+		Actor:    req.Actor,
+		Field:    req.Field,
+		Limit:    req.Limit,
+	}
+}
+
+func ToAuditEntriesDTO(entries []domain.AuditEntry) []auditdto.AuditEntryDTO {
+	entryDTOs := make([]auditdto.AuditEntryDTO, len(entries))
+	for i, entry := range entries {
+		fields := make([]catalogdto.FieldChangeDTO, len(entry.Fields))
+		for j, field := range entry.Fields {
+			fields[j] = catalogdto.FieldChangeDTO{
+				Field:  field.Field,
+				Before: field.Before,
+				After:  field.After,
+			}
+		}
+
+		entryDTOs[i] = auditdto.AuditEntryDTO{
+			AuditID: entry.ID,
+			Entity:  entry.Entity,
+			ID:      entry.EntityID,
+			Action:  entry.Action,
+			Actor:   entry.Actor,
+			At:      entry.At,
+			Fields:  fields,
+		}
+	}
+	return entryDTOs
+}
@@ -0,0 +1,30 @@
+package mapperdto
+
+import (
+	"github.com/chayutK/hotel-property-service/internal/domain"
+	"github.com/chayutK/hotel-property-service/internal/transport/http/dto/benefitdto"
+)
+
+func ToPhysicalRoomBenefitsDTO(benefits []domain.Benefit, roomIDs []string) []benefitdto.BenefitDTO {
+	benefitDTOs := make([]benefitdto.BenefitDTO, len(benefits))
+	for i, benefit := range benefits {
+		benefitDTOs[i] = *ToPhysicalRoomBenefitDTO(&benefit, roomIDs)
+	}
+	return benefitDTOs
+}
+
+func ToPhysicalRoomBenefitDTO(benefit *domain.Benefit, roomIDs []string) *benefitdto.BenefitDTO {
+	if benefit == nil {
+		return nil
+	}
+
+	return &benefitdto.BenefitDTO{
+		BenefitID:      benefit.ID,
+		PhysicalRoomID: benefit.PhysicalRoomID,
+		Name:           benefit.Name,
+		Description:    benefit.Description,
+		ActiveFrom:     benefit.ActiveFrom,
+		ActiveUntil:    benefit.ActiveUntil,
+		RoomIDs:        roomIDs,
+	}
+}
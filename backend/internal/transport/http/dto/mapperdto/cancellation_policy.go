@@ -0,0 +1,74 @@
+package mapperdto
+
+import (
+	"github.com/chayutK/hotel-property-service/internal/domain"
+	"github.com/chayutK/hotel-property-service/internal/transport/http/dto/cancellationpolicydto"
+)
+
+func ToCancellationPoliciesDTO(policies []domain.CancellationPolicy) []cancellationpolicydto.CancellationPolicyDTO {
+	policyDTOs := make([]cancellationpolicydto.CancellationPolicyDTO, len(policies))
+	for i, policy := range policies {
+		policyDTOs[i] = *ToCancellationPolicyDTO(&policy)
+	}
+	return policyDTOs
+}
+
+func ToCancellationPolicyDTO(policy *domain.CancellationPolicy) *cancellationpolicydto.CancellationPolicyDTO {
+	if policy == nil {
+		return nil
+	}
+
+	tiers := make([]cancellationpolicydto.PenaltyTierDTO, len(policy.PenaltyTiers))
+	for i, tier := range policy.PenaltyTiers {
+		tiers[i] = cancellationpolicydto.PenaltyTierDTO{
+			HoursBeforeArrival: tier.HoursBeforeArrival,
+			PenaltyPercent:     tier.PenaltyPercent,
+		}
+	}
+
+	return &cancellationpolicydto.CancellationPolicyDTO{
+		PolicyID:              policy.ID,
+		Name:                  policy.Name,
+		Description:           policy.Description,
+		FreeCancellationHours: policy.FreeCancellationHours,
+		PenaltyTiers:          tiers,
+		SurchargePercent:      policy.SurchargePercent,
+	}
+}
+
+func CreateCancellationPolicyRequestToDomain(req *cancellationpolicydto.CreateCancellationPolicyRequest) *domain.CancellationPolicy {
+	return &domain.CancellationPolicy{
+		Name:                  req.Name,
+		Description:           req.Description,
+		FreeCancellationHours: req.FreeCancellationHours,
+		PenaltyTiers:          toCancellationTiers(req.PenaltyTiers),
+		SurchargePercent:      req.SurchargePercent,
+	}
+}
+
+func UpdateCancellationPolicyRequestToDomain(req *cancellationpolicydto.UpdateCancellationPolicyRequest) *domain.CancellationPolicy {
+	return &domain.CancellationPolicy{
+		ID:                    req.PolicyID,
+		Name:                  req.Name,
+		Description:           req.Description,
+		FreeCancellationHours: req.FreeCancellationHours,
+		PenaltyTiers:          toCancellationTiers(req.PenaltyTiers),
+		SurchargePercent:      req.SurchargePercent,
+	}
+}
+
+// toCancellationTiers stores an empty list as nil, the same as a policy read back without tiers
+func toCancellationTiers(tierDTOs []cancellationpolicydto.PenaltyTierDTO) []domain.CancellationTier {
+	if len(tierDTOs) == 0 {
+		return nil
+	}
+
+	tiers := make([]domain.CancellationTier, len(tierDTOs))
+	for i, tier := range tierDTOs {
+		tiers[i] = domain.CancellationTier{
+			HoursBeforeArrival: tier.HoursBeforeArrival,
+			PenaltyPercent:     tier.PenaltyPercent,
+		}
+	}
+	return tiers
+}
@@ -0,0 +1,297 @@
+package mapperdto
+
+import (
+	"time"
+
+	"github.com/chayutK/hotel-property-service/internal/domain"
+	"github.com/chayutK/hotel-property-service/internal/transport/http/dto/catalogdto"
+)
+
+// ToDomainCatalogRecords flattens a catalog document, children take the IDs of the hotel and
+// physical room they are listed under.
+func ToDomainCatalogRecords(doc *catalogdto.CatalogDocument) *domain.CatalogRecords {
+	var records domain.CatalogRecords
+
+	for _, e := range doc.FacilityCatalog {
+		records.FacilityCatalog = append(records.FacilityCatalog, domain.FacilityCatalogEntry{
+			Code:        e.Code,
+			Name:        e.Name,
+			Description: e.Description,
+			IsActive:    isActive(e.IsActive),
+		})
+	}
+
+	for _, p := range doc.CancellationPolicies {
+		tiers := make([]domain.CancellationTier, len(p.PenaltyTiers))
+		for i, t := range p.PenaltyTiers {
+			tiers[i] = domain.CancellationTier{HoursBeforeArrival: t.HoursBeforeArrival, PenaltyPercent: t.PenaltyPercent}
+		}
+		if len(tiers) == 0 {
+			tiers = nil
+		}
+		records.CancellationPolicies = append(records.CancellationPolicies, domain.CancellationPolicy{
+			ID:                    p.PolicyID,
+			Name:                  p.Name,
+			Description:           p.Description,
+			FreeCancellationHours: p.FreeCancellationHours,
+			PenaltyTiers:          tiers,
+			SurchargePercent:      p.SurchargePercent,
+			IsActive:              isActive(p.IsActive),
+		})
+	}
+
+	for _, h := range doc.Hotels {
+		records.Hotels = append(records.Hotels, domain.Hotel{
+			ID:          h.HotelID,
+			Name:        h.Name,
+			Address:     h.Address,
+			IsActive:    isActive(h.IsActive),
+			ActiveFrom:  toSeconds(h.ActiveFrom),
+			ActiveUntil: toSeconds(h.ActiveUntil),
+		})
+
+		for _, f := range h.Facilities {
+			records.Facilities = append(records.Facilities, domain.Facility{
+				ID:          f.FacilityID,
+				HotelID:     h.HotelID,
+				Code:        f.Code,
+				Description: f.Description,
+				IsActive:    isActive(f.IsActive),
+				ActiveFrom:  toSeconds(f.ActiveFrom),
+				ActiveUntil: toSeconds(f.ActiveUntil),
+			})
+		}
+
+		for _, p := range h.PhysicalRooms {
+			records.PhysicalRooms = append(records.PhysicalRooms, domain.PhysicalRoom{
+				ID:          p.PhysicalRoomID,
+				HotelID:     h.HotelID,
+				Name:        p.Name,
+				Description: p.Description,
+				Type:        p.Type,
+				SizeSqm:     p.SizeSqm,
+				BedType:     p.BedType,
+				BedCount:    p.BedCount,
+				UnitCount:   p.UnitCount,
+				IsActive:    isActive(p.IsActive),
+			})
+
+			for _, b := range p.Benefits {
+				records.Benefits = append(records.Benefits, domain.Benefit{
+					ID:             b.BenefitID,
+					PhysicalRoomID: p.PhysicalRoomID,
+					Name:           b.Name,
+					Description:    b.Description,
+					IsActive:       isActive(b.IsActive),
+					ActiveFrom:     toSeconds(b.ActiveFrom),
+					ActiveUntil:    toSeconds(b.ActiveUntil),
+				})
+			}
+
+			for _, o := range p.Offers {
+				var childCharges []domain.ChildCharge
+				for _, band := range o.ChildCharges {
+					childCharges = append(childCharges, domain.ChildCharge{MaxAge: band.MaxAge, Amount: domain.NewMoney(band.Amount, o.Currency)})
+				}
+
+				records.Rooms = append(records.Rooms, *withOccupancyDefaults(&domain.Room{
+					ID:                   o.RoomID,
+					PhysicalRoomID:       p.PhysicalRoomID,
+					HotelID:              h.HotelID,
+					Name:                 o.Name,
+					Description:          o.Description,
+					Type:                 o.Type,
+					BasePrice:            domain.NewMoney(o.BasePrice, o.Currency),
+					BaseOccupancy:        o.BaseOccupancy,
+					MaxOccupancy:         o.MaxOccupancy,
+					ExtraAdultCharge:     domain.NewMoney(o.ExtraAdultCharge, o.Currency),
+					ChildCharges:         childCharges,
+					CancellationPolicyID: o.CancellationPolicy,
+					IsActive:             isActive(o.IsActive),
+					ActiveFrom:           toSeconds(o.ActiveFrom),
+					ActiveUntil:          toSeconds(o.ActiveUntil),
+				}))
+			}
+		}
+	}
+
+	return &records
+}
+
+// ToCatalogDocument nests catalog rows under their hotel and physical room, every row states
+// whether it is active.
+func ToCatalogDocument(records *domain.CatalogRecords) *catalogdto.CatalogDocument {
+	doc := catalogdto.CatalogDocument{
+		FacilityCatalog:      make([]catalogdto.CatalogEntryDTO, len(records.FacilityCatalog)),
+		CancellationPolicies: make([]catalogdto.CancellationPolicyDTO, len(records.CancellationPolicies)),
+		Hotels:               make([]catalogdto.HotelDTO, len(records.Hotels)),
+	}
+
+	for i, e := range records.FacilityCatalog {
+		doc.FacilityCatalog[i] = catalogdto.CatalogEntryDTO{
+			Code:        e.Code,
+			Name:        e.Name,
+			Description: e.Description,
+			IsActive:    &e.IsActive,
+		}
+	}
+
+	for i, p := range records.CancellationPolicies {
+		tiers := make([]catalogdto.PenaltyTierDTO, len(p.PenaltyTiers))
+		for j, t := range p.PenaltyTiers {
+			tiers[j] = catalogdto.PenaltyTierDTO{HoursBeforeArrival: t.HoursBeforeArrival, PenaltyPercent: t.PenaltyPercent}
+		}
+		doc.CancellationPolicies[i] = catalogdto.CancellationPolicyDTO{
+			PolicyID:              p.ID,
+			Name:                  p.Name,
+			Description:           p.Description,
+			FreeCancellationHours: p.FreeCancellationHours,
+			PenaltyTiers:          tiers,
+			SurchargePercent:      p.SurchargePercent,
+			IsActive:              &p.IsActive,
+		}
+	}
+
+	hotels := make(map[string]*catalogdto.HotelDTO, len(records.Hotels))
+	for i, h := range records.Hotels {
+		doc.Hotels[i] = catalogdto.HotelDTO{
+			HotelID:     h.ID,
+			Name:        h.Name,
+			Address:     h.Address,
+			IsActive:    &h.IsActive,
+			ActiveFrom:  h.ActiveFrom,
+			ActiveUntil: h.ActiveUntil,
+		}
+		hotels[h.ID] = &doc.Hotels[i]
+	}
+
+	for _, f := range records.Facilities {
+		if h, ok := hotels[f.HotelID]; ok {
+			h.Facilities = append(h.Facilities, catalogdto.FacilityDTO{
+				FacilityID:  f.ID,
+				Code:        f.Code,
+				Description: f.Description,
+				IsActive:    &f.IsActive,
+				ActiveFrom:  f.ActiveFrom,
+				ActiveUntil: f.ActiveUntil,
+			})
+		}
+	}
+
+	// physical rooms are placed first so benefits and offers can be attached by pointer
+	type location struct {
+		hotel *catalogdto.HotelDTO
+		index int
+	}
+	physicalRooms := make(map[string]location, len(records.PhysicalRooms))
+	for _, p := range records.PhysicalRooms {
+		h, ok := hotels[p.HotelID]
+		if !ok {
+			continue
+		}
+		physicalRooms[p.ID] = location{hotel: h, index: len(h.PhysicalRooms)}
+		h.PhysicalRooms = append(h.PhysicalRooms, catalogdto.PhysicalRoomDTO{
+			PhysicalRoomID: p.ID,
+			Name:           p.Name,
+			Description:    p.Description,
+			Type:           p.Type,
+			SizeSqm:        p.SizeSqm,
+			BedType:        p.BedType,
+			BedCount:       p.BedCount,
+			UnitCount:      p.UnitCount,
+			IsActive:       &p.IsActive,
+		})
+	}
+
+	for _, b := range records.Benefits {
+		if loc, ok := physicalRooms[b.PhysicalRoomID]; ok {
+			p := &loc.hotel.PhysicalRooms[loc.index]
+			p.Benefits = append(p.Benefits, catalogdto.BenefitDTO{
+				BenefitID:   b.ID,
+				Name:        b.Name,
+				Description: b.Description,
+				IsActive:    &b.IsActive,
+				ActiveFrom:  b.ActiveFrom,
+				ActiveUntil: b.ActiveUntil,
+			})
+		}
+	}
+
+	for _, r := range records.Rooms {
+		if loc, ok := physicalRooms[r.PhysicalRoomID]; ok {
+			var childCharges []catalogdto.ChildChargeDTO
+			for _, band := range r.ChildCharges {
+				childCharges = append(childCharges, catalogdto.ChildChargeDTO{MaxAge: band.MaxAge, Amount: band.Amount.Float64()})
+			}
+
+			p := &loc.hotel.PhysicalRooms[loc.index]
+			p.Offers = append(p.Offers, catalogdto.OfferDTO{
+				RoomID:             r.ID,
+				Name:               r.Name,
+				Description:        r.Description,
+				Type:               r.Type,
+				BasePrice:          r.BasePrice.Float64(),
+				Currency:           r.BasePrice.Currency,
+				CancellationPolicy: r.CancellationPolicyID,
+				IsActive:           &r.IsActive,
+				ActiveFrom:         r.ActiveFrom,
+				ActiveUntil:        r.ActiveUntil,
+				BaseOccupancy:      r.BaseOccupancy,
+				MaxOccupancy:       r.MaxOccupancy,
+				ExtraAdultCharge:   r.ExtraAdultCharge.Float64(),
+				ChildCharges:       childCharges,
+			})
+		}
+	}
+
+	return &doc
+}
+
+func ToCatalogChangesDTO(changes []domain.CatalogChange) []catalogdto.CatalogChangeDTO {
+	changeDTOs := make([]catalogdto.CatalogChangeDTO, len(changes))
+	for i, change := range changes {
+		fields := make([]catalogdto.FieldChangeDTO, len(change.Fields))
+		for j, field := range change.Fields {
+			fields[j] = catalogdto.FieldChangeDTO{
+				Field:  field.Field,
+				Before: field.Before,
+				After:  field.After,
+			}
+		}
+
+		changeDTOs[i] = catalogdto.CatalogChangeDTO{
+			Entity: change.Entity,
+			ID:     change.ID,
+			Action: change.Action,
+			Fields: fields,
+		}
+	}
+	return changeDTOs
+}
+
+// ToCatalogChangeSummary counts changes per entity and action
+func ToCatalogChangeSummary(changes []domain.CatalogChange) map[string]map[string]int {
+	summary := make(map[string]map[string]int)
+	for _, change := range changes {
+		if summary[change.Entity] == nil {
+			summary[change.Entity] = make(map[string]int)
+		}
+		summary[change.Entity][change.Action]++
+	}
+	return summary
+}
+
+// isActive treats rows without an explicit flag as active
+func isActive(flag *bool) bool {
+	return flag == nil || *flag
+}
+
+// toSeconds drops the sub-second part activation windows are not stored with, so re-importing
+// an unchanged window is not reported as a change
+func toSeconds(t *time.Time) *time.Time {
+	if t == nil {
+		return nil
+	}
+	truncated := t.Truncate(time.Second).UTC()
+	return &truncated
+}
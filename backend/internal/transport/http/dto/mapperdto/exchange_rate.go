@@ -0,0 +1,32 @@
+package mapperdto
+
+import (
+	"github.com/chayutK/hotel-property-service/internal/domain"
+	"github.com/chayutK/hotel-property-service/internal/transport/http/dto/exchangeratedto"
+)
+
+func ToExchangeRatesDTO(rates []domain.ExchangeRate) []exchangeratedto.ExchangeRateDTO {
+	rateDTOs := make([]exchangeratedto.ExchangeRateDTO, len(rates))
+	for i, rate := range rates {
+		rateDTOs[i] = exchangeratedto.ExchangeRateDTO{
+			From:          rate.From,
+			To:            rate.To,
+			EffectiveDate: rate.EffectiveDate,
+			Rate:          rate.Rate,
+		}
+	}
+	return rateDTOs
+}
+
+func SetExchangeRatesRequestToDomain(req *exchangeratedto.SetExchangeRatesRequest) []domain.ExchangeRate {
+	rates := make([]domain.ExchangeRate, len(req.Rates))
+	for i, rate := range req.Rates {
+		rates[i] = domain.ExchangeRate{
+			From:          rate.From,
+			To:            rate.To,
+			EffectiveDate: rate.EffectiveDate,
+			Rate:          rate.Rate,
+		}
+	}
+	return rates
+}
@@ -0,0 +1,26 @@
+package mapperdto
+
+import (
+	"github.com/chayutK/hotel-property-service/internal/domain"
+	"github.com/chayutK/hotel-property-service/internal/transport/http/dto/facilitydto"
+)
+
+func ToCatalogEntriesDTO(entries []domain.FacilityCatalogEntry) []facilitydto.CatalogEntryDTO {
+	entryDTOs := make([]facilitydto.CatalogEntryDTO, len(entries))
+	for i, entry := range entries {
+		entryDTOs[i] = *ToCatalogEntryDTO(&entry)
+	}
+	return entryDTOs
+}
+
+func ToCatalogEntryDTO(entry *domain.FacilityCatalogEntry) *facilitydto.CatalogEntryDTO {
+	if entry == nil {
+		return nil
+	}
+
+	return &facilitydto.CatalogEntryDTO{
+		Code:        entry.Code,
+		Name:        entry.Name,
+		Description: entry.Description,
+	}
+}
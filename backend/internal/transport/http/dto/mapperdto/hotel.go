@@ -24,10 +24,12 @@ func ToHotelDTO(hotel *domain.Hotel) *hoteldto.HotelDTO {
 	}
 
 	return &hoteldto.HotelDTO{
-		HotelID:  hotel.ID,
-		Name:     hotel.Name,
-		Address:  hotel.Address,
-		Facility: facilities,
+		HotelID:     hotel.ID,
+		Name:        hotel.Name,
+		Address:     hotel.Address,
+		ActiveFrom:  hotel.ActiveFrom,
+		ActiveUntil: hotel.ActiveUntil,
+		Facility:    facilities,
 	}
 }
 
@@ -38,7 +40,56 @@ func ToFacilityDTO(facility *domain.Facility) *hoteldto.FacilityDTO {
 
 	return &hoteldto.FacilityDTO{
 		FacilityID:  facility.ID,
+		Code:        facility.Code,
 		Name:        facility.Name,
 		Description: facility.Description,
+		ActiveFrom:  facility.ActiveFrom,
+		ActiveUntil: facility.ActiveUntil,
+	}
+}
+
+func CreateHotelRequestToDomain(req *hoteldto.CreateHotelRequest) *domain.Hotel {
+	return &domain.Hotel{
+		Name:        req.Name,
+		Address:     req.Address,
+		ActiveFrom:  req.ActiveFrom,
+		ActiveUntil: req.ActiveUntil,
+	}
+}
+
+func UpdateHotelRequestToDomain(req *hoteldto.UpdateHotelRequest) *domain.Hotel {
+	return &domain.Hotel{
+		ID:          req.HotelID,
+		Name:        req.Name,
+		Address:     req.Address,
+		ActiveFrom:  req.ActiveFrom,
+		ActiveUntil: req.ActiveUntil,
+	}
+}
+
+// ToUpdateHotelRequest is the full update document of a hotel, merge patches are applied to it.
+func ToUpdateHotelRequest(hotel *domain.Hotel) *hoteldto.UpdateHotelRequest {
+	return &hoteldto.UpdateHotelRequest{
+		HotelID:     hotel.ID,
+		Name:        hotel.Name,
+		Address:     hotel.Address,
+		ActiveFrom:  hotel.ActiveFrom,
+		ActiveUntil: hotel.ActiveUntil,
+	}
+}
+
+// ApplyHotelPatch overlays the fields present in a patch request onto the current hotel.
+func ApplyHotelPatch(hotel *domain.Hotel, req *hoteldto.PatchHotelRequest) {
+	if req.Name != nil {
+		hotel.Name = *req.Name
+	}
+	if req.Address != nil {
+		hotel.Address = *req.Address
+	}
+	if req.ActiveFrom != nil {
+		hotel.ActiveFrom = req.ActiveFrom
+	}
+	if req.ActiveUntil != nil {
+		hotel.ActiveUntil = req.ActiveUntil
 	}
 }
@@ -0,0 +1,13 @@
+package mapperdto
+
+import (
+	"encoding/json"
+
+	"github.com/chayutK/hotel-property-service/internal/domain"
+)
+
+// toAmount writes an amount as an exact decimal number with every decimal of its currency,
+// e.g. 1234.50 for THB and 1234 for JPY
+func toAmount(money domain.Money) json.Number {
+	return json.Number(money.String())
+}
@@ -0,0 +1,80 @@
+package mapperdto
+
+import (
+	"github.com/chayutK/hotel-property-service/internal/domain"
+	"github.com/chayutK/hotel-property-service/internal/transport/http/dto/physicalroomdto"
+	"github.com/chayutK/hotel-property-service/internal/transport/http/dto/roomdto"
+)
+
+func ToPhysicalRoomsDTO(physicalRooms []domain.PhysicalRoom) []physicalroomdto.PhysicalRoomDTO {
+	physicalRoomDTOs := make([]physicalroomdto.PhysicalRoomDTO, len(physicalRooms))
+	for i, physicalRoom := range physicalRooms {
+		physicalRoomDTOs[i] = *ToPhysicalRoomDTO(&physicalRoom)
+	}
+	return physicalRoomDTOs
+}
+
+func ToPhysicalRoomDTO(physicalRoom *domain.PhysicalRoom) *physicalroomdto.PhysicalRoomDTO {
+	if physicalRoom == nil {
+		return nil
+	}
+
+	benefits := make([]roomdto.BenefitDTO, len(physicalRoom.Benefit))
+	for i, benefit := range physicalRoom.Benefit {
+		benefits[i] = *ToBenefitDTO(&benefit)
+	}
+
+	offers := make([]physicalroomdto.OfferDTO, len(physicalRoom.Offers))
+	for i, offer := range physicalRoom.Offers {
+		offers[i] = physicalroomdto.OfferDTO{
+			RoomID:             offer.ID,
+			Name:               offer.Name,
+			Description:        offer.Description,
+			BasePrice:          toAmount(offer.BasePrice),
+			Currency:           offer.BasePrice.Currency,
+			CancellationPolicy: offer.CancellationPolicyID,
+			MaxOccupancy:       offer.MaxOccupancy,
+		}
+	}
+
+	return &physicalroomdto.PhysicalRoomDTO{
+		PhysicalRoomID: physicalRoom.ID,
+		HotelID:        physicalRoom.HotelID,
+		Name:           physicalRoom.Name,
+		Description:    physicalRoom.Description,
+		Type:           physicalRoom.Type,
+		SizeSqm:        physicalRoom.SizeSqm,
+		BedType:        physicalRoom.BedType,
+		BedCount:       physicalRoom.BedCount,
+		UnitCount:      physicalRoom.UnitCount,
+		Benefit:        benefits,
+		Offers:         offers,
+	}
+}
+
+func CreatePhysicalRoomRequestToDomain(req *physicalroomdto.CreatePhysicalRoomRequest) *domain.PhysicalRoom {
+	return &domain.PhysicalRoom{
+		HotelID:     req.HotelID,
+		Name:        req.Name,
+		Description: req.Description,
+		Type:        req.Type,
+		SizeSqm:     req.SizeSqm,
+		BedType:     req.BedType,
+		BedCount:    req.BedCount,
+		UnitCount:   req.UnitCount,
+	}
+}
+
+func UpdatePhysicalRoomRequestToDomain(req *physicalroomdto.UpdatePhysicalRoomRequest) *domain.PhysicalRoom {
+	return &domain.PhysicalRoom{
+		ID:          req.PhysicalRoomID,
+		HotelID:     req.HotelID,
+		Name:        req.Name,
+		Description: req.Description,
+		Type:        req.Type,
+		SizeSqm:     req.SizeSqm,
+		BedType:     req.BedType,
+		BedCount:    req.BedCount,
+		UnitCount:   req.UnitCount,
+	}
+}
@@ -0,0 +1,59 @@
+package mapperdto
+
+import (
+	"time"
+
+	"github.com/chayutK/hotel-property-service/internal/domain"
+	"github.com/chayutK/hotel-property-service/internal/transport/http/dto/pricehistorydto"
+)
+
+// InquiryPriceHistoryRequestToDomain reads the filter of a validated request
+func InquiryPriceHistoryRequestToDomain(req *pricehistorydto.InquiryPriceHistoryRequest) (domain.PriceHistoryFilter, error) {
+	from, err := toTimestamp(req.From)
+	if err != nil {
+		return domain.PriceHistoryFilter{}, err
+	}
+
+	to, err := toTimestamp(req.To)
+	if err != nil {
+		return domain.PriceHistoryFilter{}, err
+	}
+
+	return domain.PriceHistoryFilter{RoomID: req.RoomID, Source: req.Source, Night: req.Night, From: from, To: to}, nil
+}
+
+func ToPricePointsDTO(points []domain.PricePoint) []pricehistorydto.PricePointDTO {
+	pointDTOs := make([]pricehistorydto.PricePointDTO, len(points))
+	for i := range points {
+		pointDTOs[i] = *ToPricePointDTO(&points[i])
+	}
+	return pointDTOs
+}
+
+func ToPricePointDTO(point *domain.PricePoint) *pricehistorydto.PricePointDTO {
+	pointDTO := &pricehistorydto.PricePointDTO{
+		Source:      point.Source,
+		Date:        point.Date,
+		EffectiveAt: point.EffectiveAt,
+		Actor:       point.Actor,
+	}
+	if point.Price != nil {
+		price := toAmount(*point.Price)
+		pointDTO.Price = &price
+		pointDTO.Currency = point.Price.Currency
+	}
+	return pointDTO
+}
+
+// toTimestamp reads an optional RFC 3339 timestamp
+func toTimestamp(value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
@@ -0,0 +1,110 @@
+package mapperdto
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/chayutK/hotel-property-service/internal/domain"
+	"github.com/chayutK/hotel-property-service/internal/transport/http/dto/pricingdto"
+)
+
+// ToSimulationPeriod reads the nights of a validated simulation request, at most
+// domain.MaxSimulationNights of them
+func ToSimulationPeriod(req *pricingdto.SimulatePricesRequest) (time.Time, time.Time, error) {
+	from, err := time.Parse(domain.DateLayout, req.From)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+
+	to, err := time.Parse(domain.DateLayout, req.To)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+
+	if nights := int(to.Sub(from).Hours() / 24); nights > domain.MaxSimulationNights {
+		return time.Time{}, time.Time{}, fmt.Errorf("simulation covers %d nights, at most %d are allowed", nights, domain.MaxSimulationNights)
+	}
+
+	return from, to, nil
+}
+
+func SimulatePricesRequestToDomain(req *pricingdto.SimulatePricesRequest) domain.PriceDraft {
+	draft := domain.PriceDraft{RemovedRuleIDs: req.RemovedRuleIDs}
+
+	for _, rule := range req.PricingRules {
+		draft.Rules = append(draft.Rules, domain.PricingRule{
+			ID:         rule.RuleID,
+			RoomType:   rule.RoomType,
+			RoomID:     rule.RoomID,
+			Name:       rule.Name,
+			DateFrom:   rule.DateFrom,
+			DateUntil:  rule.DateUntil,
+			Weekdays:   weekdays(rule.Weekdays),
+			Multiplier: rule.Multiplier,
+			Priority:   rule.Priority,
+			Stackable:  rule.Stackable,
+		})
+	}
+
+	if len(req.BasePrices) > 0 {
+		draft.BasePrices = make(map[string]domain.Money, len(req.BasePrices))
+		for _, basePrice := range req.BasePrices {
+			draft.BasePrices[basePrice.RoomID] = domain.NewMoney(basePrice.Price, basePrice.Currency)
+		}
+	}
+
+	for _, rate := range req.Rates {
+		draft.Rates = append(draft.Rates, domain.RoomRate{
+			RoomID: rate.RoomID,
+			Date:   rate.Date,
+			Price:  domain.NewMoney(rate.Price, rate.Currency),
+		})
+	}
+
+	return draft
+}
+
+func ToSimulatePricesResponse(req *pricingdto.SimulatePricesRequest, rooms []domain.SimulatedRoom) *pricingdto.SimulatePricesResponse {
+	money := func(amount domain.Money) pricingdto.MoneyDTO {
+		return pricingdto.MoneyDTO{Amount: toAmount(amount), Currency: amount.Currency}
+	}
+	price := func(night domain.NightlyPrice) pricingdto.SimulatedPriceDTO {
+		var rules []pricingdto.AppliedRuleDTO
+		for _, rule := range night.Rules {
+			rules = append(rules, pricingdto.AppliedRuleDTO{RuleID: rule.ID, Name: rule.Name, Multiplier: rule.Multiplier})
+		}
+		return pricingdto.SimulatedPriceDTO{Source: night.Source, Rate: money(night.Rate), Rules: rules}
+	}
+
+	roomDTOs := make([]pricingdto.SimulatedRoomDTO, len(rooms))
+	for i, room := range rooms {
+		nights := make([]pricingdto.SimulatedNightDTO, len(room.Nights))
+		for j, night := range room.Nights {
+			nights[j] = pricingdto.SimulatedNightDTO{
+				Date:          night.Current.Date,
+				Current:       price(night.Current),
+				Simulated:     price(night.Simulated),
+				Change:        money(night.Change()),
+				ChangePercent: night.ChangePercent(),
+			}
+		}
+
+		currentTotal, simulatedTotal := room.CurrentTotal(), room.SimulatedTotal()
+		roomDTOs[i] = pricingdto.SimulatedRoomDTO{
+			RoomID:         room.Room.ID,
+			Name:           room.Room.Name,
+			Type:           room.Room.Type,
+			Nights:         nights,
+			CurrentTotal:   money(currentTotal),
+			SimulatedTotal: money(simulatedTotal),
+			Change:         money(simulatedTotal.Sub(currentTotal)),
+		}
+	}
+
+	return &pricingdto.SimulatePricesResponse{
+		HotelID: req.HotelID,
+		From:    req.From,
+		To:      req.To,
+		Rooms:   roomDTOs,
+	}
+}
@@ -0,0 +1,124 @@
+package mapperdto
+
+import (
+	"github.com/chayutK/hotel-property-service/internal/domain"
+	"github.com/chayutK/hotel-property-service/internal/transport/http/dto/pricingdto"
+)
+
+func ToCalculatePricingResponse(quote *domain.Quote) *pricingdto.CalculatePricingResponse {
+	money := func(amount domain.Money) pricingdto.MoneyDTO {
+		return pricingdto.MoneyDTO{Amount: toAmount(amount), Currency: amount.Currency}
+	}
+	lines := func(lines []domain.QuoteLine) []pricingdto.LineItemDTO {
+		lineDTOs := make([]pricingdto.LineItemDTO, len(lines))
+		for i, line := range lines {
+			lineDTOs[i] = pricingdto.LineItemDTO{Name: line.Name, Amount: money(line.Amount), Inclusive: line.Inclusive, PromotionID: line.PromotionID, StayRuleID: line.StayRuleID}
+		}
+		return lineDTOs
+	}
+
+	nights := make([]pricingdto.NightlyRateDTO, len(quote.Nights))
+	for i, night := range quote.Nights {
+		var rules []pricingdto.AppliedRuleDTO
+		for _, rule := range night.Rules {
+			rules = append(rules, pricingdto.AppliedRuleDTO{RuleID: rule.ID, Name: rule.Name, Multiplier: rule.Multiplier})
+		}
+
+		nights[i] = pricingdto.NightlyRateDTO{
+			Date:     night.Date,
+			Source:   night.Source,
+			BaseRate: money(night.BaseRate),
+			Rate:     money(night.Rate),
+			Rules:    rules,
+		}
+	}
+
+	var conversion *pricingdto.ConversionDTO
+	if quote.Conversion != nil {
+		rate := quote.Conversion.Rate
+		conversion = &pricingdto.ConversionDTO{
+			Rate:  pricingdto.ExchangeRateDTO{From: rate.From, To: rate.To, EffectiveDate: rate.EffectiveDate, Rate: rate.Rate},
+			Total: money(quote.Conversion.Total),
+		}
+	}
+
+	var signedQuote *pricingdto.SignedQuoteDTO
+	if signed := quote.Signed; signed != nil {
+		children := make([]pricingdto.ChildDTO, len(signed.Stay.ChildAges))
+		for i, age := range signed.Stay.ChildAges {
+			children[i] = pricingdto.ChildDTO{Age: age}
+		}
+
+		signedQuote = &pricingdto.SignedQuoteDTO{
+			QuoteID:    signed.ID,
+			HotelID:    signed.HotelID,
+			RoomID:     signed.RoomID,
+			RatePlanID: signed.RatePlanID,
+			CheckIn:    signed.Stay.CheckIn.Format(domain.DateLayout),
+			CheckOut:   signed.Stay.CheckOut.Format(domain.DateLayout),
+			Adults:     signed.Stay.Adults,
+			Children:   children,
+			Total:      money(signed.Total),
+			ExpiresAt:  signed.ExpiresAt,
+			Signature:  signed.Signature,
+		}
+	}
+
+	var ratePlan *pricingdto.RatePlanDTO
+	if plan := quote.RatePlan; plan != nil {
+		ratePlan = &pricingdto.RatePlanDTO{RatePlanID: plan.ID, Code: plan.Code, Name: plan.Name, Multiplier: plan.Multiplier, Inclusions: plan.Inclusions}
+	}
+
+	var promotion *pricingdto.PromoCodeDTO
+	if quote.PromoCode != "" {
+		promotion = &pricingdto.PromoCodeDTO{Code: quote.PromoCode, Applied: quote.PromoRejection == nil}
+		if quote.PromoRejection != nil {
+			promotion.Reason = quote.PromoRejection.Reason
+			promotion.Message = quote.PromoRejection.Message
+		}
+	}
+
+	return &pricingdto.CalculatePricingResponse{
+		Nights:       len(quote.Nights),
+		NightlyRates: nights,
+		Occupancy:    lines(quote.Occupancy),
+		RatePlan:     ratePlan,
+		Subtotal:     money(quote.Subtotal()),
+		CancellationSurcharge: pricingdto.SurchargeDTO{
+			PolicyID: quote.CancellationPolicyID,
+			Percent:  quote.SurchargePercent,
+			Amount:   money(quote.Surcharge),
+		},
+		Discounts:   lines(quote.Discounts),
+		Taxes:       lines(quote.Taxes),
+		Fees:        lines(quote.Fees),
+		Total:       money(quote.Total()),
+		Conversion:  conversion,
+		Promotion:   promotion,
+		SignedQuote: signedQuote,
+	}
+}
+
+// VerifyQuoteRequestToDomain reads the signed quote of a validated verification request
+func VerifyQuoteRequestToDomain(req *pricingdto.VerifyQuoteRequest) (*domain.SignedQuote, error) {
+	stay, err := ToStay(&pricingdto.CalculatePricingRequest{CheckIn: req.CheckIn, CheckOut: req.CheckOut, Adults: req.Adults, Children: req.Children})
+	if err != nil {
+		return nil, err
+	}
+
+	amount, err := req.Total.Amount.Float64()
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.SignedQuote{
+		ID:         req.QuoteID,
+		HotelID:    req.HotelID,
+		RoomID:     req.RoomID,
+		RatePlanID: req.RatePlanID,
+		Stay:       stay,
+		Total:      domain.NewMoney(amount, req.Total.Currency),
+		ExpiresAt:  req.ExpiresAt,
+		Signature:  req.Signature,
+	}, nil
+}
@@ -0,0 +1,74 @@
+package mapperdto
+
+import (
+	"github.com/chayutK/hotel-property-service/internal/domain"
+	"github.com/chayutK/hotel-property-service/internal/transport/http/dto/pricingruledto"
+)
+
+func ToPricingRulesDTO(rules []domain.PricingRule) []pricingruledto.PricingRuleDTO {
+	ruleDTOs := make([]pricingruledto.PricingRuleDTO, len(rules))
+	for i, rule := range rules {
+		ruleDTOs[i] = *ToPricingRuleDTO(&rule)
+	}
+	return ruleDTOs
+}
+
+func ToPricingRuleDTO(rule *domain.PricingRule) *pricingruledto.PricingRuleDTO {
+	if rule == nil {
+		return nil
+	}
+
+	return &pricingruledto.PricingRuleDTO{
+		RuleID:     rule.ID,
+		HotelID:    rule.HotelID,
+		Scope:      rule.Scope(),
+		RoomType:   rule.RoomType,
+		RoomID:     rule.RoomID,
+		Name:       rule.Name,
+		DateFrom:   rule.DateFrom,
+		DateUntil:  rule.DateUntil,
+		Weekdays:   rule.Weekdays,
+		Multiplier: rule.Multiplier,
+		Priority:   rule.Priority,
+		Stackable:  rule.Stackable,
+	}
+}
+
+func CreatePricingRuleRequestToDomain(req *pricingruledto.CreatePricingRuleRequest) *domain.PricingRule {
+	return &domain.PricingRule{
+		HotelID:    req.HotelID,
+		RoomType:   req.RoomType,
+		RoomID:     req.RoomID,
+		Name:       req.Name,
+		DateFrom:   req.DateFrom,
+		DateUntil:  req.DateUntil,
+		Weekdays:   weekdays(req.Weekdays),
+		Multiplier: req.Multiplier,
+		Priority:   req.Priority,
+		Stackable:  req.Stackable,
+	}
+}
+
+func UpdatePricingRuleRequestToDomain(req *pricingruledto.UpdatePricingRuleRequest) *domain.PricingRule {
+	return &domain.PricingRule{
+		ID:         req.RuleID,
+		HotelID:    req.HotelID,
+		RoomType:   req.RoomType,
+		RoomID:     req.RoomID,
+		Name:       req.Name,
+		DateFrom:   req.DateFrom,
+		DateUntil:  req.DateUntil,
+		Weekdays:   weekdays(req.Weekdays),
+		Multiplier: req.Multiplier,
+		Priority:   req.Priority,
+		Stackable:  req.Stackable,
+	}
+}
+
+// weekdays stores an empty list as nil, the same as a rule read back without weekdays
+func weekdays(codes []string) []string {
+	if len(codes) == 0 {
+		return nil
+	}
+	return codes
+}
@@ -0,0 +1,108 @@
+package mapperdto
+
+import (
+	"strings"
+
+	"github.com/chayutK/hotel-property-service/internal/domain"
+	"github.com/chayutK/hotel-property-service/internal/transport/http/dto/promotiondto"
+)
+
+func ToPromotionsDTO(promotions []domain.Promotion) []promotiondto.PromotionDTO {
+	promotionDTOs := make([]promotiondto.PromotionDTO, len(promotions))
+	for i, promotion := range promotions {
+		promotionDTOs[i] = *ToPromotionDTO(&promotion)
+	}
+	return promotionDTOs
+}
+
+func ToPromotionDTO(promotion *domain.Promotion) *promotiondto.PromotionDTO {
+	if promotion == nil {
+		return nil
+	}
+
+	promotionDTO := &promotiondto.PromotionDTO{
+		PromotionID:          promotion.ID,
+		Code:                 promotion.Code,
+		Name:                 promotion.Name,
+		Calculation:          promotion.Calculation,
+		Percent:              promotion.Percent,
+		Currency:             promotion.Amount.Currency,
+		ActiveFrom:           promotion.ActiveFrom,
+		ActiveUntil:          promotion.ActiveUntil,
+		StayFrom:             promotion.StayFrom,
+		StayUntil:            promotion.StayUntil,
+		MinNights:            promotion.MinNights,
+		MinDaysBeforeArrival: promotion.MinDaysBeforeArrival,
+		MaxDaysBeforeArrival: promotion.MaxDaysBeforeArrival,
+		HotelIDs:             promotion.HotelIDs,
+		RoomIDs:              promotion.RoomIDs,
+		MaxUses:              promotion.MaxUses,
+		Uses:                 promotion.Uses,
+		Stackable:            promotion.Stackable,
+	}
+	if promotion.Calculation == domain.CalculationFixed {
+		promotionDTO.Amount = toAmount(promotion.Amount)
+	}
+	return promotionDTO
+}
+
+func CreatePromotionRequestToDomain(req *promotiondto.CreatePromotionRequest) *domain.Promotion {
+	return toPromotion(&domain.Promotion{
+		Code:                 req.Code,
+		Name:                 req.Name,
+		Calculation:          req.Calculation,
+		Percent:              req.Percent,
+		Amount:               domain.NewMoney(req.Amount, req.Currency),
+		ActiveFrom:           req.ActiveFrom,
+		ActiveUntil:          req.ActiveUntil,
+		StayFrom:             req.StayFrom,
+		StayUntil:            req.StayUntil,
+		MinNights:            req.MinNights,
+		MinDaysBeforeArrival: req.MinDaysBeforeArrival,
+		MaxDaysBeforeArrival: req.MaxDaysBeforeArrival,
+		HotelIDs:             req.HotelIDs,
+		RoomIDs:              req.RoomIDs,
+		MaxUses:              req.MaxUses,
+		Stackable:            req.Stackable,
+	})
+}
+
+func UpdatePromotionRequestToDomain(req *promotiondto.UpdatePromotionRequest) *domain.Promotion {
+	return toPromotion(&domain.Promotion{
+		ID:                   req.PromotionID,
+		Code:                 req.Code,
+		Name:                 req.Name,
+		Calculation:          req.Calculation,
+		Percent:              req.Percent,
+		Amount:               domain.NewMoney(req.Amount, req.Currency),
+		ActiveFrom:           req.ActiveFrom,
+		ActiveUntil:          req.ActiveUntil,
+		StayFrom:             req.StayFrom,
+		StayUntil:            req.StayUntil,
+		MinNights:            req.MinNights,
+		MinDaysBeforeArrival: req.MinDaysBeforeArrival,
+		MaxDaysBeforeArrival: req.MaxDaysBeforeArrival,
+		HotelIDs:             req.HotelIDs,
+		RoomIDs:              req.RoomIDs,
+		MaxUses:              req.MaxUses,
+		Stackable:            req.Stackable,
+	})
+}
+
+// toPromotion stores codes upper case and drops the fields the calculation does not use, empty
+// ID lists are stored as nil, the same as a promotion read back without them
+func toPromotion(promotion *domain.Promotion) *domain.Promotion {
+	promotion.Code = strings.ToUpper(promotion.Code)
+	if promotion.Calculation == domain.CalculationPercent {
+		promotion.Amount = domain.Money{}
+	} else {
+		promotion.Percent = 0
+	}
+	if len(promotion.HotelIDs) == 0 {
+		promotion.HotelIDs = nil
+	}
+	if len(promotion.RoomIDs) == 0 {
+		promotion.RoomIDs = nil
+	}
+	return promotion
+}
@@ -0,0 +1,95 @@
+package mapperdto
+
+import (
+	"strings"
+
+	"github.com/chayutK/hotel-property-service/internal/domain"
+	"github.com/chayutK/hotel-property-service/internal/transport/http/dto/rateplandto"
+)
+
+func ToRatePlansDTO(plans []domain.RatePlan) []rateplandto.RatePlanDTO {
+	planDTOs := make([]rateplandto.RatePlanDTO, len(plans))
+	for i, plan := range plans {
+		planDTOs[i] = *ToRatePlanDTO(&plan)
+	}
+	return planDTOs
+}
+
+func ToRatePlanDTO(plan *domain.RatePlan) *rateplandto.RatePlanDTO {
+	if plan == nil {
+		return nil
+	}
+
+	return &rateplandto.RatePlanDTO{
+		RatePlanID:           plan.ID,
+		HotelID:              plan.HotelID,
+		Code:                 plan.Code,
+		Name:                 plan.Name,
+		Description:          plan.Description,
+		Kind:                 plan.Kind,
+		Multiplier:           plan.Multiplier,
+		CancellationPolicy:   plan.CancellationPolicyID,
+		Inclusions:           plan.Inclusions,
+		RoomIDs:              plan.RoomIDs,
+		RoomTypes:            plan.RoomTypes,
+		MembersOnly:          plan.MembersOnly,
+		AccessCodeRequired:   plan.AccessCode != "",
+		MinDaysBeforeArrival: plan.MinDaysBeforeArrival,
+		MinNights:            plan.MinNights,
+	}
+}
+
+func CreateRatePlanRequestToDomain(req *rateplandto.CreateRatePlanRequest) *domain.RatePlan {
+	return toRatePlan(&domain.RatePlan{
+		HotelID:              req.HotelID,
+		Code:                 req.Code,
+		Name:                 req.Name,
+		Description:          req.Description,
+		Kind:                 req.Kind,
+		Multiplier:           req.Multiplier,
+		CancellationPolicyID: req.CancellationPolicy,
+		Inclusions:           req.Inclusions,
+		RoomIDs:              req.RoomIDs,
+		RoomTypes:            req.RoomTypes,
+		MembersOnly:          req.MembersOnly,
+		AccessCode:           req.AccessCode,
+		MinDaysBeforeArrival: req.MinDaysBeforeArrival,
+		MinNights:            req.MinNights,
+	})
+}
+
+func UpdateRatePlanRequestToDomain(req *rateplandto.UpdateRatePlanRequest) *domain.RatePlan {
+	return toRatePlan(&domain.RatePlan{
+		ID:                   req.RatePlanID,
+		HotelID:              req.HotelID,
+		Code:                 req.Code,
+		Name:                 req.Name,
+		Description:          req.Description,
+		Kind:                 req.Kind,
+		Multiplier:           req.Multiplier,
+		CancellationPolicyID: req.CancellationPolicy,
+		Inclusions:           req.Inclusions,
+		RoomIDs:              req.RoomIDs,
+		RoomTypes:            req.RoomTypes,
+		MembersOnly:          req.MembersOnly,
+		AccessCode:           req.AccessCode,
+		MinDaysBeforeArrival: req.MinDaysBeforeArrival,
+		MinNights:            req.MinNights,
+	})
+}
+
+// toRatePlan stores codes upper case, empty lists are stored as nil, the same as a rate plan
+// read back without them
+func toRatePlan(plan *domain.RatePlan) *domain.RatePlan {
+	plan.Code = strings.ToUpper(plan.Code)
+	if len(plan.Inclusions) == 0 {
+		plan.Inclusions = nil
+	}
+	if len(plan.RoomIDs) == 0 {
+		plan.RoomIDs = nil
+	}
+	if len(plan.RoomTypes) == 0 {
+		plan.RoomTypes = nil
+	}
+	return plan
+}
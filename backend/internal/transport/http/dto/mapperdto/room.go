@@ -1,6 +1,7 @@
 package mapperdto
 
 import (
+	"github.com/chayutK/hotel-property-service/internal/constants/roomtype"
 	"github.com/chayutK/hotel-property-service/internal/domain"
 	"github.com/chayutK/hotel-property-service/internal/transport/http/dto/roomdto"
 )
@@ -23,17 +24,63 @@ func ToRoomDTO(room *domain.Room) *roomdto.RoomDTO {
 		benefits[i] = *ToBenefitDTO(&benefit)
 	}
 
+	childCharges := make([]roomdto.ChildChargeDTO, len(room.ChildCharges))
+	for i, band := range room.ChildCharges {
+		childCharges[i] = roomdto.ChildChargeDTO{MaxAge: band.MaxAge, Amount: toAmount(band.Amount)}
+	}
+
 	return &roomdto.RoomDTO{
 		RoomID:             room.ID,
+		PhysicalRoomID:     room.PhysicalRoomID,
 		HotelID:            room.HotelID,
 		Name:               room.Name,
 		Description:        room.Description,
 		Type:               room.Type,
-		BasePrice:          room.BasePrice,
-		Currency:           room.Currency,
+		BasePrice:          toAmount(room.BasePrice),
+		Currency:           room.BasePrice.Currency,
+		BaseOccupancy:      room.BaseOccupancy,
+		MaxOccupancy:       room.MaxOccupancy,
+		ExtraAdultCharge:   toAmount(room.ExtraAdultCharge),
+		ChildCharges:       childCharges,
 		Benefit:            benefits,
-		CancellationPolicy: room.CancellationPolicy,
+		CancellationPolicy: room.CancellationPolicyID,
+		ActiveFrom:         room.ActiveFrom,
+		ActiveUntil:        room.ActiveUntil,
+		RatePlans:          toRoomRatePlansDTO(room),
+	}
+}
+
+// toRoomRatePlansDTO lists the rate plans of a room, a plan without its own cancellation policy
+// is sold with the room's
+func toRoomRatePlansDTO(room *domain.Room) []roomdto.RatePlanDTO {
+	if len(room.RatePlans) == 0 {
+		return nil
 	}
+
+	planDTOs := make([]roomdto.RatePlanDTO, len(room.RatePlans))
+	for i, plan := range room.RatePlans {
+		policy := plan.CancellationPolicyID
+		if policy == "" {
+			policy = room.CancellationPolicyID
+		}
+
+		planDTOs[i] = roomdto.RatePlanDTO{
+			RatePlanID:           plan.ID,
+			Code:                 plan.Code,
+			Name:                 plan.Name,
+			Description:          plan.Description,
+			Kind:                 plan.Kind,
+			Multiplier:           plan.Multiplier,
+			BasePrice:            toAmount(room.BasePrice.Mul(plan.Multiplier)),
+			CancellationPolicy:   policy,
+			Inclusions:           plan.Inclusions,
+			MembersOnly:          plan.MembersOnly,
+			AccessCodeRequired:   plan.AccessCode != "",
+			MinDaysBeforeArrival: plan.MinDaysBeforeArrival,
+			MinNights:            plan.MinNights,
+		}
+	}
+	return planDTOs
 }
 
 func ToBenefitDTO(benefit *domain.Benefit) *roomdto.BenefitDTO {
@@ -41,6 +88,139 @@ func ToBenefitDTO(benefit *domain.Benefit) *roomdto.BenefitDTO {
 		BenefitID:      benefit.ID,
 		PhysicalRoomID: benefit.PhysicalRoomID,
 		Description:    benefit.Description,
+		ActiveFrom:     benefit.ActiveFrom,
+		ActiveUntil:    benefit.ActiveUntil,
+	}
+
+}
+
+func CreateRoomRequestToDomain(req *roomdto.CreateRoomRequest) *domain.Room {
+	return withOccupancyDefaults(&domain.Room{
+		PhysicalRoomID:       req.PhysicalRoomID,
+		HotelID:              req.HotelID,
+		Name:                 req.Name,
+		Description:          req.Description,
+		Type:                 req.Type,
+		BasePrice:            domain.NewMoney(req.BasePrice, req.Currency),
+		BaseOccupancy:        req.BaseOccupancy,
+		MaxOccupancy:         req.MaxOccupancy,
+		ExtraAdultCharge:     domain.NewMoney(req.ExtraAdultCharge, req.Currency),
+		ChildCharges:         toChildCharges(req.ChildCharges, req.Currency),
+		CancellationPolicyID: req.CancellationPolicy,
+		ActiveFrom:           req.ActiveFrom,
+		ActiveUntil:          req.ActiveUntil,
+	})
+}
+
+func UpdateRoomRequestToDomain(req *roomdto.UpdateRoomRequest) *domain.Room {
+	return withOccupancyDefaults(&domain.Room{
+		ID:                   req.RoomID,
+		HotelID:              req.HotelID,
+		Name:                 req.Name,
+		Description:          req.Description,
+		Type:                 req.Type,
+		BasePrice:            domain.NewMoney(req.BasePrice, req.Currency),
+		BaseOccupancy:        req.BaseOccupancy,
+		MaxOccupancy:         req.MaxOccupancy,
+		ExtraAdultCharge:     domain.NewMoney(req.ExtraAdultCharge, req.Currency),
+		ChildCharges:         toChildCharges(req.ChildCharges, req.Currency),
+		CancellationPolicyID: req.CancellationPolicy,
+		ActiveFrom:           req.ActiveFrom,
+		ActiveUntil:          req.ActiveUntil,
+	})
+}
+
+// ToUpdateRoomRequest is the full update document of a room, merge patches are applied to it.
+func ToUpdateRoomRequest(room *domain.Room) *roomdto.UpdateRoomRequest {
+	return &roomdto.UpdateRoomRequest{
+		HotelID:            room.HotelID,
+		RoomID:             room.ID,
+		Name:               room.Name,
+		Description:        room.Description,
+		Type:               room.Type,
+		BasePrice:          room.BasePrice.Float64(),
+		Currency:           room.BasePrice.Currency,
+		BaseOccupancy:      room.BaseOccupancy,
+		MaxOccupancy:       room.MaxOccupancy,
+		ExtraAdultCharge:   room.ExtraAdultCharge.Float64(),
+		ChildCharges:       toChildChargeRequests(room.ChildCharges),
+		CancellationPolicy: room.CancellationPolicyID,
+		ActiveFrom:         room.ActiveFrom,
+		ActiveUntil:        room.ActiveUntil,
+	}
+}
+
+// ApplyRoomPatch overlays the fields present in a patch request onto the full update document
+// of the room, which is then validated like a PUT. A new currency keeps the amounts in major
+// units, they have to fit its minor unit.
+func ApplyRoomPatch(update *roomdto.UpdateRoomRequest, req *roomdto.PatchRoomRequest) {
+	if req.Name != nil {
+		update.Name = *req.Name
+	}
+	if req.Description != nil {
+		update.Description = *req.Description
+	}
+	if req.Type != nil {
+		update.Type = *req.Type
+	}
+	if req.BasePrice != nil {
+		update.BasePrice = *req.BasePrice
+	}
+	if req.Currency != nil {
+		update.Currency = *req.Currency
+	}
+	if req.ExtraAdultCharge != nil {
+		update.ExtraAdultCharge = *req.ExtraAdultCharge
+	}
+	if req.ChildCharges != nil {
+		update.ChildCharges = req.ChildCharges
+	}
+	if req.BaseOccupancy != nil {
+		update.BaseOccupancy = *req.BaseOccupancy
+	}
+	if req.MaxOccupancy != nil {
+		update.MaxOccupancy = *req.MaxOccupancy
+	}
+	if req.CancellationPolicy != nil {
+		update.CancellationPolicy = *req.CancellationPolicy
+	}
+	if req.ActiveFrom != nil {
+		update.ActiveFrom = req.ActiveFrom
+	}
+	if req.ActiveUntil != nil {
+		update.ActiveUntil = req.ActiveUntil
 	}
+}
+
+// withOccupancyDefaults fills in the occupancy a room left out from the defaults of its type,
+// never below the base occupancy it gives
+func withOccupancyDefaults(room *domain.Room) *domain.Room {
+	if room.MaxOccupancy == 0 {
+		room.MaxOccupancy = max(roomtype.DefaultMaxOccupancy(room.Type), room.BaseOccupancy)
+	}
+	if room.BaseOccupancy == 0 {
+		room.BaseOccupancy = min(roomtype.DefaultBaseOccupancy, room.MaxOccupancy)
+	}
+	return room
+}
+
+// toChildCharges stores an empty list as nil, the same as a room read back without bands
+func toChildCharges(bandDTOs []roomdto.ChildChargeRequest, currency string) []domain.ChildCharge {
+	if len(bandDTOs) == 0 {
+		return nil
+	}
+
+	bands := make([]domain.ChildCharge, len(bandDTOs))
+	for i, band := range bandDTOs {
+		bands[i] = domain.ChildCharge{MaxAge: band.MaxAge, Amount: domain.NewMoney(band.Amount, currency)}
+	}
+	return bands
+}
 
+func toChildChargeRequests(bands []domain.ChildCharge) []roomdto.ChildChargeRequest {
+	bandDTOs := make([]roomdto.ChildChargeRequest, len(bands))
+	for i, band := range bands {
+		bandDTOs[i] = roomdto.ChildChargeRequest{MaxAge: band.MaxAge, Amount: band.Amount.Float64()}
+	}
+	return bandDTOs
 }
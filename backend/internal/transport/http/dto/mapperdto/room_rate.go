@@ -0,0 +1,63 @@
+package mapperdto
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/chayutK/hotel-property-service/internal/domain"
+	"github.com/chayutK/hotel-property-service/internal/transport/http/dto/pricingdto"
+	"github.com/chayutK/hotel-property-service/internal/transport/http/dto/roomratedto"
+)
+
+func ToRoomRatesDTO(rates []domain.RoomRate) []roomratedto.RoomRateDTO {
+	rateDTOs := make([]roomratedto.RoomRateDTO, len(rates))
+	for i, rate := range rates {
+		rateDTOs[i] = roomratedto.RoomRateDTO{
+			Date:     rate.Date,
+			Price:    toAmount(rate.Price),
+			Currency: rate.Price.Currency,
+		}
+	}
+	return rateDTOs
+}
+
+func SetRoomRatesRequestToDomain(req *roomratedto.SetRoomRatesRequest) []domain.RoomRate {
+	rates := make([]domain.RoomRate, len(req.Rates))
+	for i, rate := range req.Rates {
+		rates[i] = domain.RoomRate{
+			RoomID: req.RoomID,
+			Date:   rate.Date,
+			Price:  domain.NewMoney(rate.Price, req.Currency),
+		}
+	}
+	return rates
+}
+
+// ToStay reads the stay of a validated pricing request, at most domain.MaxStayNights nights
+func ToStay(req *pricingdto.CalculatePricingRequest) (domain.Stay, error) {
+	checkIn, err := time.Parse(domain.DateLayout, req.CheckIn)
+	if err != nil {
+		return domain.Stay{}, err
+	}
+
+	checkOut, err := time.Parse(domain.DateLayout, req.CheckOut)
+	if err != nil {
+		return domain.Stay{}, err
+	}
+
+	if nights := int(checkOut.Sub(checkIn).Hours() / 24); nights > domain.MaxStayNights {
+		return domain.Stay{}, fmt.Errorf("stay covers %d nights, at most %d are allowed", nights, domain.MaxStayNights)
+	}
+
+	adults := req.Adults
+	if adults == 0 {
+		adults = 1
+	}
+
+	var childAges []int
+	for _, child := range req.Children {
+		childAges = append(childAges, child.Age)
+	}
+
+	return domain.Stay{CheckIn: checkIn, CheckOut: checkOut, Adults: adults, ChildAges: childAges}, nil
+}
@@ -0,0 +1,62 @@
+package mapperdto
+
+import (
+	"github.com/chayutK/hotel-property-service/internal/domain"
+	"github.com/chayutK/hotel-property-service/internal/transport/http/dto/stayruledto"
+)
+
+func ToStayRulesDTO(rules []domain.StayRule) []stayruledto.StayRuleDTO {
+	ruleDTOs := make([]stayruledto.StayRuleDTO, len(rules))
+	for i, rule := range rules {
+		ruleDTOs[i] = *ToStayRuleDTO(&rule)
+	}
+	return ruleDTOs
+}
+
+func ToStayRuleDTO(rule *domain.StayRule) *stayruledto.StayRuleDTO {
+	if rule == nil {
+		return nil
+	}
+
+	return &stayruledto.StayRuleDTO{
+		RuleID:    rule.ID,
+		HotelID:   rule.HotelID,
+		RoomID:    rule.RoomID,
+		Name:      rule.Name,
+		Kind:      rule.Kind,
+		DateFrom:  rule.DateFrom,
+		DateUntil: rule.DateUntil,
+		MinNights: rule.MinNights,
+		MaxNights: rule.MaxNights,
+		Percent:   rule.Percent,
+	}
+}
+
+func CreateStayRuleRequestToDomain(req *stayruledto.CreateStayRuleRequest) *domain.StayRule {
+	return &domain.StayRule{
+		HotelID:   req.HotelID,
+		RoomID:    req.RoomID,
+		Name:      req.Name,
+		Kind:      req.Kind,
+		DateFrom:  req.DateFrom,
+		DateUntil: req.DateUntil,
+		MinNights: req.MinNights,
+		MaxNights: req.MaxNights,
+		Percent:   req.Percent,
+	}
+}
+
+func UpdateStayRuleRequestToDomain(req *stayruledto.UpdateStayRuleRequest) *domain.StayRule {
+	return &domain.StayRule{
+		ID:        req.RuleID,
+		HotelID:   req.HotelID,
+		RoomID:    req.RoomID,
+		Name:      req.Name,
+		Kind:      req.Kind,
+		DateFrom:  req.DateFrom,
+		DateUntil: req.DateUntil,
+		MinNights: req.MinNights,
+		MaxNights: req.MaxNights,
+		Percent:   req.Percent,
+	}
+}
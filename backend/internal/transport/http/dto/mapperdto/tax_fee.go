@@ -0,0 +1,80 @@
+package mapperdto
+
+import (
+	"github.com/chayutK/hotel-property-service/internal/domain"
+	"github.com/chayutK/hotel-property-service/internal/transport/http/dto/taxfeedto"
+)
+
+func ToTaxFeesDTO(taxFees []domain.TaxFee) []taxfeedto.TaxFeeDTO {
+	taxFeeDTOs := make([]taxfeedto.TaxFeeDTO, len(taxFees))
+	for i, taxFee := range taxFees {
+		taxFeeDTOs[i] = *ToTaxFeeDTO(&taxFee)
+	}
+	return taxFeeDTOs
+}
+
+func ToTaxFeeDTO(taxFee *domain.TaxFee) *taxfeedto.TaxFeeDTO {
+	if taxFee == nil {
+		return nil
+	}
+
+	taxFeeDTO := &taxfeedto.TaxFeeDTO{
+		TaxFeeID:    taxFee.ID,
+		HotelID:     taxFee.HotelID,
+		Name:        taxFee.Name,
+		Kind:        taxFee.Kind,
+		Calculation: taxFee.Calculation,
+		Percent:     taxFee.Percent,
+		Basis:       taxFee.Basis,
+		Currency:    taxFee.Amount.Currency,
+		Inclusive:   taxFee.Inclusive,
+		Compound:    taxFee.Compound,
+		Sequence:    taxFee.Sequence,
+	}
+	if taxFee.Calculation == domain.CalculationFixed {
+		taxFeeDTO.Amount = toAmount(taxFee.Amount)
+	}
+	return taxFeeDTO
+}
+
+func CreateTaxFeeRequestToDomain(req *taxfeedto.CreateTaxFeeRequest) *domain.TaxFee {
+	return toTaxFee(&domain.TaxFee{
+		HotelID:     req.HotelID,
+		Name:        req.Name,
+		Kind:        req.Kind,
+		Calculation: req.Calculation,
+		Percent:     req.Percent,
+		Amount:      domain.NewMoney(req.Amount, req.Currency),
+		Basis:       req.Basis,
+		Inclusive:   req.Inclusive,
+		Compound:    req.Compound,
+		Sequence:    req.Sequence,
+	})
+}
+
+func UpdateTaxFeeRequestToDomain(req *taxfeedto.UpdateTaxFeeRequest) *domain.TaxFee {
+	return toTaxFee(&domain.TaxFee{
+		ID:          req.TaxFeeID,
+		HotelID:     req.HotelID,
+		Name:        req.Name,
+		Kind:        req.Kind,
+		Calculation: req.Calculation,
+		Percent:     req.Percent,
+		Amount:      domain.NewMoney(req.Amount, req.Currency),
+		Basis:       req.Basis,
+		Inclusive:   req.Inclusive,
+		Compound:    req.Compound,
+		Sequence:    req.Sequence,
+	})
+}
+
+// toTaxFee drops the fields the calculation does not use, so a stored percentage never carries
+// a stray fixed amount and the other way around
+func toTaxFee(taxFee *domain.TaxFee) *domain.TaxFee {
+	if taxFee.Calculation == domain.CalculationPercent {
+		taxFee.Amount, taxFee.Basis = domain.Money{}, ""
+	} else {
+		taxFee.Percent, taxFee.Compound = 0, false
+	}
+	return taxFee
+}
@@ -0,0 +1,32 @@
+package physicalroomdto
+
+import (
+	"encoding/json"
+
+	"github.com/chayutK/hotel-property-service/internal/transport/http/dto/roomdto"
+)
+
+type PhysicalRoomDTO struct {
+	PhysicalRoomID string               `json:"physicalRoomID"`
+	HotelID        string               `json:"hotelID"`
+	Name           string               `json:"name"`
+	Description    string               `json:"description"`
+	Type           string               `json:"type"`
+	SizeSqm        int                  `json:"sizeSqm"`
+	BedType        string               `json:"bedType"`
+	BedCount       int                  `json:"bedCount"`
+	UnitCount      int                  `json:"unitCount"`
+	Benefit        []roomdto.BenefitDTO `json:"benefit"`
+	Offers         []OfferDTO           `json:"offers"`
+}
+
+// OfferDTO is a sellable rate variant of a physical room.
+type OfferDTO struct {
+	RoomID             string      `json:"roomID"`
+	Name               string      `json:"name"`
+	Description        string      `json:"description"`
+	BasePrice          json.Number `json:"basePrice" swaggertype:"number" example:"1234.50"`
+	Currency           string      `json:"currency"`
+	CancellationPolicy string      `json:"cancellationPolicy"`
+	MaxOccupancy       int         `json:"maxOccupancy"`
+}
@@ -0,0 +1,38 @@
+package physicalroomdto
+
+type InquiryPhysicalRoomsRequest struct {
+	HotelID string `param:"hotelID" validate:"required,uuid4"`
+}
+
+type InquiryPhysicalRoomRequest struct {
+	HotelID        string `param:"hotelID" validate:"required,uuid4"`
+	PhysicalRoomID string `param:"physicalRoomID" validate:"required,uuid4"`
+}
+
+type CreatePhysicalRoomRequest struct {
+	HotelID     string `param:"hotelID" json:"-" validate:"required,uuid4"`
+	Name        string `json:"name" validate:"required,max=255"`
+	Description string `json:"description" validate:"max=1000"`
+	Type        string `json:"type" validate:"required,room_type"`
+	SizeSqm     int    `json:"sizeSqm" validate:"min=0"`
+	BedType     string `json:"bedType" validate:"max=64"`
+	BedCount    int    `json:"bedCount" validate:"required,min=1"`
+	UnitCount   int    `json:"unitCount" validate:"required,min=1"`
+}
+
+type UpdatePhysicalRoomRequest struct {
+	HotelID        string `param:"hotelID" json:"-" validate:"required,uuid4"`
+	PhysicalRoomID string `param:"physicalRoomID" json:"-" validate:"required,uuid4"`
+	Name           string `json:"name" validate:"required,max=255"`
+	Description    string `json:"description" validate:"max=1000"`
+	Type           string `json:"type" validate:"required,room_type"`
+	SizeSqm        int    `json:"sizeSqm" validate:"min=0"`
+	BedType        string `json:"bedType" validate:"max=64"`
+	BedCount       int    `json:"bedCount" validate:"required,min=1"`
+	UnitCount      int    `json:"unitCount" validate:"required,min=1"`
+}
+
+type DeletePhysicalRoomRequest struct {
+	HotelID        string `param:"hotelID" validate:"required,uuid4"`
+	PhysicalRoomID string `param:"physicalRoomID" validate:"required,uuid4"`
+}
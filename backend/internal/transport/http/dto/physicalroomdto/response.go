@@ -0,0 +1,9 @@
+package physicalroomdto
+
+type InquiryPhysicalRoomsResponse struct {
+	PhysicalRooms []PhysicalRoomDTO `json:"physicalRooms"`
+}
+
+type PhysicalRoomResponse struct {
+	PhysicalRoom PhysicalRoomDTO `json:"physicalRoom"`
+}
@@ -0,0 +1,17 @@
+package pricehistorydto
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// PricePointDTO is a price that took effect at EffectiveAt, a rate removed from the calendar has
+// no price
+type PricePointDTO struct {
+	Source      string       `json:"source" example:"rate_calendar"`
+	Date        string       `json:"date,omitempty"`
+	Price       *json.Number `json:"price" swaggertype:"number" example:"1234.50"`
+	Currency    string       `json:"currency,omitempty"`
+	EffectiveAt time.Time    `json:"effectiveAt"`
+	Actor       string       `json:"actor"`
+}
@@ -0,0 +1,14 @@
+package pricehistorydto
+
+// InquiryPriceHistoryRequest selects price changes that took effect from From up to To, both
+// RFC 3339 timestamps. With Night only the base prices and the rates of that night are listed,
+// At then also asks for the price of the night at that time.
+type InquiryPriceHistoryRequest struct {
+	HotelID string `param:"hotelID" validate:"required,uuid4"`
+	RoomID  string `param:"roomID" validate:"required,uuid4"`
+	Source  string `query:"source" validate:"omitempty,oneof=base_price rate_calendar"`
+	Night   string `query:"night" validate:"required_with=At,omitempty,datetime=2006-01-02"`
+	From    string `query:"from" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00"`
+	To      string `query:"to" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00"`
+	At      string `query:"at" validate:"omitempty,datetime=2006-01-02T15:04:05Z07:00"`
+}
@@ -0,0 +1,7 @@
+package pricehistorydto
+
+type PriceHistoryResponse struct {
+	History []PricePointDTO `json:"history"`
+	// PriceAt is the price of the night at the requested time, before pricing rules
+	PriceAt *PricePointDTO `json:"priceAt,omitempty"`
+}
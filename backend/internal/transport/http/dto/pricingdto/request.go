@@ -1,7 +1,89 @@
 package pricingdto
 
+import "time"
+
+// CalculatePricingRequest prices the nights from CheckIn up to, not including, CheckOut for
+// Adults adults, one when left out, and Children. With Currency the total is also converted to
+// it, PromoCode is the promo code the guest entered. With RatePlanID the stay is priced on that
+// rate plan, Member and AccessCode make the guest eligible for member and corporate plans.
 type CalculatePricingRequest struct {
-	HotelID string `json:"hotelID" validate:"required,uuid4"`
-	RoomID  string `json:"roomID" validate:"required,uuid4"`
-	Nights  int    `json:"nights" validate:"required,min=1"`
+	HotelID    string     `json:"hotelID" validate:"required,uuid4"`
+	RoomID     string     `json:"roomID" validate:"required,uuid4"`
+	RatePlanID string     `json:"ratePlanID" validate:"omitempty,uuid4"`
+	CheckIn    string     `json:"checkIn" validate:"required,datetime=2006-01-02"`
+	CheckOut   string     `json:"checkOut" validate:"required,datetime=2006-01-02,date_after=CheckIn"`
+	Adults     int        `json:"adults" validate:"omitempty,min=1,max=20"`
+	Children   []ChildDTO `json:"children" validate:"max=10,dive"`
+	Currency   string     `json:"currency" validate:"omitempty,currency"`
+	PromoCode  string     `json:"promoCode" validate:"omitempty,max=64"`
+	Member     bool       `json:"member"`
+	AccessCode string     `json:"accessCode" validate:"max=64"`
+}
+
+type ChildDTO struct {
+	Age int `json:"age" validate:"min=0,max=17"`
+}
+
+// BatchPricingRequest prices every item like a CalculatePricingRequest, items are validated one
+// by one so an invalid item only fails itself
+type BatchPricingRequest struct {
+	Items []CalculatePricingRequest `json:"items" validate:"required,min=1,max=50"`
+}
+
+// VerifyQuoteRequest is the signedQuote of a pricing response, exactly as it was returned
+type VerifyQuoteRequest struct {
+	QuoteID    string     `json:"quoteID" validate:"required,uuid4"`
+	HotelID    string     `json:"hotelID" validate:"required,uuid4"`
+	RoomID     string     `json:"roomID" validate:"required,uuid4"`
+	RatePlanID string     `json:"ratePlanID" validate:"omitempty,uuid4"`
+	CheckIn    string     `json:"checkIn" validate:"required,datetime=2006-01-02"`
+	CheckOut   string     `json:"checkOut" validate:"required,datetime=2006-01-02"`
+	Adults     int        `json:"adults" validate:"min=1,max=20"`
+	Children   []ChildDTO `json:"children" validate:"max=10,dive"`
+	Total      MoneyDTO   `json:"total"`
+	ExpiresAt  time.Time  `json:"expiresAt" validate:"required"`
+	Signature  string     `json:"signature" validate:"required,max=128"`
+}
+
+// SimulatePricesRequest previews draft prices of the hotel for the nights from From up to, not
+// including, To. PricingRules are added to the rules of the hotel, one with a RuleID replaces
+// that rule, and RemovedRuleIDs are left out. BasePrices and Rates are in the currency of their
+// room. Without RoomIDs every room on sale is previewed.
+type SimulatePricesRequest struct {
+	HotelID        string                    `param:"hotelID" json:"-" validate:"required,uuid4"`
+	From           string                    `json:"from" validate:"required,datetime=2006-01-02"`
+	To             string                    `json:"to" validate:"required,datetime=2006-01-02,date_after=From"`
+	RoomIDs        []string                  `json:"roomIDs" validate:"max=100,unique,dive,uuid4"`
+	PricingRules   []DraftPricingRuleRequest `json:"pricingRules" validate:"max=50,dive"`
+	RemovedRuleIDs []string                  `json:"removedRuleIDs" validate:"max=50,unique,dive,uuid4"`
+	BasePrices     []DraftBasePriceRequest   `json:"basePrices" validate:"max=100,unique=RoomID,dive"`
+	Rates          []DraftRateRequest        `json:"rates" validate:"max=1000,dive"`
+}
+
+// DraftPricingRuleRequest is a pricing rule like the ones POST /hotels/:hotelID/pricing-rules
+// creates, with the RuleID of the rule it replaces
+type DraftPricingRuleRequest struct {
+	RuleID     string   `json:"ruleID" validate:"omitempty,uuid4"`
+	RoomType   string   `json:"roomType" validate:"omitempty,room_type,excluded_with=RoomID"`
+	RoomID     string   `json:"roomID" validate:"omitempty,uuid4"`
+	Name       string   `json:"name" validate:"required,max=255"`
+	DateFrom   string   `json:"dateFrom" validate:"omitempty,datetime=2006-01-02"`
+	DateUntil  string   `json:"dateUntil" validate:"omitempty,datetime=2006-01-02,date_after=DateFrom"`
+	Weekdays   []string `json:"weekdays" validate:"max=7,unique,dive,oneof=MON TUE WED THU FRI SAT SUN"`
+	Multiplier float64  `json:"multiplier" validate:"required,gt=0,lte=10"`
+	Priority   int      `json:"priority" validate:"min=0,max=1000"`
+	Stackable  bool     `json:"stackable"`
+}
+
+type DraftBasePriceRequest struct {
+	RoomID   string  `json:"roomID" validate:"required,uuid4"`
+	Price    float64 `json:"price" validate:"required,gt=0,money=Currency"`
+	Currency string  `json:"currency" validate:"required,currency"`
+}
+
+type DraftRateRequest struct {
+	RoomID   string  `json:"roomID" validate:"required,uuid4"`
+	Date     string  `json:"date" validate:"required,datetime=2006-01-02"`
+	Price    float64 `json:"price" validate:"required,gt=0,money=Currency"`
+	Currency string  `json:"currency" validate:"required,currency"`
 }
@@ -1,5 +1,175 @@
 package pricingdto
 
+import (
+	"encoding/json"
+	"time"
+)
+
+// CalculatePricingResponse itemizes the price of a stay, Total is what the guest pays
 type CalculatePricingResponse struct {
-	TotalPrice float64 `json:"totalPrice"`
+	Nights                int              `json:"nights"`
+	NightlyRates          []NightlyRateDTO `json:"nightlyRates"`
+	Occupancy             []LineItemDTO    `json:"occupancy"`
+	RatePlan              *RatePlanDTO     `json:"ratePlan,omitempty"`
+	Subtotal              MoneyDTO         `json:"subtotal"`
+	CancellationSurcharge SurchargeDTO     `json:"cancellationSurcharge"`
+	Discounts             []LineItemDTO    `json:"discounts"`
+	Taxes                 []LineItemDTO    `json:"taxes"`
+	Fees                  []LineItemDTO    `json:"fees"`
+	Total                 MoneyDTO         `json:"total"`
+	Conversion            *ConversionDTO   `json:"conversion,omitempty"`
+	Promotion             *PromoCodeDTO    `json:"promotion,omitempty"`
+	SignedQuote           *SignedQuoteDTO  `json:"signedQuote,omitempty"`
+}
+
+// MoneyDTO is an exact amount, Amount has every decimal of the currency, e.g. 1234.50 THB
+type MoneyDTO struct {
+	Amount   json.Number `json:"amount" swaggertype:"number" example:"1234.50"`
+	Currency string      `json:"currency" example:"THB"`
+}
+
+// NightlyRateDTO is one night, Source is rate_calendar or base_price
+type NightlyRateDTO struct {
+	Date     string           `json:"date"`
+	Source   string           `json:"source"`
+	BaseRate MoneyDTO         `json:"baseRate"`
+	Rate     MoneyDTO         `json:"rate"`
+	Rules    []AppliedRuleDTO `json:"rules,omitempty"`
+}
+
+// RatePlanDTO is the rate plan the stay is priced on, Multiplier is already part of the nightly
+// rates
+type RatePlanDTO struct {
+	RatePlanID string   `json:"ratePlanID"`
+	Code       string   `json:"code" example:"MEMBER"`
+	Name       string   `json:"name"`
+	Multiplier float64  `json:"multiplier" example:"0.9"`
+	Inclusions []string `json:"inclusions,omitempty"`
+}
+
+// ConversionDTO is the total in the requested currency and the exchange rate it was converted at
+type ConversionDTO struct {
+	Rate  ExchangeRateDTO `json:"rate"`
+	Total MoneyDTO        `json:"total"`
+}
+
+type ExchangeRateDTO struct {
+	From          string  `json:"from"`
+	To            string  `json:"to"`
+	EffectiveDate string  `json:"effectiveDate"`
+	Rate          float64 `json:"rate"`
+}
+
+type AppliedRuleDTO struct {
+	RuleID     string  `json:"ruleID,omitempty"`
+	Name       string  `json:"name"`
+	Multiplier float64 `json:"multiplier"`
+}
+
+type SurchargeDTO struct {
+	PolicyID string   `json:"policyID"`
+	Percent  float64  `json:"percent"`
+	Amount   MoneyDTO `json:"amount"`
+}
+
+// LineItemDTO is an occupancy charge, discount, tax or fee, an inclusive one is already part of the nightly rates.
+// PromotionID or StayRuleID is set on discounts of promotions and length of stay rules.
+type LineItemDTO struct {
+	Name        string   `json:"name"`
+	Amount      MoneyDTO `json:"amount"`
+	Inclusive   bool     `json:"inclusive,omitempty"`
+	PromotionID string   `json:"promotionID,omitempty"`
+	StayRuleID  string   `json:"stayRuleID,omitempty"`
+}
+
+// PromoCodeDTO tells whether the promo code of the request was applied and, when it was not, why
+type PromoCodeDTO struct {
+	Code    string `json:"code"`
+	Applied bool   `json:"applied"`
+	Reason  string `json:"reason,omitempty" example:"min_nights"`
+	Message string `json:"message,omitempty" example:"the code applies to stays of at least 3 nights"`
+}
+
+// BatchPricingResponse has a result for every item of the request, in the same order
+type BatchPricingResponse struct {
+	Results []BatchResultDTO `json:"results"`
+}
+
+// BatchResultDTO holds either the quote of an item or why it could not be priced
+type BatchResultDTO struct {
+	Index int                       `json:"index"`
+	Quote *CalculatePricingResponse `json:"quote,omitempty"`
+	Error *BatchErrorDTO            `json:"error,omitempty"`
+}
+
+// BatchErrorDTO is why an item could not be priced, Status is the HTTP status it stands for
+type BatchErrorDTO struct {
+	Status     int      `json:"status" example:"422"`
+	Message    string   `json:"message" example:"Unprocessable entity"`
+	Errors     []string `json:"errors,omitempty"`
+	Reason     string   `json:"reason,omitempty"`
+	RuleID     string   `json:"ruleID,omitempty"`
+	RatePlanID string   `json:"ratePlanID,omitempty"`
+}
+
+// SignedQuoteDTO is what the signature vouches for, it is verified by POST /price/verify
+type SignedQuoteDTO struct {
+	QuoteID    string     `json:"quoteID"`
+	HotelID    string     `json:"hotelID"`
+	RoomID     string     `json:"roomID"`
+	RatePlanID string     `json:"ratePlanID,omitempty"`
+	CheckIn    string     `json:"checkIn"`
+	CheckOut   string     `json:"checkOut"`
+	Adults     int        `json:"adults"`
+	Children   []ChildDTO `json:"children"`
+	Total      MoneyDTO   `json:"total"`
+	ExpiresAt  time.Time  `json:"expiresAt"`
+	Signature  string     `json:"signature"`
+}
+
+// VerifyQuoteResponse tells whether a signed quote can be honoured, Reason is invalid_signature or
+// expired when it cannot
+type VerifyQuoteResponse struct {
+	Valid     bool      `json:"valid"`
+	Reason    string    `json:"reason,omitempty"`
+	QuoteID   string    `json:"quoteID"`
+	Total     MoneyDTO  `json:"total"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+// SimulatePricesResponse is the room by night grid of current and simulated prices, nothing of
+// the draft is stored
+type SimulatePricesResponse struct {
+	HotelID string             `json:"hotelID"`
+	From    string             `json:"from"`
+	To      string             `json:"to"`
+	Rooms   []SimulatedRoomDTO `json:"rooms"`
+}
+
+// SimulatedRoomDTO is a row of the grid, the totals sum the nights of the room
+type SimulatedRoomDTO struct {
+	RoomID         string              `json:"roomID"`
+	Name           string              `json:"name"`
+	Type           string              `json:"type"`
+	Nights         []SimulatedNightDTO `json:"nights"`
+	CurrentTotal   MoneyDTO            `json:"currentTotal"`
+	SimulatedTotal MoneyDTO            `json:"simulatedTotal"`
+	Change         MoneyDTO            `json:"change"`
+}
+
+// SimulatedNightDTO is a cell of the grid, Change is the simulated minus the current price
+type SimulatedNightDTO struct {
+	Date          string            `json:"date"`
+	Current       SimulatedPriceDTO `json:"current"`
+	Simulated     SimulatedPriceDTO `json:"simulated"`
+	Change        MoneyDTO          `json:"change"`
+	ChangePercent float64           `json:"changePercent" example:"15"`
+}
+
+// SimulatedPriceDTO is the price of a night, Source is rate_calendar or base_price and Rules the
+// pricing rules applied, a draft rule without a RuleID is new
+type SimulatedPriceDTO struct {
+	Source string           `json:"source"`
+	Rate   MoneyDTO         `json:"rate"`
+	Rules  []AppliedRuleDTO `json:"rules,omitempty"`
 }
@@ -0,0 +1,16 @@
+package pricingruledto
+
+type PricingRuleDTO struct {
+	RuleID     string   `json:"ruleID"`
+	HotelID    string   `json:"hotelID"`
+	Scope      string   `json:"scope"`
+	RoomType   string   `json:"roomType,omitempty"`
+	RoomID     string   `json:"roomID,omitempty"`
+	Name       string   `json:"name"`
+	DateFrom   string   `json:"dateFrom,omitempty"`
+	DateUntil  string   `json:"dateUntil,omitempty"`
+	Weekdays   []string `json:"weekdays,omitempty"`
+	Multiplier float64  `json:"multiplier"`
+	Priority   int      `json:"priority"`
+	Stackable  bool     `json:"stackable"`
+}
@@ -0,0 +1,39 @@
+package pricingruledto
+
+type InquiryPricingRulesRequest struct {
+	HotelID string `param:"hotelID" validate:"required,uuid4"`
+}
+
+// CreatePricingRuleRequest narrows a rule with either RoomType or RoomID, dates are YYYY-MM-DD
+// and DateUntil is exclusive
+type CreatePricingRuleRequest struct {
+	HotelID    string   `param:"hotelID" json:"-" validate:"required,uuid4"`
+	RoomType   string   `json:"roomType" validate:"omitempty,room_type,excluded_with=RoomID"`
+	RoomID     string   `json:"roomID" validate:"omitempty,uuid4"`
+	Name       string   `json:"name" validate:"required,max=255"`
+	DateFrom   string   `json:"dateFrom" validate:"omitempty,datetime=2006-01-02"`
+	DateUntil  string   `json:"dateUntil" validate:"omitempty,datetime=2006-01-02,date_after=DateFrom"`
+	Weekdays   []string `json:"weekdays" validate:"max=7,unique,dive,oneof=MON TUE WED THU FRI SAT SUN"`
+	Multiplier float64  `json:"multiplier" validate:"required,gt=0,lte=10"`
+	Priority   int      `json:"priority" validate:"min=0,max=1000"`
+	Stackable  bool     `json:"stackable"`
+}
+
+type UpdatePricingRuleRequest struct {
+	HotelID    string   `param:"hotelID" json:"-" validate:"required,uuid4"`
+	RuleID     string   `param:"ruleID" json:"-" validate:"required,uuid4"`
+	RoomType   string   `json:"roomType" validate:"omitempty,room_type,excluded_with=RoomID"`
+	RoomID     string   `json:"roomID" validate:"omitempty,uuid4"`
+	Name       string   `json:"name" validate:"required,max=255"`
+	DateFrom   string   `json:"dateFrom" validate:"omitempty,datetime=2006-01-02"`
+	DateUntil  string   `json:"dateUntil" validate:"omitempty,datetime=2006-01-02,date_after=DateFrom"`
+	Weekdays   []string `json:"weekdays" validate:"max=7,unique,dive,oneof=MON TUE WED THU FRI SAT SUN"`
+	Multiplier float64  `json:"multiplier" validate:"required,gt=0,lte=10"`
+	Priority   int      `json:"priority" validate:"min=0,max=1000"`
+	Stackable  bool     `json:"stackable"`
+}
+
+type DeactivatePricingRuleRequest struct {
+	HotelID string `param:"hotelID" validate:"required,uuid4"`
+	RuleID  string `param:"ruleID" validate:"required,uuid4"`
+}
@@ -0,0 +1,9 @@
+package pricingruledto
+
+type InquiryPricingRulesResponse struct {
+	Rules []PricingRuleDTO `json:"rules"`
+}
+
+type PricingRuleResponse struct {
+	Rule PricingRuleDTO `json:"rule"`
+}
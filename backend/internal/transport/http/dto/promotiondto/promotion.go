@@ -0,0 +1,28 @@
+package promotiondto
+
+import (
+	"encoding/json"
+	"time"
+)
+
+type PromotionDTO struct {
+	PromotionID          string      `json:"promotionID"`
+	Code                 string      `json:"code,omitempty" example:"EARLYBIRD"`
+	Name                 string      `json:"name"`
+	Calculation          string      `json:"calculation"`
+	Percent              float64     `json:"percent,omitempty"`
+	Amount               json.Number `json:"amount,omitempty" swaggertype:"number" example:"500.00"`
+	Currency             string      `json:"currency,omitempty"`
+	ActiveFrom           *time.Time  `json:"activeFrom,omitempty"`
+	ActiveUntil          *time.Time  `json:"activeUntil,omitempty"`
+	StayFrom             string      `json:"stayFrom,omitempty"`
+	StayUntil            string      `json:"stayUntil,omitempty"`
+	MinNights            int         `json:"minNights"`
+	MinDaysBeforeArrival int         `json:"minDaysBeforeArrival"`
+	MaxDaysBeforeArrival *int        `json:"maxDaysBeforeArrival,omitempty"`
+	HotelIDs             []string    `json:"hotelIDs,omitempty"`
+	RoomIDs              []string    `json:"roomIDs,omitempty"`
+	MaxUses              int         `json:"maxUses"`
+	Uses                 int         `json:"uses"`
+	Stackable            bool        `json:"stackable"`
+}
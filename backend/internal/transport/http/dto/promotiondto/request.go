@@ -0,0 +1,60 @@
+package promotiondto
+
+import "time"
+
+type InquiryPromotionRequest struct {
+	PromotionID string `param:"promotionID" validate:"required,uuid4"`
+}
+
+// CreatePromotionRequest sets Percent for a percentage, or Amount and Currency for a fixed amount
+// per stay. Without a Code the promotion is a campaign every eligible quote gets. StayUntil is
+// exclusive, empty HotelIDs and RoomIDs make every hotel and room eligible and a MaxUses of 0
+// leaves the redemptions unlimited.
+type CreatePromotionRequest struct {
+	Code                 string     `json:"code" validate:"omitempty,promo_code"`
+	Name                 string     `json:"name" validate:"required,max=255"`
+	Calculation          string     `json:"calculation" validate:"required,oneof=percent fixed"`
+	Percent              float64    `json:"percent" validate:"required_if=Calculation percent,gte=0,lte=100"`
+	Amount               float64    `json:"amount" validate:"required_if=Calculation fixed,gte=0,money=Currency"`
+	Currency             string     `json:"currency" validate:"required_if=Calculation fixed,omitempty,currency"`
+	ActiveFrom           *time.Time `json:"activeFrom"`
+	ActiveUntil          *time.Time `json:"activeUntil" validate:"omitempty,active_until"`
+	StayFrom             string     `json:"stayFrom" validate:"omitempty,datetime=2006-01-02"`
+	StayUntil            string     `json:"stayUntil" validate:"omitempty,datetime=2006-01-02,date_after=StayFrom"`
+	MinNights            int        `json:"minNights" validate:"min=0,max=365"`
+	MinDaysBeforeArrival int        `json:"minDaysBeforeArrival" validate:"min=0,max=730"`
+	MaxDaysBeforeArrival *int       `json:"maxDaysBeforeArrival" validate:"omitempty,min=0,max=730"`
+	HotelIDs             []string   `json:"hotelIDs" validate:"max=100,unique,dive,uuid4"`
+	RoomIDs              []string   `json:"roomIDs" validate:"max=100,unique,dive,uuid4"`
+	MaxUses              int        `json:"maxUses" validate:"min=0"`
+	Stackable            bool       `json:"stackable"`
+}
+
+type UpdatePromotionRequest struct {
+	PromotionID          string     `param:"promotionID" json:"-" validate:"required,uuid4"`
+	Code                 string     `json:"code" validate:"omitempty,promo_code"`
+	Name                 string     `json:"name" validate:"required,max=255"`
+	Calculation          string     `json:"calculation" validate:"required,oneof=percent fixed"`
+	Percent              float64    `json:"percent" validate:"required_if=Calculation percent,gte=0,lte=100"`
+	Amount               float64    `json:"amount" validate:"required_if=Calculation fixed,gte=0,money=Currency"`
+	Currency             string     `json:"currency" validate:"required_if=Calculation fixed,omitempty,currency"`
+	ActiveFrom           *time.Time `json:"activeFrom"`
+	ActiveUntil          *time.Time `json:"activeUntil" validate:"omitempty,active_until"`
+	StayFrom             string     `json:"stayFrom" validate:"omitempty,datetime=2006-01-02"`
+	StayUntil            string     `json:"stayUntil" validate:"omitempty,datetime=2006-01-02,date_after=StayFrom"`
+	MinNights            int        `json:"minNights" validate:"min=0,max=365"`
+	MinDaysBeforeArrival int        `json:"minDaysBeforeArrival" validate:"min=0,max=730"`
+	MaxDaysBeforeArrival *int       `json:"maxDaysBeforeArrival" validate:"omitempty,min=0,max=730"`
+	HotelIDs             []string   `json:"hotelIDs" validate:"max=100,unique,dive,uuid4"`
+	RoomIDs              []string   `json:"roomIDs" validate:"max=100,unique,dive,uuid4"`
+	MaxUses              int        `json:"maxUses" validate:"min=0"`
+	Stackable            bool       `json:"stackable"`
+}
+
+type DeactivatePromotionRequest struct {
+	PromotionID string `param:"promotionID" validate:"required,uuid4"`
+}
+
+type RedeemPromotionRequest struct {
+	PromotionID string `param:"promotionID" validate:"required,uuid4"`
+}
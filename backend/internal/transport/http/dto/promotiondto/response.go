@@ -0,0 +1,9 @@
+package promotiondto
+
+type InquiryPromotionsResponse struct {
+	Promotions []PromotionDTO `json:"promotions"`
+}
+
+type PromotionResponse struct {
+	Promotion PromotionDTO `json:"promotion"`
+}
@@ -0,0 +1,19 @@
+package rateplandto
+
+type RatePlanDTO struct {
+	RatePlanID           string   `json:"ratePlanID"`
+	HotelID              string   `json:"hotelID"`
+	Code                 string   `json:"code" example:"MEMBER"`
+	Name                 string   `json:"name"`
+	Description          string   `json:"description"`
+	Kind                 string   `json:"kind" example:"member"`
+	Multiplier           float64  `json:"multiplier" example:"0.9"`
+	CancellationPolicy   string   `json:"cancellationPolicy,omitempty"`
+	Inclusions           []string `json:"inclusions,omitempty"`
+	RoomIDs              []string `json:"roomIDs,omitempty"`
+	RoomTypes            []string `json:"roomTypes,omitempty"`
+	MembersOnly          bool     `json:"membersOnly"`
+	AccessCodeRequired   bool     `json:"accessCodeRequired"`
+	MinDaysBeforeArrival int      `json:"minDaysBeforeArrival"`
+	MinNights            int      `json:"minNights"`
+}
@@ -0,0 +1,48 @@
+package rateplandto
+
+type InquiryRatePlansRequest struct {
+	HotelID string `param:"hotelID" validate:"required,uuid4"`
+}
+
+// CreateRatePlanRequest is a rate plan of the hotel. Multiplier is applied to the nightly rates,
+// e.g. 0.9 for 10% off. Without a CancellationPolicy the room's applies, without RoomIDs and
+// RoomTypes the plan is sold on every room of the hotel.
+type CreateRatePlanRequest struct {
+	HotelID              string   `param:"hotelID" json:"-" validate:"required,uuid4"`
+	Code                 string   `json:"code" validate:"required,promo_code"`
+	Name                 string   `json:"name" validate:"required,max=255"`
+	Description          string   `json:"description" validate:"max=1000"`
+	Kind                 string   `json:"kind" validate:"required,oneof=bar member corporate advance_purchase"`
+	Multiplier           float64  `json:"multiplier" validate:"required,gt=0,lte=10"`
+	CancellationPolicy   string   `json:"cancellationPolicy" validate:"max=64"`
+	Inclusions           []string `json:"inclusions" validate:"max=20,dive,required,max=100"`
+	RoomIDs              []string `json:"roomIDs" validate:"max=100,unique,dive,uuid4"`
+	RoomTypes            []string `json:"roomTypes" validate:"max=10,unique,dive,room_type"`
+	MembersOnly          bool     `json:"membersOnly"`
+	AccessCode           string   `json:"accessCode" validate:"omitempty,promo_code"`
+	MinDaysBeforeArrival int      `json:"minDaysBeforeArrival" validate:"min=0,max=730"`
+	MinNights            int      `json:"minNights" validate:"min=0,max=365"`
+}
+
+type UpdateRatePlanRequest struct {
+	HotelID              string   `param:"hotelID" json:"-" validate:"required,uuid4"`
+	RatePlanID           string   `param:"ratePlanID" json:"-" validate:"required,uuid4"`
+	Code                 string   `json:"code" validate:"required,promo_code"`
+	Name                 string   `json:"name" validate:"required,max=255"`
+	Description          string   `json:"description" validate:"max=1000"`
+	Kind                 string   `json:"kind" validate:"required,oneof=bar member corporate advance_purchase"`
+	Multiplier           float64  `json:"multiplier" validate:"required,gt=0,lte=10"`
+	CancellationPolicy   string   `json:"cancellationPolicy" validate:"max=64"`
+	Inclusions           []string `json:"inclusions" validate:"max=20,dive,required,max=100"`
+	RoomIDs              []string `json:"roomIDs" validate:"max=100,unique,dive,uuid4"`
+	RoomTypes            []string `json:"roomTypes" validate:"max=10,unique,dive,room_type"`
+	MembersOnly          bool     `json:"membersOnly"`
+	AccessCode           string   `json:"accessCode" validate:"omitempty,promo_code"`
+	MinDaysBeforeArrival int      `json:"minDaysBeforeArrival" validate:"min=0,max=730"`
+	MinNights            int      `json:"minNights" validate:"min=0,max=365"`
+}
+
+type DeactivateRatePlanRequest struct {
+	HotelID    string `param:"hotelID" validate:"required,uuid4"`
+	RatePlanID string `param:"ratePlanID" validate:"required,uuid4"`
+}
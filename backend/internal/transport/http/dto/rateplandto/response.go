@@ -0,0 +1,9 @@
+package rateplandto
+
+type InquiryRatePlansResponse struct {
+	RatePlans []RatePlanDTO `json:"ratePlans"`
+}
+
+type RatePlanResponse struct {
+	RatePlan RatePlanDTO `json:"ratePlan"`
+}
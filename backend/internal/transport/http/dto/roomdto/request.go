@@ -1,5 +1,7 @@
 package roomdto
 
+import "time"
+
 type InquiryRoomsRequest struct {
 	HotelID string `param:"hotelID" validate:"required,uuid4"`
 }
@@ -8,3 +10,68 @@ type InquiryRoomRequest struct {
 	HotelID string `param:"hotelID" validate:"required,uuid4"`
 	RoomID  string `param:"roomID" validate:"required,uuid4"`
 }
+
+// CreateRoomRequest leaves BaseOccupancy and MaxOccupancy out for the defaults of the room type.
+// Charges for guests beyond the base occupancy are per night in Currency.
+type CreateRoomRequest struct {
+	HotelID            string               `param:"hotelID" json:"-" validate:"required,uuid4"`
+	PhysicalRoomID     string               `json:"physicalRoomID" validate:"required,uuid4"`
+	Name               string               `json:"name" validate:"required,max=255"`
+	Description        string               `json:"description" validate:"max=1000"`
+	Type               string               `json:"type" validate:"required,room_type"`
+	BasePrice          float64              `json:"basePrice" validate:"required,gt=0,money=Currency"`
+	Currency           string               `json:"currency" validate:"required,currency"`
+	BaseOccupancy      int                  `json:"baseOccupancy" validate:"omitempty,min=1,max=20"`
+	MaxOccupancy       int                  `json:"maxOccupancy" validate:"omitempty,min=1,max=20,gtefield=BaseOccupancy"`
+	ExtraAdultCharge   float64              `json:"extraAdultCharge" validate:"gte=0,money=Currency"`
+	ChildCharges       []ChildChargeRequest `json:"childCharges" validate:"max=5,unique=MaxAge,money=Currency Amount,dive"`
+	CancellationPolicy string               `json:"cancellationPolicy" validate:"required,max=64"`
+	ActiveFrom         *time.Time           `json:"activeFrom"`
+	ActiveUntil        *time.Time           `json:"activeUntil" validate:"omitempty,active_until"`
+}
+
+type UpdateRoomRequest struct {
+	HotelID            string               `param:"hotelID" json:"-" validate:"required,uuid4"`
+	RoomID             string               `param:"roomID" json:"-" validate:"required,uuid4"`
+	Name               string               `json:"name" validate:"required,max=255"`
+	Description        string               `json:"description" validate:"max=1000"`
+	Type               string               `json:"type" validate:"required,room_type"`
+	BasePrice          float64              `json:"basePrice" validate:"required,gt=0,money=Currency"`
+	Currency           string               `json:"currency" validate:"required,currency"`
+	BaseOccupancy      int                  `json:"baseOccupancy" validate:"omitempty,min=1,max=20"`
+	MaxOccupancy       int                  `json:"maxOccupancy" validate:"omitempty,min=1,max=20,gtefield=BaseOccupancy"`
+	ExtraAdultCharge   float64              `json:"extraAdultCharge" validate:"gte=0,money=Currency"`
+	ChildCharges       []ChildChargeRequest `json:"childCharges" validate:"max=5,unique=MaxAge,money=Currency Amount,dive"`
+	CancellationPolicy string               `json:"cancellationPolicy" validate:"required,max=64"`
+	ActiveFrom         *time.Time           `json:"activeFrom"`
+	ActiveUntil        *time.Time           `json:"activeUntil" validate:"omitempty,active_until"`
+}
+
+// PatchRoomRequest can set an activation window but not clear it, merge patches clear with null
+type PatchRoomRequest struct {
+	HotelID            string               `param:"hotelID" json:"-" validate:"required,uuid4"`
+	RoomID             string               `param:"roomID" json:"-" validate:"required,uuid4"`
+	Name               *string              `json:"name" validate:"omitempty,min=1,max=255"`
+	Description        *string              `json:"description" validate:"omitempty,max=1000"`
+	Type               *string              `json:"type" validate:"omitempty,room_type"`
+	BasePrice          *float64             `json:"basePrice" validate:"omitempty,gt=0"`
+	Currency           *string              `json:"currency" validate:"omitempty,currency"`
+	BaseOccupancy      *int                 `json:"baseOccupancy" validate:"omitempty,min=1,max=20"`
+	MaxOccupancy       *int                 `json:"maxOccupancy" validate:"omitempty,min=1,max=20"`
+	ExtraAdultCharge   *float64             `json:"extraAdultCharge" validate:"omitempty,gte=0"`
+	ChildCharges       []ChildChargeRequest `json:"childCharges" validate:"omitempty,max=5,unique=MaxAge,dive"`
+	CancellationPolicy *string              `json:"cancellationPolicy" validate:"omitempty,min=1,max=64"`
+	ActiveFrom         *time.Time           `json:"activeFrom"`
+	ActiveUntil        *time.Time           `json:"activeUntil"`
+}
+
+// ChildChargeRequest charges Amount a night for a child up to MaxAge years old
+type ChildChargeRequest struct {
+	MaxAge int     `json:"maxAge" validate:"min=0,max=17"`
+	Amount float64 `json:"amount" validate:"gte=0"`
+}
+
+type DeleteRoomRequest struct {
+	HotelID string `param:"hotelID" validate:"required,uuid4"`
+	RoomID  string `param:"roomID" validate:"required,uuid4"`
+}
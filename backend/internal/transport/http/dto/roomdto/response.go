@@ -7,3 +7,11 @@ type InquiryRoomsResponse struct {
 type InquiryRoomResponse struct {
 	Room RoomDTO `json:"room"`
 }
+
+type CreateRoomResponse struct {
+	Room RoomDTO `json:"room"`
+}
+
+type UpdateRoomResponse struct {
+	Room RoomDTO `json:"room"`
+}
@@ -1,19 +1,57 @@
 package roomdto
 
+import (
+	"encoding/json"
+	"time"
+)
+
 type RoomDTO struct {
-	RoomID             string       `json:"roomID"`
-	HotelID            string       `json:"hotelID"`
-	Name               string       `json:"name"`
-	Description        string       `json:"description"`
-	Type               string       `json:"type"`
-	BasePrice          float64      `json:"basePrice"`
-	Currency           string       `json:"currency"`
-	Benefit            []BenefitDTO `json:"benefit"`
-	CancellationPolicy string       `json:"cancellationPolicy"`
+	RoomID             string           `json:"roomID"`
+	PhysicalRoomID     string           `json:"physicalRoomID"`
+	HotelID            string           `json:"hotelID"`
+	Name               string           `json:"name"`
+	Description        string           `json:"description"`
+	Type               string           `json:"type"`
+	BasePrice          json.Number      `json:"basePrice" swaggertype:"number" example:"1234.50"`
+	Currency           string           `json:"currency"`
+	BaseOccupancy      int              `json:"baseOccupancy"`
+	MaxOccupancy       int              `json:"maxOccupancy"`
+	ExtraAdultCharge   json.Number      `json:"extraAdultCharge" swaggertype:"number" example:"800.00"`
+	ChildCharges       []ChildChargeDTO `json:"childCharges"`
+	Benefit            []BenefitDTO     `json:"benefit"`
+	CancellationPolicy string           `json:"cancellationPolicy"`
+	ActiveFrom         *time.Time       `json:"activeFrom,omitempty"`
+	ActiveUntil        *time.Time       `json:"activeUntil,omitempty"`
+	RatePlans          []RatePlanDTO    `json:"ratePlans,omitempty"`
+}
+
+// RatePlanDTO is a rate plan the room is sold on, BasePrice is the room's base price on the plan.
+// The access code of a corporate plan is not shown, only that one is needed.
+type RatePlanDTO struct {
+	RatePlanID           string      `json:"ratePlanID"`
+	Code                 string      `json:"code" example:"MEMBER"`
+	Name                 string      `json:"name"`
+	Description          string      `json:"description"`
+	Kind                 string      `json:"kind" example:"member"`
+	Multiplier           float64     `json:"multiplier" example:"0.9"`
+	BasePrice            json.Number `json:"basePrice" swaggertype:"number" example:"1111.05"`
+	CancellationPolicy   string      `json:"cancellationPolicy"`
+	Inclusions           []string    `json:"inclusions,omitempty"`
+	MembersOnly          bool        `json:"membersOnly"`
+	AccessCodeRequired   bool        `json:"accessCodeRequired"`
+	MinDaysBeforeArrival int         `json:"minDaysBeforeArrival"`
+	MinNights            int         `json:"minNights"`
+}
+
+type ChildChargeDTO struct {
+	MaxAge int         `json:"maxAge"`
+	Amount json.Number `json:"amount" swaggertype:"number" example:"400.00"`
 }
 
 type BenefitDTO struct {
-	BenefitID      string `json:"benefitID"`
-	PhysicalRoomID string `json:"physicalRoomID"`
-	Description    string `json:"description"`
+	BenefitID      string     `json:"benefitID"`
+	PhysicalRoomID string     `json:"physicalRoomID"`
+	Description    string     `json:"description"`
+	ActiveFrom     *time.Time `json:"activeFrom,omitempty"`
+	ActiveUntil    *time.Time `json:"activeUntil,omitempty"`
 }
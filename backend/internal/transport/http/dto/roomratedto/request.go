@@ -0,0 +1,27 @@
+package roomratedto
+
+type InquiryRoomRatesRequest struct {
+	HotelID string `param:"hotelID" validate:"required,uuid4"`
+	RoomID  string `param:"roomID" validate:"required,uuid4"`
+	From    string `query:"from" validate:"omitempty,datetime=2006-01-02"`
+	To      string `query:"to" validate:"omitempty,datetime=2006-01-02,date_after=From"`
+}
+
+// SetRoomRatesRequest prices nights in Currency, which has to be the currency of the room
+type SetRoomRatesRequest struct {
+	HotelID  string            `param:"hotelID" json:"-" validate:"required,uuid4"`
+	RoomID   string            `param:"roomID" json:"-" validate:"required,uuid4"`
+	Currency string            `json:"currency" validate:"required,currency"`
+	Rates    []RoomRateRequest `json:"rates" validate:"required,min=1,max=366,unique=Date,money=Currency Price,dive"`
+}
+
+type RoomRateRequest struct {
+	Date  string  `json:"date" validate:"required,datetime=2006-01-02"`
+	Price float64 `json:"price" validate:"required,gt=0"`
+}
+
+type DeleteRoomRateRequest struct {
+	HotelID string `param:"hotelID" validate:"required,uuid4"`
+	RoomID  string `param:"roomID" validate:"required,uuid4"`
+	Date    string `param:"date" validate:"required,datetime=2006-01-02"`
+}
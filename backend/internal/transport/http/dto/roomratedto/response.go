@@ -0,0 +1,5 @@
+package roomratedto
+
+type RoomRatesResponse struct {
+	Rates []RoomRateDTO `json:"rates"`
+}
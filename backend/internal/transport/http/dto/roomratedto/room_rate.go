@@ -0,0 +1,9 @@
+package roomratedto
+
+import "encoding/json"
+
+type RoomRateDTO struct {
+	Date     string      `json:"date"`
+	Price    json.Number `json:"price" swaggertype:"number" example:"1234.50"`
+	Currency string      `json:"currency"`
+}
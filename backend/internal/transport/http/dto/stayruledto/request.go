@@ -0,0 +1,38 @@
+package stayruledto
+
+type InquiryStayRulesRequest struct {
+	HotelID string `param:"hotelID" validate:"required,uuid4"`
+}
+
+// CreateStayRuleRequest is a restriction with MinNights and an optional MaxNights, or a discount
+// tier with MinNights and Percent. DateFrom and DateUntil bound the arrival dates, YYYY-MM-DD and
+// DateUntil exclusive.
+type CreateStayRuleRequest struct {
+	HotelID   string  `param:"hotelID" json:"-" validate:"required,uuid4"`
+	RoomID    string  `json:"roomID" validate:"omitempty,uuid4"`
+	Name      string  `json:"name" validate:"required,max=255"`
+	Kind      string  `json:"kind" validate:"required,oneof=restriction discount"`
+	DateFrom  string  `json:"dateFrom" validate:"omitempty,datetime=2006-01-02"`
+	DateUntil string  `json:"dateUntil" validate:"omitempty,datetime=2006-01-02,date_after=DateFrom"`
+	MinNights int     `json:"minNights" validate:"min=1,max=365"`
+	MaxNights int     `json:"maxNights" validate:"excluded_if=Kind discount,omitempty,gtefield=MinNights,max=365"`
+	Percent   float64 `json:"percent" validate:"required_if=Kind discount,excluded_if=Kind restriction,gte=0,lte=100"`
+}
+
+type UpdateStayRuleRequest struct {
+	HotelID   string  `param:"hotelID" json:"-" validate:"required,uuid4"`
+	RuleID    string  `param:"ruleID" json:"-" validate:"required,uuid4"`
+	RoomID    string  `json:"roomID" validate:"omitempty,uuid4"`
+	Name      string  `json:"name" validate:"required,max=255"`
+	Kind      string  `json:"kind" validate:"required,oneof=restriction discount"`
+	DateFrom  string  `json:"dateFrom" validate:"omitempty,datetime=2006-01-02"`
+	DateUntil string  `json:"dateUntil" validate:"omitempty,datetime=2006-01-02,date_after=DateFrom"`
+	MinNights int     `json:"minNights" validate:"min=1,max=365"`
+	MaxNights int     `json:"maxNights" validate:"excluded_if=Kind discount,omitempty,gtefield=MinNights,max=365"`
+	Percent   float64 `json:"percent" validate:"required_if=Kind discount,excluded_if=Kind restriction,gte=0,lte=100"`
+}
+
+type DeactivateStayRuleRequest struct {
+	HotelID string `param:"hotelID" validate:"required,uuid4"`
+	RuleID  string `param:"ruleID" validate:"required,uuid4"`
+}
@@ -0,0 +1,9 @@
+package stayruledto
+
+type InquiryStayRulesResponse struct {
+	Rules []StayRuleDTO `json:"rules"`
+}
+
+type StayRuleResponse struct {
+	Rule StayRuleDTO `json:"rule"`
+}
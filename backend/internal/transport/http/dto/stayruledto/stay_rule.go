@@ -0,0 +1,14 @@
+package stayruledto
+
+type StayRuleDTO struct {
+	RuleID    string  `json:"ruleID"`
+	HotelID   string  `json:"hotelID"`
+	RoomID    string  `json:"roomID,omitempty"`
+	Name      string  `json:"name"`
+	Kind      string  `json:"kind"`
+	DateFrom  string  `json:"dateFrom,omitempty"`
+	DateUntil string  `json:"dateUntil,omitempty"`
+	MinNights int     `json:"minNights"`
+	MaxNights int     `json:"maxNights,omitempty"`
+	Percent   float64 `json:"percent,omitempty"`
+}
@@ -0,0 +1,41 @@
+package taxfeedto
+
+type InquiryTaxesAndFeesRequest struct {
+	HotelID string `param:"hotelID" validate:"required,uuid4"`
+}
+
+// CreateTaxFeeRequest sets Percent for a percentage, or Amount, Basis and Currency for a fixed
+// amount
+type CreateTaxFeeRequest struct {
+	HotelID     string  `param:"hotelID" json:"-" validate:"required,uuid4"`
+	Name        string  `json:"name" validate:"required,max=255"`
+	Kind        string  `json:"kind" validate:"required,oneof=tax fee"`
+	Calculation string  `json:"calculation" validate:"required,oneof=percent fixed"`
+	Percent     float64 `json:"percent" validate:"required_if=Calculation percent,gte=0,lte=100"`
+	Amount      float64 `json:"amount" validate:"required_if=Calculation fixed,gte=0,money=Currency"`
+	Basis       string  `json:"basis" validate:"required_if=Calculation fixed,omitempty,oneof=per_night per_stay per_person"`
+	Currency    string  `json:"currency" validate:"required_if=Calculation fixed,omitempty,currency"`
+	Inclusive   bool    `json:"inclusive"`
+	Compound    bool    `json:"compound"`
+	Sequence    int     `json:"sequence" validate:"min=0,max=100"`
+}
+
+type UpdateTaxFeeRequest struct {
+	HotelID     string  `param:"hotelID" json:"-" validate:"required,uuid4"`
+	TaxFeeID    string  `param:"taxFeeID" json:"-" validate:"required,uuid4"`
+	Name        string  `json:"name" validate:"required,max=255"`
+	Kind        string  `json:"kind" validate:"required,oneof=tax fee"`
+	Calculation string  `json:"calculation" validate:"required,oneof=percent fixed"`
+	Percent     float64 `json:"percent" validate:"required_if=Calculation percent,gte=0,lte=100"`
+	Amount      float64 `json:"amount" validate:"required_if=Calculation fixed,gte=0,money=Currency"`
+	Basis       string  `json:"basis" validate:"required_if=Calculation fixed,omitempty,oneof=per_night per_stay per_person"`
+	Currency    string  `json:"currency" validate:"required_if=Calculation fixed,omitempty,currency"`
+	Inclusive   bool    `json:"inclusive"`
+	Compound    bool    `json:"compound"`
+	Sequence    int     `json:"sequence" validate:"min=0,max=100"`
+}
+
+type DeactivateTaxFeeRequest struct {
+	HotelID  string `param:"hotelID" validate:"required,uuid4"`
+	TaxFeeID string `param:"taxFeeID" validate:"required,uuid4"`
+}
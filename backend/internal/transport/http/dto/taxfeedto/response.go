@@ -0,0 +1,9 @@
+package taxfeedto
+
+type InquiryTaxesAndFeesResponse struct {
+	TaxesAndFees []TaxFeeDTO `json:"taxesAndFees"`
+}
+
+type TaxFeeResponse struct {
+	TaxFee TaxFeeDTO `json:"taxFee"`
+}
@@ -0,0 +1,18 @@
+package taxfeedto
+
+import "encoding/json"
+
+type TaxFeeDTO struct {
+	TaxFeeID    string      `json:"taxFeeID"`
+	HotelID     string      `json:"hotelID"`
+	Name        string      `json:"name"`
+	Kind        string      `json:"kind"`
+	Calculation string      `json:"calculation"`
+	Percent     float64     `json:"percent,omitempty"`
+	Amount      json.Number `json:"amount,omitempty" swaggertype:"number" example:"50.00"`
+	Basis       string      `json:"basis,omitempty"`
+	Currency    string      `json:"currency,omitempty"`
+	Inclusive   bool        `json:"inclusive"`
+	Compound    bool        `json:"compound"`
+	Sequence    int         `json:"sequence"`
+}
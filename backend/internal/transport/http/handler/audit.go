@@ -0,0 +1,70 @@
+package handler
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/chayutK/hotel-property-service/internal/service"
+	"github.com/chayutK/hotel-property-service/internal/transport/http/dto/auditdto"
+	"github.com/chayutK/hotel-property-service/internal/transport/http/dto/mapperdto"
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+)
+
+type AuditHandler struct {
+	auditService *service.AuditService
+	validate     *validator.Validate
+}
+
+func NewAuditHandler(auditService *service.AuditService, validate *validator.Validate) *AuditHandler {
+	return &AuditHandler{
+		auditService: auditService,
+		validate:     validate,
+	}
+}
+
+func (h *AuditHandler) RegisterRoutes(g *echo.Group) {
+	g.GET("/admin/audit", h.GetAuditLog)
+}
+
+// GetAuditLog godoc
+// @Summary Query catalog audit log
+// @Description List recorded catalog changes, newest first, with who made them and a field-level before/after diff
+// @Tags admin
+// @Produce json
+// @Param entity query string false "Entity" Enums(facility_catalog, hotel, facility, physical_room, room, benefit)
+// @Param id query string false "Entity ID, the code for facility catalog entries"
+// @Param actor query string false "Actor"
+// @Param field query string false "Only changes of this field, e.g. basePrice"
+// @Param limit query int false "Maximum number of entries, 100 by default"
+// @Success 200 {object} auditdto.InquiryAuditResponse
+// @Router /admin/audit [get]
+func (h *AuditHandler) GetAuditLog(c echo.Context) error {
+	var (
+		req  auditdto.InquiryAuditRequest
+		resp auditdto.InquiryAuditResponse
+	)
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), 5*time.Second)
+	defer cancel()
+
+	if err := c.Bind(&req); err != nil {
+		slog.Error("[HANDLER]", "message", "error binding request", "error", err.Error())
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Bad request"})
+	}
+
+	if err := h.validate.Struct(&req); err != nil {
+		slog.Error("[HANDLER]", "message", "error validating request", "error", err.Error())
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Bad request"})
+	}
+
+	entries, err := h.auditService.GetAuditLog(ctx, mapperdto.InquiryAuditRequestToDomain(&req))
+	if err != nil {
+		return err
+	}
+
+	resp.Entries = mapperdto.ToAuditEntriesDTO(entries)
+	return c.JSON(200, &resp)
+}
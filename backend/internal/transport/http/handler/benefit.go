@@ -0,0 +1,195 @@
+package handler
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/chayutK/hotel-property-service/internal/domain"
+	"github.com/chayutK/hotel-property-service/internal/service"
+	"github.com/chayutK/hotel-property-service/internal/transport/http/dto/benefitdto"
+	"github.com/chayutK/hotel-property-service/internal/transport/http/dto/mapperdto"
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+)
+
+type BenefitHandler struct {
+	benefitService *service.BenefitService
+	validate       *validator.Validate
+}
+
+func NewBenefitHandler(benefitService *service.BenefitService, validate *validator.Validate) *BenefitHandler {
+	return &BenefitHandler{
+		benefitService: benefitService,
+		validate:       validate,
+	}
+}
+
+func (h *BenefitHandler) RegisterRoutes(g *echo.Group) {
+	g.GET("/hotels/:hotelID/physical-rooms/:physicalRoomID/benefits", h.GetBenefits)
+	g.POST("/hotels/:hotelID/physical-rooms/:physicalRoomID/benefits", h.CreateBenefit)
+	g.PUT("/hotels/:hotelID/physical-rooms/:physicalRoomID/benefits/:benefitID", h.UpdateBenefit)
+	g.DELETE("/hotels/:hotelID/physical-rooms/:physicalRoomID/benefits/:benefitID", h.RetireBenefit)
+}
+
+// GetBenefits godoc
+// @Summary List physical room benefits
+// @Description Get the benefits of a physical room and the room offers showing each of them
+// @Tags benefits
+// @Produce json
+// @Param hotelID path string true "Hotel ID"
+// @Param physicalRoomID path string true "Physical room ID"
+// @Success 200 {object} benefitdto.InquiryBenefitsResponse
+// @Router /hotels/{hotelID}/physical-rooms/{physicalRoomID}/benefits [get]
+func (h *BenefitHandler) GetBenefits(c echo.Context) error {
+	var (
+		req  benefitdto.InquiryBenefitsRequest
+		resp benefitdto.InquiryBenefitsResponse
+	)
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), 5*time.Second)
+	defer cancel()
+
+	if err := c.Bind(&req); err != nil {
+		slog.Error("[HANDLER]", "message", "error binding request", "error", err.Error())
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Bad request"})
+	}
+
+	if err := h.validate.Struct(&req); err != nil {
+		slog.Error("[HANDLER]", "message", "error validating request", "error", err.Error())
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Bad request"})
+	}
+
+	benefits, roomIDs, err := h.benefitService.GetBenefits(ctx, req.HotelID, req.PhysicalRoomID)
+	if err != nil {
+		return err
+	}
+
+	resp.Benefits = mapperdto.ToPhysicalRoomBenefitsDTO(benefits, roomIDs)
+	return c.JSON(200, &resp)
+}
+
+// CreateBenefit godoc
+// @Summary Add physical room benefit
+// @Description Add a benefit shown by every room offer sharing the physical room
+// @Tags benefits
+// @Accept json
+// @Produce json
+// @Param hotelID path string true "Hotel ID"
+// @Param physicalRoomID path string true "Physical room ID"
+// @Param request body benefitdto.CreateBenefitRequest true "Benefit"
+// @Success 201 {object} benefitdto.BenefitResponse
+// @Router /hotels/{hotelID}/physical-rooms/{physicalRoomID}/benefits [post]
+func (h *BenefitHandler) CreateBenefit(c echo.Context) error {
+	var (
+		req  benefitdto.CreateBenefitRequest
+		resp benefitdto.BenefitResponse
+	)
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), 5*time.Second)
+	defer cancel()
+
+	if err := c.Bind(&req); err != nil {
+		slog.Error("[HANDLER]", "message", "error binding request", "error", err.Error())
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Bad request"})
+	}
+
+	if err := h.validate.Struct(&req); err != nil {
+		slog.Error("[HANDLER]", "message", "error validating request", "error", err.Error())
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Bad request"})
+	}
+
+	benefit, roomIDs, err := h.benefitService.CreateBenefit(ctx, req.HotelID, &domain.Benefit{
+		PhysicalRoomID: req.PhysicalRoomID,
+		Name:           req.Name,
+		Description:    req.Description,
+		ActiveFrom:     req.ActiveFrom,
+		ActiveUntil:    req.ActiveUntil,
+	})
+	if err != nil {
+		return err
+	}
+
+	resp.Benefit = *mapperdto.ToPhysicalRoomBenefitDTO(benefit, roomIDs)
+	return c.JSON(http.StatusCreated, &resp)
+}
+
+// UpdateBenefit godoc
+// @Summary Edit physical room benefit
+// @Description Edit a benefit; the change shows on every room offer listed in roomIDs
+// @Tags benefits
+// @Accept json
+// @Produce json
+// @Param hotelID path string true "Hotel ID"
+// @Param physicalRoomID path string true "Physical room ID"
+// @Param benefitID path string true "Benefit ID"
+// @Param request body benefitdto.UpdateBenefitRequest true "Benefit"
+// @Success 200 {object} benefitdto.BenefitResponse
+// @Router /hotels/{hotelID}/physical-rooms/{physicalRoomID}/benefits/{benefitID} [put]
+func (h *BenefitHandler) UpdateBenefit(c echo.Context) error {
+	var (
+		req  benefitdto.UpdateBenefitRequest
+		resp benefitdto.BenefitResponse
+	)
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), 5*time.Second)
+	defer cancel()
+
+	if err := c.Bind(&req); err != nil {
+		slog.Error("[HANDLER]", "message", "error binding request", "error", err.Error())
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Bad request"})
+	}
+
+	if err := h.validate.Struct(&req); err != nil {
+		slog.Error("[HANDLER]", "message", "error validating request", "error", err.Error())
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Bad request"})
+	}
+
+	benefit, roomIDs, err := h.benefitService.UpdateBenefit(ctx, req.HotelID, &domain.Benefit{
+		ID:             req.BenefitID,
+		PhysicalRoomID: req.PhysicalRoomID,
+		Name:           req.Name,
+		Description:    req.Description,
+		ActiveFrom:     req.ActiveFrom,
+		ActiveUntil:    req.ActiveUntil,
+	})
+	if err != nil {
+		return err
+	}
+
+	resp.Benefit = *mapperdto.ToPhysicalRoomBenefitDTO(benefit, roomIDs)
+	return c.JSON(200, &resp)
+}
+
+// RetireBenefit godoc
+// @Summary Retire physical room benefit
+// @Description Stop showing a benefit on the room offers sharing the physical room
+// @Tags benefits
+// @Param hotelID path string true "Hotel ID"
+// @Param physicalRoomID path string true "Physical room ID"
+// @Param benefitID path string true "Benefit ID"
+// @Success 204
+// @Router /hotels/{hotelID}/physical-rooms/{physicalRoomID}/benefits/{benefitID} [delete]
+func (h *BenefitHandler) RetireBenefit(c echo.Context) error {
+	var req benefitdto.RetireBenefitRequest
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), 5*time.Second)
+	defer cancel()
+
+	if err := c.Bind(&req); err != nil {
+		slog.Error("[HANDLER]", "message", "error binding request", "error", err.Error())
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Bad request"})
+	}
+
+	if err := h.validate.Struct(&req); err != nil {
+		slog.Error("[HANDLER]", "message", "error validating request", "error", err.Error())
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Bad request"})
+	}
+
+	if err := h.benefitService.RetireBenefit(ctx, req.HotelID, req.PhysicalRoomID, req.BenefitID); err != nil {
+		return err
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
@@ -0,0 +1,197 @@
+package handler
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/chayutK/hotel-property-service/internal/service"
+	"github.com/chayutK/hotel-property-service/internal/transport/http/dto/cancellationpolicydto"
+	"github.com/chayutK/hotel-property-service/internal/transport/http/dto/mapperdto"
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+)
+
+type CancellationPolicyHandler struct {
+	cancellationPolicyService *service.CancellationPolicyService
+	validate                  *validator.Validate
+}
+
+func NewCancellationPolicyHandler(cancellationPolicyService *service.CancellationPolicyService, validate *validator.Validate) *CancellationPolicyHandler {
+	return &CancellationPolicyHandler{
+		cancellationPolicyService: cancellationPolicyService,
+		validate:                  validate,
+	}
+}
+
+func (h *CancellationPolicyHandler) RegisterRoutes(g *echo.Group) {
+	g.GET("/cancellation-policies", h.GetCancellationPolicies)
+	g.GET("/cancellation-policies/:policyID", h.GetCancellationPolicy)
+	g.POST("/cancellation-policies", h.CreateCancellationPolicy)
+	g.PUT("/cancellation-policies/:policyID", h.UpdateCancellationPolicy)
+	g.DELETE("/cancellation-policies/:policyID", h.DeactivateCancellationPolicy)
+}
+
+// GetCancellationPolicies godoc
+// @Summary List cancellation policies
+// @Description Get the active cancellation policies rooms can be sold with
+// @Tags cancellation-policies
+// @Produce json
+// @Success 200 {object} cancellationpolicydto.InquiryCancellationPoliciesResponse
+// @Router /cancellation-policies [get]
+func (h *CancellationPolicyHandler) GetCancellationPolicies(c echo.Context) error {
+	var resp cancellationpolicydto.InquiryCancellationPoliciesResponse
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), 5*time.Second)
+	defer cancel()
+
+	policies, err := h.cancellationPolicyService.GetCancellationPolicies(ctx)
+	if err != nil {
+		return err
+	}
+
+	resp.Policies = mapperdto.ToCancellationPoliciesDTO(policies)
+	return c.JSON(200, &resp)
+}
+
+// GetCancellationPolicy godoc
+// @Summary Get cancellation policy
+// @Description Get an active cancellation policy by ID
+// @Tags cancellation-policies
+// @Produce json
+// @Param policyID path string true "Cancellation policy ID"
+// @Success 200 {object} cancellationpolicydto.CancellationPolicyResponse
+// @Router /cancellation-policies/{policyID} [get]
+func (h *CancellationPolicyHandler) GetCancellationPolicy(c echo.Context) error {
+	var (
+		req  cancellationpolicydto.InquiryCancellationPolicyRequest
+		resp cancellationpolicydto.CancellationPolicyResponse
+	)
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), 5*time.Second)
+	defer cancel()
+
+	if err := c.Bind(&req); err != nil {
+		slog.Error("[HANDLER]", "message", "error binding request", "error", err.Error())
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Bad request"})
+	}
+
+	if err := h.validate.Struct(&req); err != nil {
+		slog.Error("[HANDLER]", "message", "error validating request", "error", err.Error())
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Bad request"})
+	}
+
+	policy, err := h.cancellationPolicyService.GetCancellationPolicy(ctx, req.PolicyID)
+	if err != nil {
+		return err
+	}
+
+	resp.Policy = *mapperdto.ToCancellationPolicyDTO(policy)
+	return c.JSON(200, &resp)
+}
+
+// CreateCancellationPolicy godoc
+// @Summary Create cancellation policy
+// @Description Create a cancellation policy with a free cancellation deadline, penalty tiers and a price surcharge
+// @Tags cancellation-policies
+// @Accept json
+// @Produce json
+// @Param request body cancellationpolicydto.CreateCancellationPolicyRequest true "Cancellation policy"
+// @Success 201 {object} cancellationpolicydto.CancellationPolicyResponse
+// @Router /cancellation-policies [post]
+func (h *CancellationPolicyHandler) CreateCancellationPolicy(c echo.Context) error {
+	var (
+		req  cancellationpolicydto.CreateCancellationPolicyRequest
+		resp cancellationpolicydto.CancellationPolicyResponse
+	)
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), 5*time.Second)
+	defer cancel()
+
+	if err := c.Bind(&req); err != nil {
+		slog.Error("[HANDLER]", "message", "error binding request", "error", err.Error())
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Bad request"})
+	}
+
+	if err := h.validate.Struct(&req); err != nil {
+		slog.Error("[HANDLER]", "message", "error validating request", "error", err.Error())
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Bad request"})
+	}
+
+	policy, err := h.cancellationPolicyService.CreateCancellationPolicy(ctx, mapperdto.CreateCancellationPolicyRequestToDomain(&req))
+	if err != nil {
+		return err
+	}
+
+	resp.Policy = *mapperdto.ToCancellationPolicyDTO(policy)
+	return c.JSON(http.StatusCreated, &resp)
+}
+
+// UpdateCancellationPolicy godoc
+// @Summary Update cancellation policy
+// @Description Replace every field of a cancellation policy, rooms using it are priced with the new terms
+// @Tags cancellation-policies
+// @Accept json
+// @Produce json
+// @Param policyID path string true "Cancellation policy ID"
+// @Param request body cancellationpolicydto.UpdateCancellationPolicyRequest true "Cancellation policy"
+// @Success 200 {object} cancellationpolicydto.CancellationPolicyResponse
+// @Router /cancellation-policies/{policyID} [put]
+func (h *CancellationPolicyHandler) UpdateCancellationPolicy(c echo.Context) error {
+	var (
+		req  cancellationpolicydto.UpdateCancellationPolicyRequest
+		resp cancellationpolicydto.CancellationPolicyResponse
+	)
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), 5*time.Second)
+	defer cancel()
+
+	if err := c.Bind(&req); err != nil {
+		slog.Error("[HANDLER]", "message", "error binding request", "error", err.Error())
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Bad request"})
+	}
+
+	if err := h.validate.Struct(&req); err != nil {
+		slog.Error("[HANDLER]", "message", "error validating request", "error", err.Error())
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Bad request"})
+	}
+
+	policy, err := h.cancellationPolicyService.UpdateCancellationPolicy(ctx, mapperdto.UpdateCancellationPolicyRequestToDomain(&req))
+	if err != nil {
+		return err
+	}
+
+	resp.Policy = *mapperdto.ToCancellationPolicyDTO(policy)
+	return c.JSON(200, &resp)
+}
+
+// DeactivateCancellationPolicy godoc
+// @Summary Deactivate cancellation policy
+// @Description Soft-delete a cancellation policy that no active room or rate plan uses
+// @Tags cancellation-policies
+// @Param policyID path string true "Cancellation policy ID"
+// @Success 204
+// @Router /cancellation-policies/{policyID} [delete]
+func (h *CancellationPolicyHandler) DeactivateCancellationPolicy(c echo.Context) error {
+	var req cancellationpolicydto.DeactivateCancellationPolicyRequest
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), 5*time.Second)
+	defer cancel()
+
+	if err := c.Bind(&req); err != nil {
+		slog.Error("[HANDLER]", "message", "error binding request", "error", err.Error())
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Bad request"})
+	}
+
+	if err := h.validate.Struct(&req); err != nil {
+		slog.Error("[HANDLER]", "message", "error validating request", "error", err.Error())
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Bad request"})
+	}
+
+	if err := h.cancellationPolicyService.DeactivateCancellationPolicy(ctx, req.PolicyID); err != nil {
+		return err
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
@@ -0,0 +1,187 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/chayutK/hotel-property-service/internal/service"
+	"github.com/chayutK/hotel-property-service/internal/transport/http/dto/catalogdto"
+	"github.com/chayutK/hotel-property-service/internal/transport/http/dto/mapperdto"
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+)
+
+type CatalogHandler struct {
+	catalogService *service.CatalogService
+	validate       *validator.Validate
+}
+
+func NewCatalogHandler(catalogService *service.CatalogService, validate *validator.Validate) *CatalogHandler {
+	return &CatalogHandler{
+		catalogService: catalogService,
+		validate:       validate,
+	}
+}
+
+func (h *CatalogHandler) RegisterRoutes(g *echo.Group) {
+	g.POST("/admin/import", h.ImportCatalog)
+	g.GET("/admin/snapshot", h.ExportSnapshot)
+	g.POST("/admin/snapshot", h.RestoreSnapshot)
+}
+
+// ImportCatalog godoc
+// @Summary Import catalog
+// @Description Validate and upsert hotels with their facilities, physical rooms, offers and benefits in one transaction.
+// @Description Every listed hotel is complete, anything under it that is not listed is deactivated.
+// @Description Accepts the JSON document or a CSV with an entity column. With dry_run only the diff is returned.
+// @Tags admin
+// @Accept json
+// @Accept text/csv
+// @Produce json
+// @Param dry_run query bool false "Only report the changes"
+// @Param request body catalogdto.CatalogDocument true "Catalog document"
+// @Success 200 {object} catalogdto.ImportCatalogResponse
+// @Router /admin/import [post]
+func (h *CatalogHandler) ImportCatalog(c echo.Context) error {
+	var (
+		req  catalogdto.ImportCatalogRequest
+		resp catalogdto.ImportCatalogResponse
+	)
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), 30*time.Second)
+	defer cancel()
+
+	if err := (&echo.DefaultBinder{}).BindQueryParams(c, &req); err != nil {
+		slog.Error("[HANDLER]", "message", "error binding request", "error", err.Error())
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Bad request"})
+	}
+
+	if strings.HasPrefix(c.Request().Header.Get(echo.HeaderContentType), "text/csv") {
+		doc, err := catalogdto.ParseCSV(c.Request().Body)
+		if err != nil {
+			slog.Error("[HANDLER]", "message", "error parsing csv", "error", err.Error())
+			return c.JSON(http.StatusBadRequest, map[string]any{"message": "Bad request", "errors": []string{err.Error()}})
+		}
+		req.Document = *doc
+	} else if err := (&echo.DefaultBinder{}).BindBody(c, &req.Document); err != nil {
+		slog.Error("[HANDLER]", "message", "error binding request", "error", err.Error())
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Bad request"})
+	}
+
+	if err := h.validate.Struct(&req.Document); err != nil {
+		slog.Error("[HANDLER]", "message", "error validating request", "error", err.Error())
+		return c.JSON(http.StatusBadRequest, map[string]any{"message": "Bad request", "errors": validationProblems(err)})
+	}
+
+	changes, err := h.catalogService.ImportCatalog(ctx, mapperdto.ToDomainCatalogRecords(&req.Document), req.DryRun)
+	if err != nil {
+		var invalid *service.CatalogValidationError
+		if errors.As(err, &invalid) {
+			return c.JSON(http.StatusUnprocessableEntity, map[string]any{"message": "Unprocessable entity", "errors": invalid.Problems})
+		}
+		return err
+	}
+
+	resp.DryRun = req.DryRun
+	resp.Summary = mapperdto.ToCatalogChangeSummary(changes)
+	resp.Changes = mapperdto.ToCatalogChangesDTO(changes)
+	return c.JSON(200, &resp)
+}
+
+// ExportSnapshot godoc
+// @Summary Export catalog snapshot
+// @Description Dump the whole catalog, inactive rows included, as a versioned document that can be restored elsewhere
+// @Tags admin
+// @Produce json
+// @Success 200 {object} catalogdto.CatalogSnapshot
+// @Router /admin/snapshot [get]
+func (h *CatalogHandler) ExportSnapshot(c echo.Context) error {
+	var resp catalogdto.CatalogSnapshot
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), 30*time.Second)
+	defer cancel()
+
+	records, err := h.catalogService.ExportCatalog(ctx)
+	if err != nil {
+		return err
+	}
+
+	resp.Version = catalogdto.SnapshotVersion
+	resp.ExportedAt = time.Now().UTC()
+	resp.CatalogDocument = *mapperdto.ToCatalogDocument(records)
+	return c.JSON(200, &resp)
+}
+
+// RestoreSnapshot godoc
+// @Summary Restore catalog snapshot
+// @Description Load a snapshot into an empty or existing database. Hotels in the snapshot end up exactly as exported,
+// @Description hotels that are not in it are left untouched. With dry_run only the diff is returned.
+// @Tags admin
+// @Accept json
+// @Produce json
+// @Param dry_run query bool false "Only report the changes"
+// @Param request body catalogdto.CatalogSnapshot true "Catalog snapshot"
+// @Success 200 {object} catalogdto.ImportCatalogResponse
+// @Router /admin/snapshot [post]
+func (h *CatalogHandler) RestoreSnapshot(c echo.Context) error {
+	var (
+		req  catalogdto.RestoreSnapshotRequest
+		resp catalogdto.ImportCatalogResponse
+	)
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), 30*time.Second)
+	defer cancel()
+
+	if err := (&echo.DefaultBinder{}).BindQueryParams(c, &req); err != nil {
+		slog.Error("[HANDLER]", "message", "error binding request", "error", err.Error())
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Bad request"})
+	}
+
+	if err := (&echo.DefaultBinder{}).BindBody(c, &req.Snapshot); err != nil {
+		slog.Error("[HANDLER]", "message", "error binding request", "error", err.Error())
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Bad request"})
+	}
+
+	if !catalogdto.IsSupportedVersion(req.Snapshot.Version) {
+		slog.Error("[HANDLER]", "message", "unsupported snapshot version", "version", req.Snapshot.Version)
+		return c.JSON(http.StatusBadRequest, map[string]any{"message": "Bad request", "errors": []string{fmt.Sprintf("snapshot version %d is not supported", req.Snapshot.Version)}})
+	}
+
+	if err := h.validate.Struct(&req.Snapshot); err != nil {
+		slog.Error("[HANDLER]", "message", "error validating request", "error", err.Error())
+		return c.JSON(http.StatusBadRequest, map[string]any{"message": "Bad request", "errors": validationProblems(err)})
+	}
+
+	changes, err := h.catalogService.ImportCatalog(ctx, mapperdto.ToDomainCatalogRecords(&req.Snapshot.CatalogDocument), req.DryRun)
+	if err != nil {
+		var invalid *service.CatalogValidationError
+		if errors.As(err, &invalid) {
+			return c.JSON(http.StatusUnprocessableEntity, map[string]any{"message": "Unprocessable entity", "errors": invalid.Problems})
+		}
+		return err
+	}
+
+	resp.DryRun = req.DryRun
+	resp.Summary = mapperdto.ToCatalogChangeSummary(changes)
+	resp.Changes = mapperdto.ToCatalogChangesDTO(changes)
+	return c.JSON(200, &resp)
+}
+
+// validationProblems lists each failed field so a large import can be fixed in one pass
+func validationProblems(err error) []string {
+	var fieldErrors validator.ValidationErrors
+	if !errors.As(err, &fieldErrors) {
+		return []string{err.Error()}
+	}
+
+	problems := make([]string, len(fieldErrors))
+	for i, fe := range fieldErrors {
+		problems[i] = fe.Namespace() + " failed on " + fe.Tag()
+	}
+	return problems
+}
@@ -0,0 +1,111 @@
+package handler
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	headerETag                    = "ETag"
+	headerIfMatch                 = "If-Match"
+	mimeApplicationMergePatchJSON = "application/merge-patch+json"
+)
+
+// etag renders a row version as a strong entity tag
+func etag(updatedAt int64) string {
+	return strconv.Quote(strconv.FormatInt(updatedAt, 10))
+}
+
+func setETag(c echo.Context, updatedAt int64) {
+	c.Response().Header().Set(headerETag, etag(updatedAt))
+}
+
+func hasIfMatch(c echo.Context) bool {
+	return c.Request().Header.Get(headerIfMatch) != ""
+}
+
+// ifMatch reports whether the If-Match header allows an update of the given version. A
+// missing header or "*" matches any version.
+func ifMatch(c echo.Context, updatedAt int64) bool {
+	header := strings.TrimSpace(c.Request().Header.Get(headerIfMatch))
+	if header == "" || header == "*" {
+		return true
+	}
+
+	current := etag(updatedAt)
+	for _, tag := range strings.Split(header, ",") {
+		if strings.TrimSpace(tag) == current {
+			return true
+		}
+	}
+	return false
+}
+
+func preconditionFailed(c echo.Context) error {
+	return c.JSON(http.StatusPreconditionFailed, map[string]string{"message": "Precondition failed"})
+}
+
+func isMergePatch(c echo.Context) bool {
+	return strings.HasPrefix(c.Request().Header.Get(echo.HeaderContentType), mimeApplicationMergePatchJSON)
+}
+
+// mergePatch applies the RFC 7386 JSON Merge Patch in the request body to the JSON form of
+// doc and decodes the result back into doc. Members set to null are removed, so they come
+// back as zero values.
+func mergePatch(c echo.Context, doc any) error {
+	body, err := io.ReadAll(c.Request().Body)
+	if err != nil {
+		return err
+	}
+
+	var patch any
+	if err := json.Unmarshal(body, &patch); err != nil {
+		return err
+	}
+
+	current, err := json.Marshal(doc)
+	if err != nil {
+		return err
+	}
+
+	var target any
+	if err := json.Unmarshal(current, &target); err != nil {
+		return err
+	}
+
+	merged, err := json.Marshal(mergeValue(target, patch))
+	if err != nil {
+		return err
+	}
+
+	v := reflect.ValueOf(doc).Elem()
+	v.Set(reflect.Zero(v.Type()))
+	return json.Unmarshal(merged, doc)
+}
+
+func mergeValue(target, patch any) any {
+	patchObject, ok := patch.(map[string]any)
+	if !ok {
+		return patch
+	}
+
+	targetObject, ok := target.(map[string]any)
+	if !ok {
+		targetObject = map[string]any{}
+	}
+
+	for key, value := range patchObject {
+		if value == nil {
+			delete(targetObject, key)
+			continue
+		}
+		targetObject[key] = mergeValue(targetObject[key], value)
+	}
+	return targetObject
+}
@@ -0,0 +1,148 @@
+package handler
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/chayutK/hotel-property-service/internal/service"
+	"github.com/chayutK/hotel-property-service/internal/transport/http/dto/exchangeratedto"
+	"github.com/chayutK/hotel-property-service/internal/transport/http/dto/mapperdto"
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+)
+
+type ExchangeRateHandler struct {
+	exchangeRateService *service.ExchangeRateService
+	validate            *validator.Validate
+}
+
+func NewExchangeRateHandler(exchangeRateService *service.ExchangeRateService, validate *validator.Validate) *ExchangeRateHandler {
+	return &ExchangeRateHandler{
+		exchangeRateService: exchangeRateService,
+		validate:            validate,
+	}
+}
+
+func (h *ExchangeRateHandler) RegisterRoutes(g *echo.Group) {
+	g.GET("/admin/exchange-rates", h.GetExchangeRates)
+	g.PUT("/admin/exchange-rates", h.SetExchangeRates)
+	g.DELETE("/admin/exchange-rates/:from/:to/:date", h.DeleteExchangeRate)
+}
+
+// GetExchangeRates godoc
+// @Summary List exchange rates
+// @Description Get the exchange rates quotes are converted at, every effective date included
+// @Tags admin
+// @Produce json
+// @Param from query string false "Currency converted from"
+// @Param to query string false "Currency converted to"
+// @Success 200 {object} exchangeratedto.ExchangeRatesResponse
+// @Router /admin/exchange-rates [get]
+func (h *ExchangeRateHandler) GetExchangeRates(c echo.Context) error {
+	var (
+		req  exchangeratedto.InquiryExchangeRatesRequest
+		resp exchangeratedto.ExchangeRatesResponse
+	)
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), 5*time.Second)
+	defer cancel()
+
+	if err := c.Bind(&req); err != nil {
+		slog.Error("[HANDLER]", "message", "error binding request", "error", err.Error())
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Bad request"})
+	}
+
+	if err := h.validate.Struct(&req); err != nil {
+		slog.Error("[HANDLER]", "message", "error validating request", "error", err.Error())
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Bad request"})
+	}
+
+	rates, err := h.exchangeRateService.GetExchangeRates(ctx, req.From, req.To)
+	if err != nil {
+		return err
+	}
+
+	resp.Rates = mapperdto.ToExchangeRatesDTO(rates)
+	return c.JSON(200, &resp)
+}
+
+// SetExchangeRates godoc
+// @Summary Set exchange rates
+// @Description Create or replace exchange rates by currency pair and effective date, other rates are left unchanged.
+// @Description Accepts the JSON document or a CSV with from, to, rate and effective_date columns.
+// @Tags admin
+// @Accept json
+// @Accept text/csv
+// @Produce json
+// @Param request body exchangeratedto.SetExchangeRatesRequest true "Exchange rates"
+// @Success 200 {object} exchangeratedto.ExchangeRatesResponse
+// @Router /admin/exchange-rates [put]
+func (h *ExchangeRateHandler) SetExchangeRates(c echo.Context) error {
+	var (
+		req  exchangeratedto.SetExchangeRatesRequest
+		resp exchangeratedto.ExchangeRatesResponse
+	)
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), 5*time.Second)
+	defer cancel()
+
+	if strings.HasPrefix(c.Request().Header.Get(echo.HeaderContentType), "text/csv") {
+		parsed, err := exchangeratedto.ParseCSV(c.Request().Body)
+		if err != nil {
+			slog.Error("[HANDLER]", "message", "error parsing csv", "error", err.Error())
+			return c.JSON(http.StatusBadRequest, map[string]any{"message": "Bad request", "errors": []string{err.Error()}})
+		}
+		req = *parsed
+	} else if err := c.Bind(&req); err != nil {
+		slog.Error("[HANDLER]", "message", "error binding request", "error", err.Error())
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Bad request"})
+	}
+
+	if err := h.validate.Struct(&req); err != nil {
+		slog.Error("[HANDLER]", "message", "error validating request", "error", err.Error())
+		return c.JSON(http.StatusBadRequest, map[string]any{"message": "Bad request", "errors": validationProblems(err)})
+	}
+
+	rates, err := h.exchangeRateService.SetExchangeRates(ctx, mapperdto.SetExchangeRatesRequestToDomain(&req))
+	if err != nil {
+		return err
+	}
+
+	resp.Rates = mapperdto.ToExchangeRatesDTO(rates)
+	return c.JSON(200, &resp)
+}
+
+// DeleteExchangeRate godoc
+// @Summary Delete exchange rate
+// @Description Remove the rate of a currency pair effective from a date, the rate effective before it applies again
+// @Tags admin
+// @Param from path string true "Currency converted from"
+// @Param to path string true "Currency converted to"
+// @Param date path string true "Effective date, YYYY-MM-DD"
+// @Success 204
+// @Router /admin/exchange-rates/{from}/{to}/{date} [delete]
+func (h *ExchangeRateHandler) DeleteExchangeRate(c echo.Context) error {
+	var req exchangeratedto.DeleteExchangeRateRequest
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), 5*time.Second)
+	defer cancel()
+
+	if err := c.Bind(&req); err != nil {
+		slog.Error("[HANDLER]", "message", "error binding request", "error", err.Error())
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Bad request"})
+	}
+
+	if err := h.validate.Struct(&req); err != nil {
+		slog.Error("[HANDLER]", "message", "error validating request", "error", err.Error())
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Bad request"})
+	}
+
+	if err := h.exchangeRateService.DeleteExchangeRate(ctx, req.From, req.To, req.Date); err != nil {
+		return err
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
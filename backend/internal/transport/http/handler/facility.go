@@ -0,0 +1,262 @@
+package handler
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/chayutK/hotel-property-service/internal/domain"
+	"github.com/chayutK/hotel-property-service/internal/service"
+	"github.com/chayutK/hotel-property-service/internal/transport/http/dto/facilitydto"
+	"github.com/chayutK/hotel-property-service/internal/transport/http/dto/hoteldto"
+	"github.com/chayutK/hotel-property-service/internal/transport/http/dto/mapperdto"
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+)
+
+type FacilityHandler struct {
+	facilityService *service.FacilityService
+	validate        *validator.Validate
+}
+
+func NewFacilityHandler(facilityService *service.FacilityService, validate *validator.Validate) *FacilityHandler {
+	return &FacilityHandler{
+		facilityService: facilityService,
+		validate:        validate,
+	}
+}
+
+func (h *FacilityHandler) RegisterRoutes(g *echo.Group) {
+	g.GET("/facilities", h.GetCatalog)
+	g.POST("/facilities", h.CreateCatalogEntry)
+	g.PUT("/facilities/:code", h.UpdateCatalogEntry)
+	g.POST("/hotel/:hotel_id/facilities", h.AttachFacility)
+	g.PUT("/hotel/:hotel_id/facilities/:code", h.DescribeFacility)
+	g.DELETE("/hotel/:hotel_id/facilities/:code", h.DetachFacility)
+}
+
+// GetCatalog godoc
+// @Summary List facility catalog
+// @Description Get the master facility catalog shared by all hotels
+// @Tags facilities
+// @Produce json
+// @Success 200 {object} facilitydto.InquiryCatalogResponse
+// @Router /facilities [get]
+func (h *FacilityHandler) GetCatalog(c echo.Context) error {
+	var resp facilitydto.InquiryCatalogResponse
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), 5*time.Second)
+	defer cancel()
+
+	entries, err := h.facilityService.GetCatalog(ctx)
+	if err != nil {
+		return err
+	}
+
+	resp.Facilities = mapperdto.ToCatalogEntriesDTO(entries)
+	return c.JSON(200, &resp)
+}
+
+// CreateCatalogEntry godoc
+// @Summary Create facility catalog entry
+// @Description Add a facility with a stable code to the master catalog
+// @Tags facilities
+// @Accept json
+// @Produce json
+// @Param request body facilitydto.CreateCatalogEntryRequest true "Catalog entry"
+// @Success 201 {object} facilitydto.CatalogEntryResponse
+// @Router /facilities [post]
+func (h *FacilityHandler) CreateCatalogEntry(c echo.Context) error {
+	var (
+		req  facilitydto.CreateCatalogEntryRequest
+		resp facilitydto.CatalogEntryResponse
+	)
+
+	if err := c.Bind(&req); err != nil {
+		slog.Error("[HANDLER]", "message", "error binding request", "error", err.Error())
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Bad request"})
+	}
+
+	if err := h.validate.Struct(&req); err != nil {
+		slog.Error("[HANDLER]", "message", "error validating request", "error", err.Error())
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Bad request"})
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), 5*time.Second)
+	defer cancel()
+
+	entry, err := h.facilityService.CreateCatalogEntry(ctx, &domain.FacilityCatalogEntry{
+		Code:        req.Code,
+		Name:        req.Name,
+		Description: req.Description,
+	})
+	if err != nil {
+		return err
+	}
+
+	resp.Facility = *mapperdto.ToCatalogEntryDTO(entry)
+	return c.JSON(http.StatusCreated, &resp)
+}
+
+// UpdateCatalogEntry godoc
+// @Summary Update facility catalog entry
+// @Description Rename or re-describe a facility for every hotel that offers it
+// @Tags facilities
+// @Accept json
+// @Produce json
+// @Param code path string true "Facility code"
+// @Param request body facilitydto.UpdateCatalogEntryRequest true "Catalog entry"
+// @Success 200 {object} facilitydto.CatalogEntryResponse
+// @Router /facilities/{code} [put]
+func (h *FacilityHandler) UpdateCatalogEntry(c echo.Context) error {
+	var (
+		req  facilitydto.UpdateCatalogEntryRequest
+		resp facilitydto.CatalogEntryResponse
+	)
+
+	if err := c.Bind(&req); err != nil {
+		slog.Error("[HANDLER]", "message", "error binding request", "error", err.Error())
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Bad request"})
+	}
+
+	if err := h.validate.Struct(&req); err != nil {
+		slog.Error("[HANDLER]", "message", "error validating request", "error", err.Error())
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Bad request"})
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), 5*time.Second)
+	defer cancel()
+
+	entry, err := h.facilityService.UpdateCatalogEntry(ctx, &domain.FacilityCatalogEntry{
+		Code:        req.Code,
+		Name:        req.Name,
+		Description: req.Description,
+	})
+	if err != nil {
+		return err
+	}
+
+	resp.Facility = *mapperdto.ToCatalogEntryDTO(entry)
+	return c.JSON(200, &resp)
+}
+
+// AttachFacility godoc
+// @Summary Attach facility to hotel
+// @Description Offer a catalog facility at a hotel, optionally with a hotel specific description
+// @Tags facilities
+// @Accept json
+// @Produce json
+// @Param hotel_id path string true "Hotel ID"
+// @Param request body facilitydto.AttachFacilityRequest true "Facility"
+// @Success 200 {object} hoteldto.UpdateHotelResponse
+// @Router /hotel/{hotel_id}/facilities [post]
+func (h *FacilityHandler) AttachFacility(c echo.Context) error {
+	var (
+		req  facilitydto.AttachFacilityRequest
+		resp hoteldto.UpdateHotelResponse
+	)
+
+	if err := c.Bind(&req); err != nil {
+		slog.Error("[HANDLER]", "message", "error binding request", "error", err.Error())
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Bad request"})
+	}
+
+	if err := h.validate.Struct(&req); err != nil {
+		slog.Error("[HANDLER]", "message", "error validating request", "error", err.Error())
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Bad request"})
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), 5*time.Second)
+	defer cancel()
+
+	hotel, err := h.facilityService.AttachFacility(ctx, &domain.Facility{
+		HotelID:     req.HotelID,
+		Code:        req.Code,
+		Description: req.Description,
+		ActiveFrom:  req.ActiveFrom,
+		ActiveUntil: req.ActiveUntil,
+	})
+	if err != nil {
+		return err
+	}
+
+	resp.Hotel = *mapperdto.ToHotelDTO(hotel)
+	return c.JSON(200, &resp)
+}
+
+// DescribeFacility godoc
+// @Summary Describe hotel facility
+// @Description Set the hotel specific description of an attached facility; empty falls back to the catalog description
+// @Tags facilities
+// @Accept json
+// @Produce json
+// @Param hotel_id path string true "Hotel ID"
+// @Param code path string true "Facility code"
+// @Param request body facilitydto.DescribeFacilityRequest true "Description"
+// @Success 200 {object} hoteldto.UpdateHotelResponse
+// @Router /hotel/{hotel_id}/facilities/{code} [put]
+func (h *FacilityHandler) DescribeFacility(c echo.Context) error {
+	var (
+		req  facilitydto.DescribeFacilityRequest
+		resp hoteldto.UpdateHotelResponse
+	)
+
+	if err := c.Bind(&req); err != nil {
+		slog.Error("[HANDLER]", "message", "error binding request", "error", err.Error())
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Bad request"})
+	}
+
+	if err := h.validate.Struct(&req); err != nil {
+		slog.Error("[HANDLER]", "message", "error validating request", "error", err.Error())
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Bad request"})
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), 5*time.Second)
+	defer cancel()
+
+	hotel, err := h.facilityService.DescribeFacility(ctx, &domain.Facility{
+		HotelID:     req.HotelID,
+		Code:        req.Code,
+		Description: req.Description,
+		ActiveFrom:  req.ActiveFrom,
+		ActiveUntil: req.ActiveUntil,
+	})
+	if err != nil {
+		return err
+	}
+
+	resp.Hotel = *mapperdto.ToHotelDTO(hotel)
+	return c.JSON(200, &resp)
+}
+
+// DetachFacility godoc
+// @Summary Detach facility from hotel
+// @Description Stop offering a facility at a hotel
+// @Tags facilities
+// @Param hotel_id path string true "Hotel ID"
+// @Param code path string true "Facility code"
+// @Success 204
+// @Router /hotel/{hotel_id}/facilities/{code} [delete]
+func (h *FacilityHandler) DetachFacility(c echo.Context) error {
+	var req facilitydto.DetachFacilityRequest
+
+	if err := c.Bind(&req); err != nil {
+		slog.Error("[HANDLER]", "message", "error binding request", "error", err.Error())
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Bad request"})
+	}
+
+	if err := h.validate.Struct(&req); err != nil {
+		slog.Error("[HANDLER]", "message", "error validating request", "error", err.Error())
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Bad request"})
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), 5*time.Second)
+	defer cancel()
+
+	if err := h.facilityService.DetachFacility(ctx, req.HotelID, req.Code); err != nil {
+		return err
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
@@ -2,10 +2,12 @@ package handler
 
 import (
 	"context"
+	"errors"
 	"log/slog"
 	"net/http"
 	"time"
 
+	"github.com/chayutK/hotel-property-service/internal/domain"
 	"github.com/chayutK/hotel-property-service/internal/service"
 	"github.com/chayutK/hotel-property-service/internal/transport/http/dto/hoteldto"
 	"github.com/chayutK/hotel-property-service/internal/transport/http/dto/mapperdto"
@@ -28,6 +30,10 @@ func NewHotelHandler(hotelService *service.HotelService, validate *validator.Val
 func (h *HotelHandler) RegisterRoutes(g *echo.Group) {
 	g.GET("/hotels", h.GetAllHotels)
 	g.GET("/hotel/:hotel_id", h.GetHotelByID)
+	g.POST("/hotels", h.CreateHotel)
+	g.PUT("/hotel/:hotel_id", h.UpdateHotel)
+	g.PATCH("/hotel/:hotel_id", h.PatchHotel)
+	g.DELETE("/hotel/:hotel_id", h.DeactivateHotel)
 }
 
 // GetAllHotels godoc
@@ -35,16 +41,28 @@ func (h *HotelHandler) RegisterRoutes(g *echo.Group) {
 // @Description Get a list of hotels
 // @Tags hotels
 // @Produce json
+// @Param facility query []string false "Facility codes every returned hotel must offer" collectionFormat(multi)
 // @Success 200 {object} hoteldto.InquiryHotelsResponse
 // @Router /hotels [get]
 func (h *HotelHandler) GetAllHotels(c echo.Context) error {
 	var (
+		req  hoteldto.InquiryHotelsRequest
 		resp hoteldto.InquiryHotelsResponse
 	)
 	ctx, cancel := context.WithTimeout(c.Request().Context(), 5*time.Second)
 	defer cancel()
 
-	hotels, err := h.hotelService.GetAllHotels(ctx)
+	if err := c.Bind(&req); err != nil {
+		slog.Error("[HANDLER]", "message", "error binding request", "error", err.Error())
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Bad request"})
+	}
+
+	if err := h.validate.Struct(&req); err != nil {
+		slog.Error("[HANDLER]", "message", "error validating request", "error", err.Error())
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Bad request"})
+	}
+
+	hotels, err := h.hotelService.GetAllHotels(ctx, domain.HotelFilter{FacilityCodes: req.Facility})
 	if err != nil {
 		return err
 	}
@@ -56,6 +74,7 @@ func (h *HotelHandler) GetAllHotels(c echo.Context) error {
 // GetHotelByID godoc
 // @Summary Get hotel by id
 // @Description Get hotel details by hotel id
+// @Description Missing, inactive and not yet or no longer live ones are 404.
 // @Tags hotels
 // @Produce json
 // @Param hotel_id path string true "Hotel ID"
@@ -81,10 +100,273 @@ func (h *HotelHandler) GetHotelByID(c echo.Context) error {
 	defer cancel()
 
 	hotel, err := h.hotelService.GetHotelByID(ctx, req.HotelID)
+	if errors.Is(err, domain.ErrNotFound) {
+		return c.JSON(http.StatusNotFound, map[string]string{"message": "Not found"})
+	}
 	if err != nil {
 		return err
 	}
 
+	setETag(c, hotel.UpdatedAt)
 	resp.Hotel = *mapperdto.ToHotelDTO(hotel)
 	return c.JSON(200, &resp)
 }
+
+// CreateHotel godoc
+// @Summary Create hotel
+// @Description Create a new active hotel, it starts with a BAR best available rate plan
+// @Tags hotels
+// @Accept json
+// @Produce json
+// @Param request body hoteldto.CreateHotelRequest true "Hotel"
+// @Success 201 {object} hoteldto.CreateHotelResponse
+// @Router /hotels [post]
+func (h *HotelHandler) CreateHotel(c echo.Context) error {
+	var (
+		req  hoteldto.CreateHotelRequest
+		resp hoteldto.CreateHotelResponse
+	)
+
+	if err := c.Bind(&req); err != nil {
+		slog.Error("[HANDLER]", "message", "error binding request", "error", err.Error())
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Bad request"})
+	}
+
+	if err := h.validate.Struct(&req); err != nil {
+		slog.Error("[HANDLER]", "message", "error validating request", "error", err.Error())
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Bad request"})
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), 5*time.Second)
+	defer cancel()
+
+	hotel, err := h.hotelService.CreateHotel(ctx, mapperdto.CreateHotelRequestToDomain(&req))
+	if err != nil {
+		return err
+	}
+
+	resp.Hotel = *mapperdto.ToHotelDTO(hotel)
+	return c.JSON(http.StatusCreated, &resp)
+}
+
+// UpdateHotel godoc
+// @Summary Update hotel
+// @Description Replace the editable fields of an active hotel
+// @Tags hotels
+// @Accept json
+// @Produce json
+// @Param hotel_id path string true "Hotel ID"
+// @Param If-Match header string false "ETag of the version being replaced"
+// @Param request body hoteldto.UpdateHotelRequest true "Hotel"
+// @Success 200 {object} hoteldto.UpdateHotelResponse
+// @Failure 412 {object} map[string]string
+// @Router /hotel/{hotel_id} [put]
+func (h *HotelHandler) UpdateHotel(c echo.Context) error {
+	var (
+		req  hoteldto.UpdateHotelRequest
+		resp hoteldto.UpdateHotelResponse
+	)
+
+	if err := c.Bind(&req); err != nil {
+		slog.Error("[HANDLER]", "message", "error binding request", "error", err.Error())
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Bad request"})
+	}
+
+	if err := h.validate.Struct(&req); err != nil {
+		slog.Error("[HANDLER]", "message", "error validating request", "error", err.Error())
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Bad request"})
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), 5*time.Second)
+	defer cancel()
+
+	hotel := mapperdto.UpdateHotelRequestToDomain(&req)
+	if hasIfMatch(c) {
+		current, err := h.hotelService.GetHotelForUpdate(ctx, req.HotelID)
+		if err != nil {
+			return err
+		}
+		if !ifMatch(c, current.UpdatedAt) {
+			return preconditionFailed(c)
+		}
+		hotel.UpdatedAt = current.UpdatedAt
+	}
+
+	hotel, err := h.hotelService.UpdateHotel(ctx, hotel)
+	if errors.Is(err, domain.ErrPreconditionFailed) {
+		return preconditionFailed(c)
+	}
+	if err != nil {
+		return err
+	}
+
+	setETag(c, hotel.UpdatedAt)
+	resp.Hotel = *mapperdto.ToHotelDTO(hotel)
+	return c.JSON(200, &resp)
+}
+
+// PatchHotel godoc
+// @Summary Partially update hotel
+// @Description Update only the provided fields of an active hotel. Also accepts application/merge-patch+json,
+// @Description where null removes a field.
+// @Tags hotels
+// @Accept json
+// @Accept application/merge-patch+json
+// @Produce json
+// @Param hotel_id path string true "Hotel ID"
+// @Param If-Match header string false "ETag of the version being patched"
+// @Param request body hoteldto.PatchHotelRequest true "Hotel fields"
+// @Success 200 {object} hoteldto.UpdateHotelResponse
+// @Failure 412 {object} map[string]string
+// @Router /hotel/{hotel_id} [patch]
+func (h *HotelHandler) PatchHotel(c echo.Context) error {
+	var (
+		req  hoteldto.PatchHotelRequest
+		resp hoteldto.UpdateHotelResponse
+	)
+
+	if isMergePatch(c) {
+		return h.mergePatchHotel(c)
+	}
+
+	if err := c.Bind(&req); err != nil {
+		slog.Error("[HANDLER]", "message", "error binding request", "error", err.Error())
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Bad request"})
+	}
+
+	if err := h.validate.Struct(&req); err != nil {
+		slog.Error("[HANDLER]", "message", "error validating request", "error", err.Error())
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Bad request"})
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), 5*time.Second)
+	defer cancel()
+
+	hotel, err := h.hotelService.GetHotelForUpdate(ctx, req.HotelID)
+	if err != nil {
+		return err
+	}
+
+	if !ifMatch(c, hotel.UpdatedAt) {
+		return preconditionFailed(c)
+	}
+
+	mapperdto.ApplyHotelPatch(hotel, &req)
+	hotel, err = h.hotelService.UpdateHotel(ctx, hotel)
+	if errors.Is(err, domain.ErrPreconditionFailed) {
+		return preconditionFailed(c)
+	}
+	if err != nil {
+		return err
+	}
+
+	setETag(c, hotel.UpdatedAt)
+	resp.Hotel = *mapperdto.ToHotelDTO(hotel)
+	return c.JSON(200, &resp)
+}
+
+// mergePatchHotel applies a JSON Merge Patch to the full update document of the hotel, the
+// result is validated like a PUT.
+func (h *HotelHandler) mergePatchHotel(c echo.Context) error {
+	var (
+		req  hoteldto.PatchHotelRequest
+		resp hoteldto.UpdateHotelResponse
+	)
+
+	if err := (&echo.DefaultBinder{}).BindPathParams(c, &req); err != nil {
+		slog.Error("[HANDLER]", "message", "error binding request", "error", err.Error())
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Bad request"})
+	}
+
+	if err := h.validate.Struct(&req); err != nil {
+		slog.Error("[HANDLER]", "message", "error validating request", "error", err.Error())
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Bad request"})
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), 5*time.Second)
+	defer cancel()
+
+	current, err := h.hotelService.GetHotelForUpdate(ctx, req.HotelID)
+	if err != nil {
+		return err
+	}
+
+	if !ifMatch(c, current.UpdatedAt) {
+		return preconditionFailed(c)
+	}
+
+	update := mapperdto.ToUpdateHotelRequest(current)
+	if err := mergePatch(c, update); err != nil {
+		slog.Error("[HANDLER]", "message", "error applying merge patch", "error", err.Error())
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Bad request"})
+	}
+	update.HotelID = current.ID
+
+	if err := h.validate.Struct(update); err != nil {
+		slog.Error("[HANDLER]", "message", "error validating request", "error", err.Error())
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Bad request"})
+	}
+
+	hotel := mapperdto.UpdateHotelRequestToDomain(update)
+	hotel.UpdatedAt = current.UpdatedAt
+
+	hotel, err = h.hotelService.UpdateHotel(ctx, hotel)
+	if errors.Is(err, domain.ErrPreconditionFailed) {
+		return preconditionFailed(c)
+	}
+	if err != nil {
+		return err
+	}
+
+	setETag(c, hotel.UpdatedAt)
+	resp.Hotel = *mapperdto.ToHotelDTO(hotel)
+	return c.JSON(200, &resp)
+}
+
+// DeactivateHotel godoc
+// @Summary Deactivate hotel
+// @Description Soft-delete a hotel by marking it inactive
+// @Tags hotels
+// @Param hotel_id path string true "Hotel ID"
+// @Param If-Match header string false "ETag of the version being deactivated"
+// @Success 204
+// @Failure 412 {object} map[string]string
+// @Router /hotel/{hotel_id} [delete]
+func (h *HotelHandler) DeactivateHotel(c echo.Context) error {
+	var req hoteldto.DeactivateHotelRequest
+
+	if err := c.Bind(&req); err != nil {
+		slog.Error("[HANDLER]", "message", "error binding request", "error", err.Error())
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Bad request"})
+	}
+
+	if err := h.validate.Struct(&req); err != nil {
+		slog.Error("[HANDLER]", "message", "error validating request", "error", err.Error())
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Bad request"})
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), 5*time.Second)
+	defer cancel()
+
+	var updatedAt int64
+	if hasIfMatch(c) {
+		current, err := h.hotelService.GetHotelForUpdate(ctx, req.HotelID)
+		if err != nil {
+			return err
+		}
+		if !ifMatch(c, current.UpdatedAt) {
+			return preconditionFailed(c)
+		}
+		updatedAt = current.UpdatedAt
+	}
+
+	err := h.hotelService.DeactivateHotel(ctx, req.HotelID, updatedAt)
+	if errors.Is(err, domain.ErrPreconditionFailed) {
+		return preconditionFailed(c)
+	}
+	if err != nil {
+		return err
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
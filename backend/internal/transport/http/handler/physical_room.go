@@ -0,0 +1,215 @@
+package handler
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/chayutK/hotel-property-service/internal/service"
+	"github.com/chayutK/hotel-property-service/internal/transport/http/dto/mapperdto"
+	"github.com/chayutK/hotel-property-service/internal/transport/http/dto/physicalroomdto"
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+)
+
+type PhysicalRoomHandler struct {
+	physicalRoomService *service.PhysicalRoomService
+	validate            *validator.Validate
+}
+
+func NewPhysicalRoomHandler(physicalRoomService *service.PhysicalRoomService, validate *validator.Validate) *PhysicalRoomHandler {
+	return &PhysicalRoomHandler{
+		physicalRoomService: physicalRoomService,
+		validate:            validate,
+	}
+}
+
+func (h *PhysicalRoomHandler) RegisterRoutes(g *echo.Group) {
+	g.GET("/hotels/:hotelID/physical-rooms", h.GetPhysicalRooms)
+	g.GET("/hotels/:hotelID/physical-rooms/:physicalRoomID", h.GetPhysicalRoomByID)
+	g.POST("/hotels/:hotelID/physical-rooms", h.CreatePhysicalRoom)
+	g.PUT("/hotels/:hotelID/physical-rooms/:physicalRoomID", h.UpdatePhysicalRoom)
+	g.DELETE("/hotels/:hotelID/physical-rooms/:physicalRoomID", h.DeletePhysicalRoom)
+}
+
+// GetPhysicalRooms godoc
+// @Summary List physical rooms by hotel
+// @Description Get the physical rooms of a hotel, each with the offers sold on it
+// @Tags physical-rooms
+// @Produce json
+// @Param hotelID path string true "Hotel ID"
+// @Success 200 {object} physicalroomdto.InquiryPhysicalRoomsResponse
+// @Router /hotels/{hotelID}/physical-rooms [get]
+func (h *PhysicalRoomHandler) GetPhysicalRooms(c echo.Context) error {
+	var (
+		req  physicalroomdto.InquiryPhysicalRoomsRequest
+		resp physicalroomdto.InquiryPhysicalRoomsResponse
+	)
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), 5*time.Second)
+	defer cancel()
+
+	if err := c.Bind(&req); err != nil {
+		slog.Error("[HANDLER]", "message", "error binding request", "error", err.Error())
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Bad request"})
+	}
+
+	if err := h.validate.Struct(&req); err != nil {
+		slog.Error("[HANDLER]", "message", "error validating request", "error", err.Error())
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Bad request"})
+	}
+
+	physicalRooms, err := h.physicalRoomService.GetPhysicalRoomsByHotelID(ctx, req.HotelID)
+	if err != nil {
+		return err
+	}
+
+	resp.PhysicalRooms = mapperdto.ToPhysicalRoomsDTO(physicalRooms)
+	return c.JSON(200, &resp)
+}
+
+// GetPhysicalRoomByID godoc
+// @Summary Get physical room by id
+// @Description Get a physical room with its benefits and offers
+// @Tags physical-rooms
+// @Produce json
+// @Param hotelID path string true "Hotel ID"
+// @Param physicalRoomID path string true "Physical room ID"
+// @Success 200 {object} physicalroomdto.PhysicalRoomResponse
+// @Router /hotels/{hotelID}/physical-rooms/{physicalRoomID} [get]
+func (h *PhysicalRoomHandler) GetPhysicalRoomByID(c echo.Context) error {
+	var (
+		req  physicalroomdto.InquiryPhysicalRoomRequest
+		resp physicalroomdto.PhysicalRoomResponse
+	)
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), 5*time.Second)
+	defer cancel()
+
+	if err := c.Bind(&req); err != nil {
+		slog.Error("[HANDLER]", "message", "error binding request", "error", err.Error())
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Bad request"})
+	}
+
+	if err := h.validate.Struct(&req); err != nil {
+		slog.Error("[HANDLER]", "message", "error validating request", "error", err.Error())
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Bad request"})
+	}
+
+	physicalRoom, err := h.physicalRoomService.GetPhysicalRoomByID(ctx, req.HotelID, req.PhysicalRoomID)
+	if err != nil {
+		return err
+	}
+
+	resp.PhysicalRoom = *mapperdto.ToPhysicalRoomDTO(physicalRoom)
+	return c.JSON(200, &resp)
+}
+
+// CreatePhysicalRoom godoc
+// @Summary Create physical room
+// @Description Create a physical room type that offers can be sold on
+// @Tags physical-rooms
+// @Accept json
+// @Produce json
+// @Param hotelID path string true "Hotel ID"
+// @Param request body physicalroomdto.CreatePhysicalRoomRequest true "Physical room"
+// @Success 201 {object} physicalroomdto.PhysicalRoomResponse
+// @Router /hotels/{hotelID}/physical-rooms [post]
+func (h *PhysicalRoomHandler) CreatePhysicalRoom(c echo.Context) error {
+	var (
+		req  physicalroomdto.CreatePhysicalRoomRequest
+		resp physicalroomdto.PhysicalRoomResponse
+	)
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), 5*time.Second)
+	defer cancel()
+
+	if err := c.Bind(&req); err != nil {
+		slog.Error("[HANDLER]", "message", "error binding request", "error", err.Error())
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Bad request"})
+	}
+
+	if err := h.validate.Struct(&req); err != nil {
+		slog.Error("[HANDLER]", "message", "error validating request", "error", err.Error())
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Bad request"})
+	}
+
+	physicalRoom, err := h.physicalRoomService.CreatePhysicalRoom(ctx, mapperdto.CreatePhysicalRoomRequestToDomain(&req))
+	if err != nil {
+		return err
+	}
+
+	resp.PhysicalRoom = *mapperdto.ToPhysicalRoomDTO(physicalRoom)
+	return c.JSON(http.StatusCreated, &resp)
+}
+
+// UpdatePhysicalRoom godoc
+// @Summary Update physical room
+// @Description Replace the editable fields of a physical room
+// @Tags physical-rooms
+// @Accept json
+// @Produce json
+// @Param hotelID path string true "Hotel ID"
+// @Param physicalRoomID path string true "Physical room ID"
+// @Param request body physicalroomdto.UpdatePhysicalRoomRequest true "Physical room"
+// @Success 200 {object} physicalroomdto.PhysicalRoomResponse
+// @Router /hotels/{hotelID}/physical-rooms/{physicalRoomID} [put]
+func (h *PhysicalRoomHandler) UpdatePhysicalRoom(c echo.Context) error {
+	var (
+		req  physicalroomdto.UpdatePhysicalRoomRequest
+		resp physicalroomdto.PhysicalRoomResponse
+	)
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), 5*time.Second)
+	defer cancel()
+
+	if err := c.Bind(&req); err != nil {
+		slog.Error("[HANDLER]", "message", "error binding request", "error", err.Error())
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Bad request"})
+	}
+
+	if err := h.validate.Struct(&req); err != nil {
+		slog.Error("[HANDLER]", "message", "error validating request", "error", err.Error())
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Bad request"})
+	}
+
+	physicalRoom, err := h.physicalRoomService.UpdatePhysicalRoom(ctx, mapperdto.UpdatePhysicalRoomRequestToDomain(&req))
+	if err != nil {
+		return err
+	}
+
+	resp.PhysicalRoom = *mapperdto.ToPhysicalRoomDTO(physicalRoom)
+	return c.JSON(200, &resp)
+}
+
+// DeletePhysicalRoom godoc
+// @Summary Delete physical room
+// @Description Soft-delete a physical room together with all offers sold on it
+// @Tags physical-rooms
+// @Param hotelID path string true "Hotel ID"
+// @Param physicalRoomID path string true "Physical room ID"
+// @Success 204
+// @Router /hotels/{hotelID}/physical-rooms/{physicalRoomID} [delete]
+func (h *PhysicalRoomHandler) DeletePhysicalRoom(c echo.Context) error {
+	var req physicalroomdto.DeletePhysicalRoomRequest
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), 5*time.Second)
+	defer cancel()
+
+	if err := c.Bind(&req); err != nil {
+		slog.Error("[HANDLER]", "message", "error binding request", "error", err.Error())
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Bad request"})
+	}
+
+	if err := h.validate.Struct(&req); err != nil {
+		slog.Error("[HANDLER]", "message", "error validating request", "error", err.Error())
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Bad request"})
+	}
+
+	if err := h.physicalRoomService.DeletePhysicalRoom(ctx, req.HotelID, req.PhysicalRoomID); err != nil {
+		return err
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
@@ -0,0 +1,90 @@
+package handler
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/chayutK/hotel-property-service/internal/service"
+	"github.com/chayutK/hotel-property-service/internal/transport/http/dto/mapperdto"
+	"github.com/chayutK/hotel-property-service/internal/transport/http/dto/pricehistorydto"
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+)
+
+type PriceHistoryHandler struct {
+	priceHistoryService *service.PriceHistoryService
+	validate            *validator.Validate
+}
+
+func NewPriceHistoryHandler(priceHistoryService *service.PriceHistoryService, validate *validator.Validate) *PriceHistoryHandler {
+	return &PriceHistoryHandler{
+		priceHistoryService: priceHistoryService,
+		validate:            validate,
+	}
+}
+
+func (h *PriceHistoryHandler) RegisterRoutes(g *echo.Group) {
+	g.GET("/hotels/:hotelID/rooms/:roomID/price-history", h.GetPriceHistory)
+}
+
+// GetPriceHistory godoc
+// @Summary Get room price history
+// @Description List every change to the base price and rate calendar of a room, oldest first, with when it took effect and who made it.
+// @Description With night and at, priceAt is the price of that night at that time before pricing rules: its rate then, or else the base price.
+// @Tags room-rates
+// @Produce json
+// @Param hotelID path string true "Hotel ID"
+// @Param roomID path string true "Room ID"
+// @Param source query string false "Only base prices or rates" Enums(base_price, rate_calendar)
+// @Param night query string false "Only base prices and the rates of this night, YYYY-MM-DD"
+// @Param from query string false "Changes from this time, RFC 3339"
+// @Param to query string false "Changes before this time, RFC 3339"
+// @Param at query string false "Time to give the price of the night at, RFC 3339, requires night"
+// @Success 200 {object} pricehistorydto.PriceHistoryResponse
+// @Router /hotels/{hotelID}/rooms/{roomID}/price-history [get]
+func (h *PriceHistoryHandler) GetPriceHistory(c echo.Context) error {
+	var (
+		req  pricehistorydto.InquiryPriceHistoryRequest
+		resp pricehistorydto.PriceHistoryResponse
+	)
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), 5*time.Second)
+	defer cancel()
+
+	if err := c.Bind(&req); err != nil {
+		slog.Error("[HANDLER]", "message", "error binding request", "error", err.Error())
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Bad request"})
+	}
+
+	if err := h.validate.Struct(&req); err != nil {
+		slog.Error("[HANDLER]", "message", "error validating request", "error", err.Error())
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Bad request"})
+	}
+
+	filter, err := mapperdto.InquiryPriceHistoryRequestToDomain(&req)
+	if err != nil {
+		slog.Error("[HANDLER]", "message", "error reading timestamps", "error", err.Error())
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Bad request"})
+	}
+
+	history, err := h.priceHistoryService.GetPriceHistory(ctx, req.HotelID, filter)
+	if err != nil {
+		return err
+	}
+	resp.History = mapperdto.ToPricePointsDTO(history)
+
+	if req.At != "" {
+		at, _ := time.Parse(time.RFC3339, req.At)
+		point, err := h.priceHistoryService.GetNightPriceAt(ctx, req.HotelID, req.RoomID, req.Night, at)
+		if err != nil {
+			return err
+		}
+		if point != nil {
+			resp.PriceAt = mapperdto.ToPricePointDTO(point)
+		}
+	}
+
+	return c.JSON(200, &resp)
+}
@@ -2,11 +2,14 @@ package handler
 
 import (
 	"context"
+	"errors"
 	"log/slog"
 	"net/http"
 	"time"
 
+	"github.com/chayutK/hotel-property-service/internal/domain"
 	"github.com/chayutK/hotel-property-service/internal/service"
+	"github.com/chayutK/hotel-property-service/internal/transport/http/dto/mapperdto"
 	"github.com/chayutK/hotel-property-service/internal/transport/http/dto/pricingdto"
 	"github.com/go-playground/validator/v10"
 	"github.com/labstack/echo/v4"
@@ -26,11 +29,20 @@ func NewPricingHandler(pricingService *service.PricingService, validate *validat
 
 func (h *PricingHandler) RegisterRoutes(g *echo.Group) {
 	g.POST("/price", h.CalculateRoomPrice)
+	g.POST("/prices\\:batch", h.CalculateRoomPrices)
+	g.POST("/price/verify", h.VerifyQuote)
+	g.POST("/hotels/:hotelID/price-simulations", h.SimulatePrices)
 }
 
 // CalculateRoomPrice godoc
 // @Summary Calculate room price
-// @Description Calculate total price for requested hotel room and nights
+// @Description Calculate the itemized price of a stay: the rate of every night, the cancellation policy surcharge, discounts, taxes, fees and the total.
+// @Description Stays of more than 90 nights are refused with 400, a room not for sale is 404.
+// @Description With a currency the total is also converted at the exchange rate effective today, 422 when there is none.
+// @Description Stays breaking a minimum or maximum stay restriction are refused with 422 and the reason, length of stay discounts apply automatically.
+// @Description Eligible campaigns are discounted automatically, a promo code is applied when valid and the promotion block explains why it was not.
+// @Description With a ratePlanID the nights are priced on that rate plan and sold with its cancellation policy, a stay that cannot be booked on it is refused with 422 and the reason.
+// @Description The signedQuote block lets booking flows honour the total until it expires, see POST /price/verify.
 // @Tags pricing
 // @Accept json
 // @Produce json
@@ -56,11 +68,252 @@ func (h *PricingHandler) CalculateRoomPrice(c echo.Context) error {
 		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Bad request"})
 	}
 
-	price, err := h.pricingService.CalculateRoomPrice(ctx, req.HotelID, req.RoomID, req.Nights)
+	stay, err := mapperdto.ToStay(&req)
 	if err != nil {
+		slog.Error("[HANDLER]", "message", "error reading stay dates", "error", err.Error())
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Bad request"})
+	}
+
+	quote, err := h.pricingService.CalculateRoomPrice(ctx, quoteRequest(&req, stay))
+	var violation *domain.StayViolation
+	if errors.As(err, &violation) {
+		body := map[string]any{"message": "Unprocessable entity", "errors": []string{violation.Message}, "reason": violation.Reason}
+		if violation.RuleID != "" {
+			body["ruleID"] = violation.RuleID
+		}
+		return c.JSON(http.StatusUnprocessableEntity, body)
+	}
+	var planViolation *domain.RatePlanViolation
+	if errors.As(err, &planViolation) {
+		return c.JSON(http.StatusUnprocessableEntity, map[string]any{"message": "Unprocessable entity", "errors": []string{planViolation.Message}, "reason": planViolation.Reason, "ratePlanID": planViolation.RatePlanID})
+	}
+	if errors.Is(err, domain.ErrNoExchangeRate) || errors.Is(err, domain.ErrCurrencyMismatch) {
+		return c.JSON(http.StatusUnprocessableEntity, map[string]any{"message": "Unprocessable entity", "errors": []string{err.Error()}})
+	}
+	if errors.Is(err, domain.ErrNotForSale) {
+		return c.JSON(http.StatusNotFound, map[string]any{"message": "Not found", "errors": []string{err.Error()}})
+	}
+	if err != nil {
+		return err
+	}
+
+	resp = *mapperdto.ToCalculatePricingResponse(quote)
+	return c.JSON(200, &resp)
+}
+
+// CalculateRoomPrices godoc
+// @Summary Calculate room prices in batch
+// @Description Price up to 50 stays at once, every item is priced like POST /price.
+// @Description Each result holds the quote of its item or its error: 400 for an invalid item, 404 for a room not for sale, 422 like /price.
+// @Tags pricing
+// @Accept json
+// @Produce json
+// @Param request body pricingdto.BatchPricingRequest true "Pricing requests"
+// @Success 200 {object} pricingdto.BatchPricingResponse
+// @Router /prices:batch [post]
+func (h *PricingHandler) CalculateRoomPrices(c echo.Context) error {
+	var (
+		req  pricingdto.BatchPricingRequest
+		resp pricingdto.BatchPricingResponse
+	)
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), 10*time.Second)
+	defer cancel()
+
+	if err := c.Bind(&req); err != nil {
+		slog.Error("[HANDLER]", "message", "error binding request", "error", err.Error())
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Bad request"})
+	}
+
+	if err := h.validate.Struct(&req); err != nil {
+		slog.Error("[HANDLER]", "message", "error validating request", "error", err.Error())
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Bad request"})
+	}
+
+	var (
+		quoteReqs []service.QuoteRequest
+		indexes   []int
+	)
+	resp.Results = make([]pricingdto.BatchResultDTO, len(req.Items))
+	for i := range req.Items {
+		item := &req.Items[i]
+		resp.Results[i].Index = i
+
+		if err := h.validate.Struct(item); err != nil {
+			slog.Error("[HANDLER]", "message", "error validating request", "index", i, "error", err.Error())
+			resp.Results[i].Error = &pricingdto.BatchErrorDTO{Status: http.StatusBadRequest, Message: "Bad request", Errors: validationProblems(err)}
+			continue
+		}
+
+		stay, err := mapperdto.ToStay(item)
+		if err != nil {
+			slog.Error("[HANDLER]", "message", "error reading stay dates", "index", i, "error", err.Error())
+			resp.Results[i].Error = &pricingdto.BatchErrorDTO{Status: http.StatusBadRequest, Message: "Bad request"}
+			continue
+		}
+
+		quoteReqs = append(quoteReqs, quoteRequest(item, stay))
+		indexes = append(indexes, i)
+	}
+
+	if len(quoteReqs) > 0 {
+		results, err := h.pricingService.CalculateRoomPrices(ctx, quoteReqs)
+		if err != nil {
+			return err
+		}
+
+		for j, result := range results {
+			i := indexes[j]
+			if result.Err != nil {
+				resp.Results[i].Error = batchError(result.Err)
+				continue
+			}
+			resp.Results[i].Quote = mapperdto.ToCalculatePricingResponse(result.Quote)
+		}
+	}
+
+	return c.JSON(200, &resp)
+}
+
+// VerifyQuote godoc
+// @Summary Verify signed quote
+// @Description Confirm a signedQuote from POST /price is authentic and unexpired, so its total can be honoured without pricing the stay again.
+// @Description A quote that was altered or signed elsewhere has reason invalid_signature, an expired one expired.
+// @Tags pricing
+// @Accept json
+// @Produce json
+// @Param request body pricingdto.VerifyQuoteRequest true "Signed quote"
+// @Success 200 {object} pricingdto.VerifyQuoteResponse
+// @Router /price/verify [post]
+func (h *PricingHandler) VerifyQuote(c echo.Context) error {
+	var (
+		req  pricingdto.VerifyQuoteRequest
+		resp pricingdto.VerifyQuoteResponse
+	)
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), 5*time.Second)
+	defer cancel()
+
+	if err := c.Bind(&req); err != nil {
+		slog.Error("[HANDLER]", "message", "error binding request", "error", err.Error())
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Bad request"})
+	}
+
+	if err := h.validate.Struct(&req); err != nil {
+		slog.Error("[HANDLER]", "message", "error validating request", "error", err.Error())
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Bad request"})
+	}
+
+	signed, err := mapperdto.VerifyQuoteRequestToDomain(&req)
+	if err != nil {
+		slog.Error("[HANDLER]", "message", "error reading signed quote", "error", err.Error())
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Bad request"})
+	}
+
+	err = h.pricingService.VerifyQuote(ctx, signed)
+	switch {
+	case errors.Is(err, domain.ErrQuoteSignature):
+		resp.Reason = "invalid_signature"
+	case errors.Is(err, domain.ErrQuoteExpired):
+		resp.Reason = "expired"
+	case err != nil:
 		return err
+	default:
+		resp.Valid = true
 	}
 
-	resp.TotalPrice = price
+	resp.QuoteID = req.QuoteID
+	resp.Total = req.Total
+	resp.ExpiresAt = req.ExpiresAt
 	return c.JSON(200, &resp)
 }
+
+// SimulatePrices godoc
+// @Summary Simulate prices
+// @Description Preview draft pricing rules, base prices and rates of a hotel before publishing them, nothing is stored.
+// @Description Returns a room by night grid of the current and the simulated nightly price, from up to to with at most 366 nights.
+// @Description Prices are the rooms' own nightly prices, before rate plans, occupancy charges, discounts, taxes and fees.
+// @Description A draft pricing rooms of another hotel or in another currency than the room's, or referencing rules of another hotel, is refused with 422.
+// @Tags pricing
+// @Accept json
+// @Produce json
+// @Param hotelID path string true "Hotel ID"
+// @Param request body pricingdto.SimulatePricesRequest true "Price draft"
+// @Success 200 {object} pricingdto.SimulatePricesResponse
+// @Router /hotels/{hotelID}/price-simulations [post]
+func (h *PricingHandler) SimulatePrices(c echo.Context) error {
+	var (
+		req  pricingdto.SimulatePricesRequest
+		resp pricingdto.SimulatePricesResponse
+	)
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), 10*time.Second)
+	defer cancel()
+
+	if err := c.Bind(&req); err != nil {
+		slog.Error("[HANDLER]", "message", "error binding request", "error", err.Error())
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Bad request"})
+	}
+
+	if err := h.validate.Struct(&req); err != nil {
+		slog.Error("[HANDLER]", "message", "error validating request", "error", err.Error())
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Bad request"})
+	}
+
+	from, to, err := mapperdto.ToSimulationPeriod(&req)
+	if err != nil {
+		slog.Error("[HANDLER]", "message", "error reading simulation dates", "error", err.Error())
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Bad request"})
+	}
+
+	rooms, err := h.pricingService.SimulatePrices(ctx, service.PriceSimulation{
+		HotelID: req.HotelID,
+		RoomIDs: req.RoomIDs,
+		From:    from,
+		To:      to,
+		Draft:   mapperdto.SimulatePricesRequestToDomain(&req),
+	})
+	if errors.Is(err, domain.ErrInvalidDraft) || errors.Is(err, domain.ErrCurrencyMismatch) {
+		return c.JSON(http.StatusUnprocessableEntity, map[string]any{"message": "Unprocessable entity", "errors": []string{err.Error()}})
+	}
+	if err != nil {
+		return err
+	}
+
+	resp = *mapperdto.ToSimulatePricesResponse(&req, rooms)
+	return c.JSON(200, &resp)
+}
+
+// quoteRequest is the stay of a validated pricing request to price
+func quoteRequest(req *pricingdto.CalculatePricingRequest, stay domain.Stay) service.QuoteRequest {
+	return service.QuoteRequest{
+		HotelID:    req.HotelID,
+		RoomID:     req.RoomID,
+		RatePlanID: req.RatePlanID,
+		Guest:      domain.RatePlanGuest{Member: req.Member, AccessCode: req.AccessCode},
+		Stay:       stay,
+		Currency:   req.Currency,
+		PromoCode:  req.PromoCode,
+	}
+}
+
+// batchError is the error response of an item that could not be priced, the reason of a server
+// error is only logged
+func batchError(err error) *pricingdto.BatchErrorDTO {
+	var (
+		violation     *domain.StayViolation
+		planViolation *domain.RatePlanViolation
+	)
+	switch {
+	case errors.As(err, &violation):
+		return &pricingdto.BatchErrorDTO{Status: http.StatusUnprocessableEntity, Message: "Unprocessable entity", Errors: []string{violation.Message}, Reason: violation.Reason, RuleID: violation.RuleID}
+	case errors.As(err, &planViolation):
+		return &pricingdto.BatchErrorDTO{Status: http.StatusUnprocessableEntity, Message: "Unprocessable entity", Errors: []string{planViolation.Message}, Reason: planViolation.Reason, RatePlanID: planViolation.RatePlanID}
+	case errors.Is(err, domain.ErrNoExchangeRate), errors.Is(err, domain.ErrCurrencyMismatch):
+		return &pricingdto.BatchErrorDTO{Status: http.StatusUnprocessableEntity, Message: "Unprocessable entity", Errors: []string{err.Error()}}
+	case errors.Is(err, domain.ErrNotForSale):
+		return &pricingdto.BatchErrorDTO{Status: http.StatusNotFound, Message: "Not found", Errors: []string{err.Error()}}
+	default:
+		return &pricingdto.BatchErrorDTO{Status: http.StatusInternalServerError, Message: "Internal Server Error"}
+	}
+}
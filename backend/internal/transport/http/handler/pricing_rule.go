@@ -0,0 +1,177 @@
+package handler
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/chayutK/hotel-property-service/internal/service"
+	"github.com/chayutK/hotel-property-service/internal/transport/http/dto/mapperdto"
+	"github.com/chayutK/hotel-property-service/internal/transport/http/dto/pricingruledto"
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+)
+
+type PricingRuleHandler struct {
+	pricingRuleService *service.PricingRuleService
+	validate           *validator.Validate
+}
+
+func NewPricingRuleHandler(pricingRuleService *service.PricingRuleService, validate *validator.Validate) *PricingRuleHandler {
+	return &PricingRuleHandler{
+		pricingRuleService: pricingRuleService,
+		validate:           validate,
+	}
+}
+
+func (h *PricingRuleHandler) RegisterRoutes(g *echo.Group) {
+	g.GET("/hotels/:hotelID/pricing-rules", h.GetPricingRules)
+	g.POST("/hotels/:hotelID/pricing-rules", h.CreatePricingRule)
+	g.PUT("/hotels/:hotelID/pricing-rules/:ruleID", h.UpdatePricingRule)
+	g.DELETE("/hotels/:hotelID/pricing-rules/:ruleID", h.DeactivatePricingRule)
+}
+
+// GetPricingRules godoc
+// @Summary List pricing rules
+// @Description Get the active pricing rules of a hotel, highest priority first
+// @Tags pricing-rules
+// @Produce json
+// @Param hotelID path string true "Hotel ID"
+// @Success 200 {object} pricingruledto.InquiryPricingRulesResponse
+// @Router /hotels/{hotelID}/pricing-rules [get]
+func (h *PricingRuleHandler) GetPricingRules(c echo.Context) error {
+	var (
+		req  pricingruledto.InquiryPricingRulesRequest
+		resp pricingruledto.InquiryPricingRulesResponse
+	)
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), 5*time.Second)
+	defer cancel()
+
+	if err := c.Bind(&req); err != nil {
+		slog.Error("[HANDLER]", "message", "error binding request", "error", err.Error())
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Bad request"})
+	}
+
+	if err := h.validate.Struct(&req); err != nil {
+		slog.Error("[HANDLER]", "message", "error validating request", "error", err.Error())
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Bad request"})
+	}
+
+	rules, err := h.pricingRuleService.GetPricingRules(ctx, req.HotelID)
+	if err != nil {
+		return err
+	}
+
+	resp.Rules = mapperdto.ToPricingRulesDTO(rules)
+	return c.JSON(200, &resp)
+}
+
+// CreatePricingRule godoc
+// @Summary Create pricing rule
+// @Description Create a rule multiplying the price of the nights it matches, for the hotel, a room type or one room
+// @Tags pricing-rules
+// @Accept json
+// @Produce json
+// @Param hotelID path string true "Hotel ID"
+// @Param request body pricingruledto.CreatePricingRuleRequest true "Pricing rule"
+// @Success 201 {object} pricingruledto.PricingRuleResponse
+// @Router /hotels/{hotelID}/pricing-rules [post]
+func (h *PricingRuleHandler) CreatePricingRule(c echo.Context) error {
+	var (
+		req  pricingruledto.CreatePricingRuleRequest
+		resp pricingruledto.PricingRuleResponse
+	)
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), 5*time.Second)
+	defer cancel()
+
+	if err := c.Bind(&req); err != nil {
+		slog.Error("[HANDLER]", "message", "error binding request", "error", err.Error())
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Bad request"})
+	}
+
+	if err := h.validate.Struct(&req); err != nil {
+		slog.Error("[HANDLER]", "message", "error validating request", "error", err.Error())
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Bad request"})
+	}
+
+	rule, err := h.pricingRuleService.CreatePricingRule(ctx, mapperdto.CreatePricingRuleRequestToDomain(&req))
+	if err != nil {
+		return err
+	}
+
+	resp.Rule = *mapperdto.ToPricingRuleDTO(rule)
+	return c.JSON(http.StatusCreated, &resp)
+}
+
+// UpdatePricingRule godoc
+// @Summary Update pricing rule
+// @Description Replace every field of a pricing rule
+// @Tags pricing-rules
+// @Accept json
+// @Produce json
+// @Param hotelID path string true "Hotel ID"
+// @Param ruleID path string true "Pricing rule ID"
+// @Param request body pricingruledto.UpdatePricingRuleRequest true "Pricing rule"
+// @Success 200 {object} pricingruledto.PricingRuleResponse
+// @Router /hotels/{hotelID}/pricing-rules/{ruleID} [put]
+func (h *PricingRuleHandler) UpdatePricingRule(c echo.Context) error {
+	var (
+		req  pricingruledto.UpdatePricingRuleRequest
+		resp pricingruledto.PricingRuleResponse
+	)
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), 5*time.Second)
+	defer cancel()
+
+	if err := c.Bind(&req); err != nil {
+		slog.Error("[HANDLER]", "message", "error binding request", "error", err.Error())
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Bad request"})
+	}
+
+	if err := h.validate.Struct(&req); err != nil {
+		slog.Error("[HANDLER]", "message", "error validating request", "error", err.Error())
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Bad request"})
+	}
+
+	rule, err := h.pricingRuleService.UpdatePricingRule(ctx, mapperdto.UpdatePricingRuleRequestToDomain(&req))
+	if err != nil {
+		return err
+	}
+
+	resp.Rule = *mapperdto.ToPricingRuleDTO(rule)
+	return c.JSON(200, &resp)
+}
+
+// DeactivatePricingRule godoc
+// @Summary Deactivate pricing rule
+// @Description Soft-delete a pricing rule, prices stop using it immediately
+// @Tags pricing-rules
+// @Param hotelID path string true "Hotel ID"
+// @Param ruleID path string true "Pricing rule ID"
+// @Success 204
+// @Router /hotels/{hotelID}/pricing-rules/{ruleID} [delete]
+func (h *PricingRuleHandler) DeactivatePricingRule(c echo.Context) error {
+	var req pricingruledto.DeactivatePricingRuleRequest
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), 5*time.Second)
+	defer cancel()
+
+	if err := c.Bind(&req); err != nil {
+		slog.Error("[HANDLER]", "message", "error binding request", "error", err.Error())
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Bad request"})
+	}
+
+	if err := h.validate.Struct(&req); err != nil {
+		slog.Error("[HANDLER]", "message", "error validating request", "error", err.Error())
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Bad request"})
+	}
+
+	if err := h.pricingRuleService.DeactivatePricingRule(ctx, req.HotelID, req.RuleID); err != nil {
+		return err
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
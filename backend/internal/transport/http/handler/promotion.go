@@ -0,0 +1,246 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/chayutK/hotel-property-service/internal/domain"
+	"github.com/chayutK/hotel-property-service/internal/service"
+	"github.com/chayutK/hotel-property-service/internal/transport/http/dto/mapperdto"
+	"github.com/chayutK/hotel-property-service/internal/transport/http/dto/promotiondto"
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+)
+
+type PromotionHandler struct {
+	promotionService *service.PromotionService
+	validate         *validator.Validate
+}
+
+func NewPromotionHandler(promotionService *service.PromotionService, validate *validator.Validate) *PromotionHandler {
+	return &PromotionHandler{
+		promotionService: promotionService,
+		validate:         validate,
+	}
+}
+
+func (h *PromotionHandler) RegisterRoutes(g *echo.Group) {
+	g.GET("/promotions", h.GetPromotions)
+	g.GET("/promotions/:promotionID", h.GetPromotion)
+	g.POST("/promotions", h.CreatePromotion)
+	g.PUT("/promotions/:promotionID", h.UpdatePromotion)
+	g.DELETE("/promotions/:promotionID", h.DeactivatePromotion)
+	g.POST("/promotions/:promotionID/redeem", h.RedeemPromotion)
+}
+
+// GetPromotions godoc
+// @Summary List promotions
+// @Description Get the active promo codes and campaigns
+// @Tags promotions
+// @Produce json
+// @Success 200 {object} promotiondto.InquiryPromotionsResponse
+// @Router /promotions [get]
+func (h *PromotionHandler) GetPromotions(c echo.Context) error {
+	var resp promotiondto.InquiryPromotionsResponse
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), 5*time.Second)
+	defer cancel()
+
+	promotions, err := h.promotionService.GetPromotions(ctx)
+	if err != nil {
+		return err
+	}
+
+	resp.Promotions = mapperdto.ToPromotionsDTO(promotions)
+	return c.JSON(200, &resp)
+}
+
+// GetPromotion godoc
+// @Summary Get promotion
+// @Description Get an active promotion by ID
+// @Tags promotions
+// @Produce json
+// @Param promotionID path string true "Promotion ID"
+// @Success 200 {object} promotiondto.PromotionResponse
+// @Router /promotions/{promotionID} [get]
+func (h *PromotionHandler) GetPromotion(c echo.Context) error {
+	var (
+		req  promotiondto.InquiryPromotionRequest
+		resp promotiondto.PromotionResponse
+	)
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), 5*time.Second)
+	defer cancel()
+
+	if err := c.Bind(&req); err != nil {
+		slog.Error("[HANDLER]", "message", "error binding request", "error", err.Error())
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Bad request"})
+	}
+
+	if err := h.validate.Struct(&req); err != nil {
+		slog.Error("[HANDLER]", "message", "error validating request", "error", err.Error())
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Bad request"})
+	}
+
+	promotion, err := h.promotionService.GetPromotion(ctx, req.PromotionID)
+	if err != nil {
+		return err
+	}
+
+	resp.Promotion = *mapperdto.ToPromotionDTO(promotion)
+	return c.JSON(200, &resp)
+}
+
+// CreatePromotion godoc
+// @Summary Create promotion
+// @Description Create a percentage or fixed discount with eligibility conditions. With a code guests apply it by entering the code,
+// @Description without one it is a campaign every eligible quote gets. 409 when another active promotion has the code.
+// @Tags promotions
+// @Accept json
+// @Produce json
+// @Param request body promotiondto.CreatePromotionRequest true "Promotion"
+// @Success 201 {object} promotiondto.PromotionResponse
+// @Router /promotions [post]
+func (h *PromotionHandler) CreatePromotion(c echo.Context) error {
+	var (
+		req  promotiondto.CreatePromotionRequest
+		resp promotiondto.PromotionResponse
+	)
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), 5*time.Second)
+	defer cancel()
+
+	if err := c.Bind(&req); err != nil {
+		slog.Error("[HANDLER]", "message", "error binding request", "error", err.Error())
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Bad request"})
+	}
+
+	if err := h.validate.Struct(&req); err != nil {
+		slog.Error("[HANDLER]", "message", "error validating request", "error", err.Error())
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Bad request"})
+	}
+
+	promotion, err := h.promotionService.CreatePromotion(ctx, mapperdto.CreatePromotionRequestToDomain(&req))
+	if errors.Is(err, domain.ErrPromotionCodeTaken) {
+		return c.JSON(http.StatusConflict, map[string]any{"message": "Conflict", "errors": []string{err.Error()}})
+	}
+	if err != nil {
+		return err
+	}
+
+	resp.Promotion = *mapperdto.ToPromotionDTO(promotion)
+	return c.JSON(http.StatusCreated, &resp)
+}
+
+// UpdatePromotion godoc
+// @Summary Update promotion
+// @Description Replace every field of a promotion, the use count is kept. 409 when another active promotion has the code.
+// @Tags promotions
+// @Accept json
+// @Produce json
+// @Param promotionID path string true "Promotion ID"
+// @Param request body promotiondto.UpdatePromotionRequest true "Promotion"
+// @Success 200 {object} promotiondto.PromotionResponse
+// @Router /promotions/{promotionID} [put]
+func (h *PromotionHandler) UpdatePromotion(c echo.Context) error {
+	var (
+		req  promotiondto.UpdatePromotionRequest
+		resp promotiondto.PromotionResponse
+	)
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), 5*time.Second)
+	defer cancel()
+
+	if err := c.Bind(&req); err != nil {
+		slog.Error("[HANDLER]", "message", "error binding request", "error", err.Error())
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Bad request"})
+	}
+
+	if err := h.validate.Struct(&req); err != nil {
+		slog.Error("[HANDLER]", "message", "error validating request", "error", err.Error())
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Bad request"})
+	}
+
+	promotion, err := h.promotionService.UpdatePromotion(ctx, mapperdto.UpdatePromotionRequestToDomain(&req))
+	if errors.Is(err, domain.ErrPromotionCodeTaken) {
+		return c.JSON(http.StatusConflict, map[string]any{"message": "Conflict", "errors": []string{err.Error()}})
+	}
+	if err != nil {
+		return err
+	}
+
+	resp.Promotion = *mapperdto.ToPromotionDTO(promotion)
+	return c.JSON(200, &resp)
+}
+
+// DeactivatePromotion godoc
+// @Summary Deactivate promotion
+// @Description Soft-delete a promotion, its code is no longer accepted
+// @Tags promotions
+// @Param promotionID path string true "Promotion ID"
+// @Success 204
+// @Router /promotions/{promotionID} [delete]
+func (h *PromotionHandler) DeactivatePromotion(c echo.Context) error {
+	var req promotiondto.DeactivatePromotionRequest
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), 5*time.Second)
+	defer cancel()
+
+	if err := c.Bind(&req); err != nil {
+		slog.Error("[HANDLER]", "message", "error binding request", "error", err.Error())
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Bad request"})
+	}
+
+	if err := h.validate.Struct(&req); err != nil {
+		slog.Error("[HANDLER]", "message", "error validating request", "error", err.Error())
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Bad request"})
+	}
+
+	if err := h.promotionService.DeactivatePromotion(ctx, req.PromotionID); err != nil {
+		return err
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// RedeemPromotion godoc
+// @Summary Redeem promotion
+// @Description Count a booking made with the promotion towards its usage cap, 422 once the cap is reached
+// @Tags promotions
+// @Produce json
+// @Param promotionID path string true "Promotion ID"
+// @Success 200 {object} promotiondto.PromotionResponse
+// @Router /promotions/{promotionID}/redeem [post]
+func (h *PromotionHandler) RedeemPromotion(c echo.Context) error {
+	var (
+		req  promotiondto.RedeemPromotionRequest
+		resp promotiondto.PromotionResponse
+	)
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), 5*time.Second)
+	defer cancel()
+
+	if err := c.Bind(&req); err != nil {
+		slog.Error("[HANDLER]", "message", "error binding request", "error", err.Error())
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Bad request"})
+	}
+
+	if err := h.validate.Struct(&req); err != nil {
+		slog.Error("[HANDLER]", "message", "error validating request", "error", err.Error())
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Bad request"})
+	}
+
+	promotion, err := h.promotionService.RedeemPromotion(ctx, req.PromotionID)
+	if errors.Is(err, domain.ErrPromotionUsedUp) {
+		return c.JSON(http.StatusUnprocessableEntity, map[string]any{"message": "Unprocessable entity", "errors": []string{err.Error()}})
+	}
+	if err != nil {
+		return err
+	}
+
+	resp.Promotion = *mapperdto.ToPromotionDTO(promotion)
+	return c.JSON(200, &resp)
+}
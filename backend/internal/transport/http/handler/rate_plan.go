@@ -0,0 +1,185 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/chayutK/hotel-property-service/internal/domain"
+	"github.com/chayutK/hotel-property-service/internal/service"
+	"github.com/chayutK/hotel-property-service/internal/transport/http/dto/mapperdto"
+	"github.com/chayutK/hotel-property-service/internal/transport/http/dto/rateplandto"
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+)
+
+type RatePlanHandler struct {
+	ratePlanService *service.RatePlanService
+	validate        *validator.Validate
+}
+
+func NewRatePlanHandler(ratePlanService *service.RatePlanService, validate *validator.Validate) *RatePlanHandler {
+	return &RatePlanHandler{
+		ratePlanService: ratePlanService,
+		validate:        validate,
+	}
+}
+
+func (h *RatePlanHandler) RegisterRoutes(g *echo.Group) {
+	g.GET("/hotels/:hotelID/rate-plans", h.GetRatePlans)
+	g.POST("/hotels/:hotelID/rate-plans", h.CreateRatePlan)
+	g.PUT("/hotels/:hotelID/rate-plans/:ratePlanID", h.UpdateRatePlan)
+	g.DELETE("/hotels/:hotelID/rate-plans/:ratePlanID", h.DeactivateRatePlan)
+}
+
+// GetRatePlans godoc
+// @Summary List rate plans
+// @Description Get the active rate plans of a hotel, access codes included
+// @Tags rate-plans
+// @Produce json
+// @Param hotelID path string true "Hotel ID"
+// @Success 200 {object} rateplandto.InquiryRatePlansResponse
+// @Router /hotels/{hotelID}/rate-plans [get]
+func (h *RatePlanHandler) GetRatePlans(c echo.Context) error {
+	var (
+		req  rateplandto.InquiryRatePlansRequest
+		resp rateplandto.InquiryRatePlansResponse
+	)
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), 5*time.Second)
+	defer cancel()
+
+	if err := c.Bind(&req); err != nil {
+		slog.Error("[HANDLER]", "message", "error binding request", "error", err.Error())
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Bad request"})
+	}
+
+	if err := h.validate.Struct(&req); err != nil {
+		slog.Error("[HANDLER]", "message", "error validating request", "error", err.Error())
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Bad request"})
+	}
+
+	plans, err := h.ratePlanService.GetRatePlans(ctx, req.HotelID)
+	if err != nil {
+		return err
+	}
+
+	resp.RatePlans = mapperdto.ToRatePlansDTO(plans)
+	return c.JSON(200, &resp)
+}
+
+// CreateRatePlan godoc
+// @Summary Create rate plan
+// @Description Create a rate plan with its price multiplier, cancellation policy, inclusions and eligibility conditions. 409 when another active plan of the hotel has the code.
+// @Tags rate-plans
+// @Accept json
+// @Produce json
+// @Param hotelID path string true "Hotel ID"
+// @Param request body rateplandto.CreateRatePlanRequest true "Rate plan"
+// @Success 201 {object} rateplandto.RatePlanResponse
+// @Router /hotels/{hotelID}/rate-plans [post]
+func (h *RatePlanHandler) CreateRatePlan(c echo.Context) error {
+	var (
+		req  rateplandto.CreateRatePlanRequest
+		resp rateplandto.RatePlanResponse
+	)
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), 5*time.Second)
+	defer cancel()
+
+	if err := c.Bind(&req); err != nil {
+		slog.Error("[HANDLER]", "message", "error binding request", "error", err.Error())
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Bad request"})
+	}
+
+	if err := h.validate.Struct(&req); err != nil {
+		slog.Error("[HANDLER]", "message", "error validating request", "error", err.Error())
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Bad request"})
+	}
+
+	plan, err := h.ratePlanService.CreateRatePlan(ctx, mapperdto.CreateRatePlanRequestToDomain(&req))
+	if errors.Is(err, domain.ErrRatePlanCodeTaken) {
+		return c.JSON(http.StatusConflict, map[string]any{"message": "Conflict", "errors": []string{err.Error()}})
+	}
+	if err != nil {
+		return err
+	}
+
+	resp.RatePlan = *mapperdto.ToRatePlanDTO(plan)
+	return c.JSON(http.StatusCreated, &resp)
+}
+
+// UpdateRatePlan godoc
+// @Summary Update rate plan
+// @Description Replace every field of a rate plan. 409 when another active plan of the hotel has the code.
+// @Tags rate-plans
+// @Accept json
+// @Produce json
+// @Param hotelID path string true "Hotel ID"
+// @Param ratePlanID path string true "Rate plan ID"
+// @Param request body rateplandto.UpdateRatePlanRequest true "Rate plan"
+// @Success 200 {object} rateplandto.RatePlanResponse
+// @Router /hotels/{hotelID}/rate-plans/{ratePlanID} [put]
+func (h *RatePlanHandler) UpdateRatePlan(c echo.Context) error {
+	var (
+		req  rateplandto.UpdateRatePlanRequest
+		resp rateplandto.RatePlanResponse
+	)
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), 5*time.Second)
+	defer cancel()
+
+	if err := c.Bind(&req); err != nil {
+		slog.Error("[HANDLER]", "message", "error binding request", "error", err.Error())
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Bad request"})
+	}
+
+	if err := h.validate.Struct(&req); err != nil {
+		slog.Error("[HANDLER]", "message", "error validating request", "error", err.Error())
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Bad request"})
+	}
+
+	plan, err := h.ratePlanService.UpdateRatePlan(ctx, mapperdto.UpdateRatePlanRequestToDomain(&req))
+	if errors.Is(err, domain.ErrRatePlanCodeTaken) {
+		return c.JSON(http.StatusConflict, map[string]any{"message": "Conflict", "errors": []string{err.Error()}})
+	}
+	if err != nil {
+		return err
+	}
+
+	resp.RatePlan = *mapperdto.ToRatePlanDTO(plan)
+	return c.JSON(200, &resp)
+}
+
+// DeactivateRatePlan godoc
+// @Summary Deactivate rate plan
+// @Description Soft-delete a rate plan, rooms stop being sold on it immediately
+// @Tags rate-plans
+// @Param hotelID path string true "Hotel ID"
+// @Param ratePlanID path string true "Rate plan ID"
+// @Success 204
+// @Router /hotels/{hotelID}/rate-plans/{ratePlanID} [delete]
+func (h *RatePlanHandler) DeactivateRatePlan(c echo.Context) error {
+	var req rateplandto.DeactivateRatePlanRequest
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), 5*time.Second)
+	defer cancel()
+
+	if err := c.Bind(&req); err != nil {
+		slog.Error("[HANDLER]", "message", "error binding request", "error", err.Error())
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Bad request"})
+	}
+
+	if err := h.validate.Struct(&req); err != nil {
+		slog.Error("[HANDLER]", "message", "error validating request", "error", err.Error())
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Bad request"})
+	}
+
+	if err := h.ratePlanService.DeactivateRatePlan(ctx, req.HotelID, req.RatePlanID); err != nil {
+		return err
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
@@ -2,10 +2,12 @@ package handler
 
 import (
 	"context"
+	"errors"
 	"log/slog"
 	"net/http"
 	"time"
 
+	"github.com/chayutK/hotel-property-service/internal/domain"
 	"github.com/chayutK/hotel-property-service/internal/service"
 	"github.com/chayutK/hotel-property-service/internal/transport/http/dto/mapperdto"
 	"github.com/chayutK/hotel-property-service/internal/transport/http/dto/roomdto"
@@ -28,6 +30,10 @@ func NewRoomHandler(roomService *service.RoomService, validate *validator.Valida
 func (h *RoomHandler) RegisterRoutes(g *echo.Group) {
 	g.GET("/hotels/:hotelID/rooms", h.GetRooms)
 	g.GET("/hotels/:hotelID/rooms/:roomID", h.GetRoomByID)
+	g.POST("/hotels/:hotelID/rooms", h.CreateRoom)
+	g.PUT("/hotels/:hotelID/rooms/:roomID", h.UpdateRoom)
+	g.PATCH("/hotels/:hotelID/rooms/:roomID", h.PatchRoom)
+	g.DELETE("/hotels/:hotelID/rooms/:roomID", h.DeleteRoom)
 }
 
 // GetRooms godoc
@@ -69,6 +75,7 @@ func (h *RoomHandler) GetRooms(c echo.Context) error {
 // GetRoomByID godoc
 // @Summary Get room by id
 // @Description Get room details by hotel id and room id
+// @Description Missing, inactive and not yet or no longer live ones are 404.
 // @Tags rooms
 // @Produce json
 // @Param hotelID path string true "Hotel ID"
@@ -95,10 +102,298 @@ func (h *RoomHandler) GetRoomByID(c echo.Context) error {
 	}
 
 	room, err := h.roomService.GetRoomByRoomID(ctx, req.HotelID, req.RoomID)
+	if errors.Is(err, domain.ErrNotFound) {
+		return c.JSON(http.StatusNotFound, map[string]string{"message": "Not found"})
+	}
+	if err != nil {
+		return err
+	}
+
+	setETag(c, room.UpdatedAt)
+	resp.Room = *mapperdto.ToRoomDTO(room)
+	return c.JSON(200, &resp)
+}
+
+// CreateRoom godoc
+// @Summary Create room offer
+// @Description Create a sellable room offer for a hotel
+// @Tags rooms
+// @Accept json
+// @Produce json
+// @Param hotelID path string true "Hotel ID"
+// @Param request body roomdto.CreateRoomRequest true "Room offer"
+// @Success 201 {object} roomdto.CreateRoomResponse
+// @Router /hotels/{hotelID}/rooms [post]
+func (h *RoomHandler) CreateRoom(c echo.Context) error {
+	var (
+		req  roomdto.CreateRoomRequest
+		resp roomdto.CreateRoomResponse
+	)
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), 5*time.Second)
+	defer cancel()
+
+	if err := c.Bind(&req); err != nil {
+		slog.Error("[HANDLER]", "message", "error binding request", "error", err.Error())
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Bad request"})
+	}
+
+	if err := h.validate.Struct(&req); err != nil {
+		slog.Error("[HANDLER]", "message", "error validating request", "error", err.Error())
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Bad request"})
+	}
+
+	room, err := h.roomService.CreateRoom(ctx, mapperdto.CreateRoomRequestToDomain(&req))
+	if err != nil {
+		return err
+	}
+
+	resp.Room = *mapperdto.ToRoomDTO(room)
+	return c.JSON(http.StatusCreated, &resp)
+}
+
+// UpdateRoom godoc
+// @Summary Update room offer
+// @Description Replace the editable fields of a room offer
+// @Description A room with rate calendar prices cannot change its currency, 422 until they are deleted.
+// @Tags rooms
+// @Accept json
+// @Produce json
+// @Param hotelID path string true "Hotel ID"
+// @Param roomID path string true "Room ID"
+// @Param If-Match header string false "ETag of the version being replaced"
+// @Param request body roomdto.UpdateRoomRequest true "Room offer"
+// @Success 200 {object} roomdto.UpdateRoomResponse
+// @Failure 412 {object} map[string]string
+// @Router /hotels/{hotelID}/rooms/{roomID} [put]
+func (h *RoomHandler) UpdateRoom(c echo.Context) error {
+	var (
+		req  roomdto.UpdateRoomRequest
+		resp roomdto.UpdateRoomResponse
+	)
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), 5*time.Second)
+	defer cancel()
+
+	if err := c.Bind(&req); err != nil {
+		slog.Error("[HANDLER]", "message", "error binding request", "error", err.Error())
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Bad request"})
+	}
+
+	if err := h.validate.Struct(&req); err != nil {
+		slog.Error("[HANDLER]", "message", "error validating request", "error", err.Error())
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Bad request"})
+	}
+
+	room := mapperdto.UpdateRoomRequestToDomain(&req)
+	if hasIfMatch(c) {
+		current, err := h.roomService.GetRoomForUpdate(ctx, req.HotelID, req.RoomID)
+		if err != nil {
+			return err
+		}
+		if !ifMatch(c, current.UpdatedAt) {
+			return preconditionFailed(c)
+		}
+		room.UpdatedAt = current.UpdatedAt
+	}
+
+	room, err := h.roomService.UpdateRoom(ctx, room)
+	if errors.Is(err, domain.ErrPreconditionFailed) {
+		return preconditionFailed(c)
+	}
+	if errors.Is(err, domain.ErrCurrencyMismatch) {
+		return c.JSON(http.StatusUnprocessableEntity, map[string]any{"message": "Unprocessable entity", "errors": []string{err.Error()}})
+	}
+	if err != nil {
+		return err
+	}
+
+	setETag(c, room.UpdatedAt)
+	resp.Room = *mapperdto.ToRoomDTO(room)
+	return c.JSON(200, &resp)
+}
+
+// PatchRoom godoc
+// @Summary Partially update room offer
+// @Description Update only the provided fields of a room offer. Also accepts application/merge-patch+json,
+// @Description where null removes a field.
+// @Description A room with rate calendar prices cannot change its currency, 422 until they are deleted.
+// @Tags rooms
+// @Accept json
+// @Accept application/merge-patch+json
+// @Produce json
+// @Param hotelID path string true "Hotel ID"
+// @Param roomID path string true "Room ID"
+// @Param If-Match header string false "ETag of the version being patched"
+// @Param request body roomdto.PatchRoomRequest true "Room offer fields"
+// @Success 200 {object} roomdto.UpdateRoomResponse
+// @Failure 412 {object} map[string]string
+// @Router /hotels/{hotelID}/rooms/{roomID} [patch]
+func (h *RoomHandler) PatchRoom(c echo.Context) error {
+	var (
+		req  roomdto.PatchRoomRequest
+		resp roomdto.UpdateRoomResponse
+	)
+
+	if isMergePatch(c) {
+		return h.mergePatchRoom(c)
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), 5*time.Second)
+	defer cancel()
+
+	if err := c.Bind(&req); err != nil {
+		slog.Error("[HANDLER]", "message", "error binding request", "error", err.Error())
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Bad request"})
+	}
+
+	if err := h.validate.Struct(&req); err != nil {
+		slog.Error("[HANDLER]", "message", "error validating request", "error", err.Error())
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Bad request"})
+	}
+
+	current, err := h.roomService.GetRoomForUpdate(ctx, req.HotelID, req.RoomID)
+	if err != nil {
+		return err
+	}
+
+	if !ifMatch(c, current.UpdatedAt) {
+		return preconditionFailed(c)
+	}
+
+	update := mapperdto.ToUpdateRoomRequest(current)
+	mapperdto.ApplyRoomPatch(update, &req)
+
+	if err := h.validate.Struct(update); err != nil {
+		slog.Error("[HANDLER]", "message", "error validating request", "error", err.Error())
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Bad request"})
+	}
+
+	room := mapperdto.UpdateRoomRequestToDomain(update)
+	room.UpdatedAt = current.UpdatedAt
+
+	room, err = h.roomService.UpdateRoom(ctx, room)
+	if errors.Is(err, domain.ErrPreconditionFailed) {
+		return preconditionFailed(c)
+	}
+	if errors.Is(err, domain.ErrCurrencyMismatch) {
+		return c.JSON(http.StatusUnprocessableEntity, map[string]any{"message": "Unprocessable entity", "errors": []string{err.Error()}})
+	}
+	if err != nil {
+		return err
+	}
+
+	setETag(c, room.UpdatedAt)
+	resp.Room = *mapperdto.ToRoomDTO(room)
+	return c.JSON(200, &resp)
+}
+
+// mergePatchRoom applies a JSON Merge Patch to the full update document of the room offer,
+// the result is validated like a PUT.
+func (h *RoomHandler) mergePatchRoom(c echo.Context) error {
+	var (
+		req  roomdto.PatchRoomRequest
+		resp roomdto.UpdateRoomResponse
+	)
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), 5*time.Second)
+	defer cancel()
+
+	if err := (&echo.DefaultBinder{}).BindPathParams(c, &req); err != nil {
+		slog.Error("[HANDLER]", "message", "error binding request", "error", err.Error())
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Bad request"})
+	}
+
+	if err := h.validate.Struct(&req); err != nil {
+		slog.Error("[HANDLER]", "message", "error validating request", "error", err.Error())
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Bad request"})
+	}
+
+	current, err := h.roomService.GetRoomForUpdate(ctx, req.HotelID, req.RoomID)
 	if err != nil {
 		return err
 	}
 
+	if !ifMatch(c, current.UpdatedAt) {
+		return preconditionFailed(c)
+	}
+
+	update := mapperdto.ToUpdateRoomRequest(current)
+	if err := mergePatch(c, update); err != nil {
+		slog.Error("[HANDLER]", "message", "error applying merge patch", "error", err.Error())
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Bad request"})
+	}
+	update.HotelID, update.RoomID = current.HotelID, current.ID
+
+	if err := h.validate.Struct(update); err != nil {
+		slog.Error("[HANDLER]", "message", "error validating request", "error", err.Error())
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Bad request"})
+	}
+
+	room := mapperdto.UpdateRoomRequestToDomain(update)
+	room.UpdatedAt = current.UpdatedAt
+
+	room, err = h.roomService.UpdateRoom(ctx, room)
+	if errors.Is(err, domain.ErrPreconditionFailed) {
+		return preconditionFailed(c)
+	}
+	if errors.Is(err, domain.ErrCurrencyMismatch) {
+		return c.JSON(http.StatusUnprocessableEntity, map[string]any{"message": "Unprocessable entity", "errors": []string{err.Error()}})
+	}
+	if err != nil {
+		return err
+	}
+
+	setETag(c, room.UpdatedAt)
 	resp.Room = *mapperdto.ToRoomDTO(room)
 	return c.JSON(200, &resp)
 }
+
+// DeleteRoom godoc
+// @Summary Delete room offer
+// @Description Soft-delete a room offer by marking it inactive
+// @Tags rooms
+// @Param hotelID path string true "Hotel ID"
+// @Param roomID path string true "Room ID"
+// @Param If-Match header string false "ETag of the version being deleted"
+// @Success 204
+// @Failure 412 {object} map[string]string
+// @Router /hotels/{hotelID}/rooms/{roomID} [delete]
+func (h *RoomHandler) DeleteRoom(c echo.Context) error {
+	var req roomdto.DeleteRoomRequest
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), 5*time.Second)
+	defer cancel()
+
+	if err := c.Bind(&req); err != nil {
+		slog.Error("[HANDLER]", "message", "error binding request", "error", err.Error())
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Bad request"})
+	}
+
+	if err := h.validate.Struct(&req); err != nil {
+		slog.Error("[HANDLER]", "message", "error validating request", "error", err.Error())
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Bad request"})
+	}
+
+	var updatedAt int64
+	if hasIfMatch(c) {
+		current, err := h.roomService.GetRoomForUpdate(ctx, req.HotelID, req.RoomID)
+		if err != nil {
+			return err
+		}
+		if !ifMatch(c, current.UpdatedAt) {
+			return preconditionFailed(c)
+		}
+		updatedAt = current.UpdatedAt
+	}
+
+	err := h.roomService.DeleteRoom(ctx, req.HotelID, req.RoomID, updatedAt)
+	if errors.Is(err, domain.ErrPreconditionFailed) {
+		return preconditionFailed(c)
+	}
+	if err != nil {
+		return err
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
@@ -0,0 +1,142 @@
+package handler
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/chayutK/hotel-property-service/internal/service"
+	"github.com/chayutK/hotel-property-service/internal/transport/http/dto/mapperdto"
+	"github.com/chayutK/hotel-property-service/internal/transport/http/dto/roomratedto"
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+)
+
+type RoomRateHandler struct {
+	roomRateService *service.RoomRateService
+	validate        *validator.Validate
+}
+
+func NewRoomRateHandler(roomRateService *service.RoomRateService, validate *validator.Validate) *RoomRateHandler {
+	return &RoomRateHandler{
+		roomRateService: roomRateService,
+		validate:        validate,
+	}
+}
+
+func (h *RoomRateHandler) RegisterRoutes(g *echo.Group) {
+	g.GET("/hotels/:hotelID/rooms/:roomID/rates", h.GetRoomRates)
+	g.PUT("/hotels/:hotelID/rooms/:roomID/rates", h.SetRoomRates)
+	g.DELETE("/hotels/:hotelID/rooms/:roomID/rates/:date", h.DeleteRoomRate)
+}
+
+// GetRoomRates godoc
+// @Summary List room rates
+// @Description Get the per-night price overrides of a room, nights without one cost the base price
+// @Tags room-rates
+// @Produce json
+// @Param hotelID path string true "Hotel ID"
+// @Param roomID path string true "Room ID"
+// @Param from query string false "First night, YYYY-MM-DD"
+// @Param to query string false "Night after the last one, YYYY-MM-DD"
+// @Success 200 {object} roomratedto.RoomRatesResponse
+// @Router /hotels/{hotelID}/rooms/{roomID}/rates [get]
+func (h *RoomRateHandler) GetRoomRates(c echo.Context) error {
+	var (
+		req  roomratedto.InquiryRoomRatesRequest
+		resp roomratedto.RoomRatesResponse
+	)
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), 5*time.Second)
+	defer cancel()
+
+	if err := c.Bind(&req); err != nil {
+		slog.Error("[HANDLER]", "message", "error binding request", "error", err.Error())
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Bad request"})
+	}
+
+	if err := h.validate.Struct(&req); err != nil {
+		slog.Error("[HANDLER]", "message", "error validating request", "error", err.Error())
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Bad request"})
+	}
+
+	rates, err := h.roomRateService.GetRoomRates(ctx, req.HotelID, req.RoomID, req.From, req.To)
+	if err != nil {
+		return err
+	}
+
+	resp.Rates = mapperdto.ToRoomRatesDTO(rates)
+	return c.JSON(200, &resp)
+}
+
+// SetRoomRates godoc
+// @Summary Set room rates
+// @Description Create or replace the price of the listed nights, other nights are left unchanged
+// @Tags room-rates
+// @Accept json
+// @Produce json
+// @Param hotelID path string true "Hotel ID"
+// @Param roomID path string true "Room ID"
+// @Param request body roomratedto.SetRoomRatesRequest true "Nightly rates"
+// @Success 200 {object} roomratedto.RoomRatesResponse
+// @Router /hotels/{hotelID}/rooms/{roomID}/rates [put]
+func (h *RoomRateHandler) SetRoomRates(c echo.Context) error {
+	var (
+		req  roomratedto.SetRoomRatesRequest
+		resp roomratedto.RoomRatesResponse
+	)
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), 5*time.Second)
+	defer cancel()
+
+	if err := c.Bind(&req); err != nil {
+		slog.Error("[HANDLER]", "message", "error binding request", "error", err.Error())
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Bad request"})
+	}
+
+	if err := h.validate.Struct(&req); err != nil {
+		slog.Error("[HANDLER]", "message", "error validating request", "error", err.Error())
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Bad request"})
+	}
+
+	rates, err := h.roomRateService.SetRoomRates(ctx, req.HotelID, req.RoomID, mapperdto.SetRoomRatesRequestToDomain(&req))
+	if err != nil {
+		return err
+	}
+
+	resp.Rates = mapperdto.ToRoomRatesDTO(rates)
+	return c.JSON(200, &resp)
+}
+
+// DeleteRoomRate godoc
+// @Summary Delete room rate
+// @Description Remove the price override of one night, the night goes back to the base price
+// @Tags room-rates
+// @Param hotelID path string true "Hotel ID"
+// @Param roomID path string true "Room ID"
+// @Param date path string true "Night, YYYY-MM-DD"
+// @Success 204
+// @Router /hotels/{hotelID}/rooms/{roomID}/rates/{date} [delete]
+func (h *RoomRateHandler) DeleteRoomRate(c echo.Context) error {
+	var req roomratedto.DeleteRoomRateRequest
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), 5*time.Second)
+	defer cancel()
+
+	if err := c.Bind(&req); err != nil {
+		slog.Error("[HANDLER]", "message", "error binding request", "error", err.Error())
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Bad request"})
+	}
+
+	if err := h.validate.Struct(&req); err != nil {
+		slog.Error("[HANDLER]", "message", "error validating request", "error", err.Error())
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Bad request"})
+	}
+
+	if err := h.roomRateService.DeleteRoomRate(ctx, req.HotelID, req.RoomID, req.Date); err != nil {
+		return err
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
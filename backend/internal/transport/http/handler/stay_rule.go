@@ -0,0 +1,177 @@
+package handler
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/chayutK/hotel-property-service/internal/service"
+	"github.com/chayutK/hotel-property-service/internal/transport/http/dto/mapperdto"
+	"github.com/chayutK/hotel-property-service/internal/transport/http/dto/stayruledto"
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+)
+
+type StayRuleHandler struct {
+	stayRuleService *service.StayRuleService
+	validate        *validator.Validate
+}
+
+func NewStayRuleHandler(stayRuleService *service.StayRuleService, validate *validator.Validate) *StayRuleHandler {
+	return &StayRuleHandler{
+		stayRuleService: stayRuleService,
+		validate:        validate,
+	}
+}
+
+func (h *StayRuleHandler) RegisterRoutes(g *echo.Group) {
+	g.GET("/hotels/:hotelID/stay-rules", h.GetStayRules)
+	g.POST("/hotels/:hotelID/stay-rules", h.CreateStayRule)
+	g.PUT("/hotels/:hotelID/stay-rules/:ruleID", h.UpdateStayRule)
+	g.DELETE("/hotels/:hotelID/stay-rules/:ruleID", h.DeactivateStayRule)
+}
+
+// GetStayRules godoc
+// @Summary List stay rules
+// @Description Get the active length of stay restrictions and discounts of a hotel
+// @Tags stay-rules
+// @Produce json
+// @Param hotelID path string true "Hotel ID"
+// @Success 200 {object} stayruledto.InquiryStayRulesResponse
+// @Router /hotels/{hotelID}/stay-rules [get]
+func (h *StayRuleHandler) GetStayRules(c echo.Context) error {
+	var (
+		req  stayruledto.InquiryStayRulesRequest
+		resp stayruledto.InquiryStayRulesResponse
+	)
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), 5*time.Second)
+	defer cancel()
+
+	if err := c.Bind(&req); err != nil {
+		slog.Error("[HANDLER]", "message", "error binding request", "error", err.Error())
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Bad request"})
+	}
+
+	if err := h.validate.Struct(&req); err != nil {
+		slog.Error("[HANDLER]", "message", "error validating request", "error", err.Error())
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Bad request"})
+	}
+
+	rules, err := h.stayRuleService.GetStayRules(ctx, req.HotelID)
+	if err != nil {
+		return err
+	}
+
+	resp.Rules = mapperdto.ToStayRulesDTO(rules)
+	return c.JSON(200, &resp)
+}
+
+// CreateStayRule godoc
+// @Summary Create stay rule
+// @Description Create a minimum and maximum stay restriction or a length of stay discount tier, for the hotel or one room
+// @Tags stay-rules
+// @Accept json
+// @Produce json
+// @Param hotelID path string true "Hotel ID"
+// @Param request body stayruledto.CreateStayRuleRequest true "Stay rule"
+// @Success 201 {object} stayruledto.StayRuleResponse
+// @Router /hotels/{hotelID}/stay-rules [post]
+func (h *StayRuleHandler) CreateStayRule(c echo.Context) error {
+	var (
+		req  stayruledto.CreateStayRuleRequest
+		resp stayruledto.StayRuleResponse
+	)
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), 5*time.Second)
+	defer cancel()
+
+	if err := c.Bind(&req); err != nil {
+		slog.Error("[HANDLER]", "message", "error binding request", "error", err.Error())
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Bad request"})
+	}
+
+	if err := h.validate.Struct(&req); err != nil {
+		slog.Error("[HANDLER]", "message", "error validating request", "error", err.Error())
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Bad request"})
+	}
+
+	rule, err := h.stayRuleService.CreateStayRule(ctx, mapperdto.CreateStayRuleRequestToDomain(&req))
+	if err != nil {
+		return err
+	}
+
+	resp.Rule = *mapperdto.ToStayRuleDTO(rule)
+	return c.JSON(http.StatusCreated, &resp)
+}
+
+// UpdateStayRule godoc
+// @Summary Update stay rule
+// @Description Replace every field of a stay rule
+// @Tags stay-rules
+// @Accept json
+// @Produce json
+// @Param hotelID path string true "Hotel ID"
+// @Param ruleID path string true "Stay rule ID"
+// @Param request body stayruledto.UpdateStayRuleRequest true "Stay rule"
+// @Success 200 {object} stayruledto.StayRuleResponse
+// @Router /hotels/{hotelID}/stay-rules/{ruleID} [put]
+func (h *StayRuleHandler) UpdateStayRule(c echo.Context) error {
+	var (
+		req  stayruledto.UpdateStayRuleRequest
+		resp stayruledto.StayRuleResponse
+	)
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), 5*time.Second)
+	defer cancel()
+
+	if err := c.Bind(&req); err != nil {
+		slog.Error("[HANDLER]", "message", "error binding request", "error", err.Error())
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Bad request"})
+	}
+
+	if err := h.validate.Struct(&req); err != nil {
+		slog.Error("[HANDLER]", "message", "error validating request", "error", err.Error())
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Bad request"})
+	}
+
+	rule, err := h.stayRuleService.UpdateStayRule(ctx, mapperdto.UpdateStayRuleRequestToDomain(&req))
+	if err != nil {
+		return err
+	}
+
+	resp.Rule = *mapperdto.ToStayRuleDTO(rule)
+	return c.JSON(200, &resp)
+}
+
+// DeactivateStayRule godoc
+// @Summary Deactivate stay rule
+// @Description Soft-delete a stay rule, prices stop using it immediately
+// @Tags stay-rules
+// @Param hotelID path string true "Hotel ID"
+// @Param ruleID path string true "Stay rule ID"
+// @Success 204
+// @Router /hotels/{hotelID}/stay-rules/{ruleID} [delete]
+func (h *StayRuleHandler) DeactivateStayRule(c echo.Context) error {
+	var req stayruledto.DeactivateStayRuleRequest
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), 5*time.Second)
+	defer cancel()
+
+	if err := c.Bind(&req); err != nil {
+		slog.Error("[HANDLER]", "message", "error binding request", "error", err.Error())
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Bad request"})
+	}
+
+	if err := h.validate.Struct(&req); err != nil {
+		slog.Error("[HANDLER]", "message", "error validating request", "error", err.Error())
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Bad request"})
+	}
+
+	if err := h.stayRuleService.DeactivateStayRule(ctx, req.HotelID, req.RuleID); err != nil {
+		return err
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
@@ -0,0 +1,187 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/chayutK/hotel-property-service/internal/domain"
+	"github.com/chayutK/hotel-property-service/internal/service"
+	"github.com/chayutK/hotel-property-service/internal/transport/http/dto/mapperdto"
+	"github.com/chayutK/hotel-property-service/internal/transport/http/dto/taxfeedto"
+	"github.com/go-playground/validator/v10"
+	"github.com/labstack/echo/v4"
+)
+
+type TaxFeeHandler struct {
+	taxFeeService *service.TaxFeeService
+	validate      *validator.Validate
+}
+
+func NewTaxFeeHandler(taxFeeService *service.TaxFeeService, validate *validator.Validate) *TaxFeeHandler {
+	return &TaxFeeHandler{
+		taxFeeService: taxFeeService,
+		validate:      validate,
+	}
+}
+
+func (h *TaxFeeHandler) RegisterRoutes(g *echo.Group) {
+	g.GET("/hotels/:hotelID/taxes-fees", h.GetTaxesAndFees)
+	g.POST("/hotels/:hotelID/taxes-fees", h.CreateTaxFee)
+	g.PUT("/hotels/:hotelID/taxes-fees/:taxFeeID", h.UpdateTaxFee)
+	g.DELETE("/hotels/:hotelID/taxes-fees/:taxFeeID", h.DeactivateTaxFee)
+}
+
+// GetTaxesAndFees godoc
+// @Summary List taxes and fees
+// @Description Get the active taxes and fees of a hotel in the order they are applied
+// @Tags taxes-fees
+// @Produce json
+// @Param hotelID path string true "Hotel ID"
+// @Success 200 {object} taxfeedto.InquiryTaxesAndFeesResponse
+// @Router /hotels/{hotelID}/taxes-fees [get]
+func (h *TaxFeeHandler) GetTaxesAndFees(c echo.Context) error {
+	var (
+		req  taxfeedto.InquiryTaxesAndFeesRequest
+		resp taxfeedto.InquiryTaxesAndFeesResponse
+	)
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), 5*time.Second)
+	defer cancel()
+
+	if err := c.Bind(&req); err != nil {
+		slog.Error("[HANDLER]", "message", "error binding request", "error", err.Error())
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Bad request"})
+	}
+
+	if err := h.validate.Struct(&req); err != nil {
+		slog.Error("[HANDLER]", "message", "error validating request", "error", err.Error())
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Bad request"})
+	}
+
+	taxFees, err := h.taxFeeService.GetTaxesAndFees(ctx, req.HotelID)
+	if err != nil {
+		return err
+	}
+
+	resp.TaxesAndFees = mapperdto.ToTaxFeesDTO(taxFees)
+	return c.JSON(200, &resp)
+}
+
+// CreateTaxFee godoc
+// @Summary Create tax or fee
+// @Description Create a percentage or fixed tax or fee the hotel charges on top of its rooms
+// @Description A fixed amount has to be in a currency a room of the hotel is sold in, 422 otherwise.
+// @Tags taxes-fees
+// @Accept json
+// @Produce json
+// @Param hotelID path string true "Hotel ID"
+// @Param request body taxfeedto.CreateTaxFeeRequest true "Tax or fee"
+// @Success 201 {object} taxfeedto.TaxFeeResponse
+// @Router /hotels/{hotelID}/taxes-fees [post]
+func (h *TaxFeeHandler) CreateTaxFee(c echo.Context) error {
+	var (
+		req  taxfeedto.CreateTaxFeeRequest
+		resp taxfeedto.TaxFeeResponse
+	)
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), 5*time.Second)
+	defer cancel()
+
+	if err := c.Bind(&req); err != nil {
+		slog.Error("[HANDLER]", "message", "error binding request", "error", err.Error())
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Bad request"})
+	}
+
+	if err := h.validate.Struct(&req); err != nil {
+		slog.Error("[HANDLER]", "message", "error validating request", "error", err.Error())
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Bad request"})
+	}
+
+	taxFee, err := h.taxFeeService.CreateTaxFee(ctx, mapperdto.CreateTaxFeeRequestToDomain(&req))
+	if errors.Is(err, domain.ErrCurrencyMismatch) {
+		return c.JSON(http.StatusUnprocessableEntity, map[string]any{"message": "Unprocessable entity", "errors": []string{err.Error()}})
+	}
+	if err != nil {
+		return err
+	}
+
+	resp.TaxFee = *mapperdto.ToTaxFeeDTO(taxFee)
+	return c.JSON(http.StatusCreated, &resp)
+}
+
+// UpdateTaxFee godoc
+// @Summary Update tax or fee
+// @Description Replace every field of a tax or fee
+// @Description A fixed amount has to be in a currency a room of the hotel is sold in, 422 otherwise.
+// @Tags taxes-fees
+// @Accept json
+// @Produce json
+// @Param hotelID path string true "Hotel ID"
+// @Param taxFeeID path string true "Tax or fee ID"
+// @Param request body taxfeedto.UpdateTaxFeeRequest true "Tax or fee"
+// @Success 200 {object} taxfeedto.TaxFeeResponse
+// @Router /hotels/{hotelID}/taxes-fees/{taxFeeID} [put]
+func (h *TaxFeeHandler) UpdateTaxFee(c echo.Context) error {
+	var (
+		req  taxfeedto.UpdateTaxFeeRequest
+		resp taxfeedto.TaxFeeResponse
+	)
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), 5*time.Second)
+	defer cancel()
+
+	if err := c.Bind(&req); err != nil {
+		slog.Error("[HANDLER]", "message", "error binding request", "error", err.Error())
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Bad request"})
+	}
+
+	if err := h.validate.Struct(&req); err != nil {
+		slog.Error("[HANDLER]", "message", "error validating request", "error", err.Error())
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Bad request"})
+	}
+
+	taxFee, err := h.taxFeeService.UpdateTaxFee(ctx, mapperdto.UpdateTaxFeeRequestToDomain(&req))
+	if errors.Is(err, domain.ErrCurrencyMismatch) {
+		return c.JSON(http.StatusUnprocessableEntity, map[string]any{"message": "Unprocessable entity", "errors": []string{err.Error()}})
+	}
+	if err != nil {
+		return err
+	}
+
+	resp.TaxFee = *mapperdto.ToTaxFeeDTO(taxFee)
+	return c.JSON(200, &resp)
+}
+
+// DeactivateTaxFee godoc
+// @Summary Deactivate tax or fee
+// @Description Soft-delete a tax or fee, prices stop including it immediately
+// @Tags taxes-fees
+// @Param hotelID path string true "Hotel ID"
+// @Param taxFeeID path string true "Tax or fee ID"
+// @Success 204
+// @Router /hotels/{hotelID}/taxes-fees/{taxFeeID} [delete]
+func (h *TaxFeeHandler) DeactivateTaxFee(c echo.Context) error {
+	var req taxfeedto.DeactivateTaxFeeRequest
+
+	ctx, cancel := context.WithTimeout(c.Request().Context(), 5*time.Second)
+	defer cancel()
+
+	if err := c.Bind(&req); err != nil {
+		slog.Error("[HANDLER]", "message", "error binding request", "error", err.Error())
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Bad request"})
+	}
+
+	if err := h.validate.Struct(&req); err != nil {
+		slog.Error("[HANDLER]", "message", "error validating request", "error", err.Error())
+		return c.JSON(http.StatusBadRequest, map[string]string{"message": "Bad request"})
+	}
+
+	if err := h.taxFeeService.DeactivateTaxFee(ctx, req.HotelID, req.TaxFeeID); err != nil {
+		return err
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
@@ -0,0 +1,23 @@
+package http
+
+import (
+	"strings"
+
+	"github.com/chayutK/hotel-property-service/internal/domain"
+	"github.com/labstack/echo/v4"
+)
+
+// HeaderActor names the person or system making a request, it is recorded in the audit log
+const HeaderActor = "X-Actor"
+
+// ActorMiddleware puts the actor of the request on its context for the services to record
+func ActorMiddleware() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if actor := strings.TrimSpace(c.Request().Header.Get(HeaderActor)); actor != "" {
+				c.SetRequest(c.Request().WithContext(domain.WithActor(c.Request().Context(), actor)))
+			}
+			return next(c)
+		}
+	}
+}
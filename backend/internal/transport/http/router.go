@@ -10,10 +10,38 @@ func RegisterRoutes(
 	hotelHandler *handler.HotelHandler,
 	roomHandler *handler.RoomHandler,
 	pricingHandler *handler.PricingHandler,
+	facilityHandler *handler.FacilityHandler,
+	benefitHandler *handler.BenefitHandler,
+	physicalRoomHandler *handler.PhysicalRoomHandler,
+	catalogHandler *handler.CatalogHandler,
+	auditHandler *handler.AuditHandler,
+	roomRateHandler *handler.RoomRateHandler,
+	pricingRuleHandler *handler.PricingRuleHandler,
+	cancellationPolicyHandler *handler.CancellationPolicyHandler,
+	taxFeeHandler *handler.TaxFeeHandler,
+	exchangeRateHandler *handler.ExchangeRateHandler,
+	promotionHandler *handler.PromotionHandler,
+	stayRuleHandler *handler.StayRuleHandler,
+	priceHistoryHandler *handler.PriceHistoryHandler,
+	ratePlanHandler *handler.RatePlanHandler,
 ) {
 	apiGroup := e.Group("/api/v1")
 
 	hotelHandler.RegisterRoutes(apiGroup)
 	roomHandler.RegisterRoutes(apiGroup)
 	pricingHandler.RegisterRoutes(apiGroup)
+	facilityHandler.RegisterRoutes(apiGroup)
+	benefitHandler.RegisterRoutes(apiGroup)
+	physicalRoomHandler.RegisterRoutes(apiGroup)
+	catalogHandler.RegisterRoutes(apiGroup)
+	auditHandler.RegisterRoutes(apiGroup)
+	roomRateHandler.RegisterRoutes(apiGroup)
+	pricingRuleHandler.RegisterRoutes(apiGroup)
+	cancellationPolicyHandler.RegisterRoutes(apiGroup)
+	taxFeeHandler.RegisterRoutes(apiGroup)
+	exchangeRateHandler.RegisterRoutes(apiGroup)
+	promotionHandler.RegisterRoutes(apiGroup)
+	stayRuleHandler.RegisterRoutes(apiGroup)
+	priceHistoryHandler.RegisterRoutes(apiGroup)
+	ratePlanHandler.RegisterRoutes(apiGroup)
 }
@@ -0,0 +1,78 @@
+package http
+
+import (
+	"reflect"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/chayutK/hotel-property-service/internal/constants/currency"
+	"github.com/chayutK/hotel-property-service/internal/constants/roomtype"
+	"github.com/chayutK/hotel-property-service/internal/domain"
+	"github.com/go-playground/validator/v10"
+)
+
+var (
+	facilityCodePattern = regexp.MustCompile(`^[A-Z][A-Z0-9_]{0,63}$`)
+	// promo codes are matched regardless of case, they are stored upper case
+	promoCodePattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9_-]{2,31}$`)
+)
+
+// NewValidator returns a validator with the custom tags used by the request DTOs
+// registered, so DTOs can be checked against the known constants.
+func NewValidator() *validator.Validate {
+	validate := validator.New()
+
+	validate.RegisterValidation("room_type", func(fl validator.FieldLevel) bool {
+		return roomtype.IsValid(fl.Field().String())
+	})
+	validate.RegisterValidation("currency", func(fl validator.FieldLevel) bool {
+		return currency.IsValid(fl.Field().String())
+	})
+	validate.RegisterValidation("facility_code", func(fl validator.FieldLevel) bool {
+		return facilityCodePattern.MatchString(fl.Field().String())
+	})
+	validate.RegisterValidation("promo_code", func(fl validator.FieldLevel) bool {
+		return promoCodePattern.MatchString(fl.Field().String())
+	})
+	validate.RegisterValidation("money", func(fl validator.FieldLevel) bool {
+		// an amount is exact in the currency of the field named by the first word of the param,
+		// e.g. money=Currency; on a list the second word names the amount of every item, e.g.
+		// money=Currency Price
+		params := strings.Fields(fl.Param())
+		code := reflect.Indirect(fl.Parent().FieldByName(params[0]))
+		if code.Kind() != reflect.String {
+			return false
+		}
+		if fl.Field().Kind() != reflect.Slice {
+			return domain.IsExactAmount(fl.Field().Float(), code.String())
+		}
+		if len(params) < 2 {
+			return false
+		}
+		for i := range fl.Field().Len() {
+			amount := reflect.Indirect(fl.Field().Index(i)).FieldByName(params[1])
+			if !domain.IsExactAmount(amount.Float(), code.String()) {
+				return false
+			}
+		}
+		return true
+	})
+	validate.RegisterValidation("active_until", func(fl validator.FieldLevel) bool {
+		// an activation window ends after it starts, an open start is always before
+		until, ok := fl.Field().Interface().(time.Time)
+		if !ok {
+			return false
+		}
+		from, ok := fl.Parent().FieldByName("ActiveFrom").Interface().(*time.Time)
+		return !ok || from == nil || until.After(*from)
+	})
+	validate.RegisterValidation("date_after", func(fl validator.FieldLevel) bool {
+		// dates are written as 2006-01-02, so a later date also sorts after; an empty other
+		// date leaves nothing to compare with
+		other := fl.Parent().FieldByName(fl.Param())
+		return !other.IsValid() || other.String() == "" || fl.Field().String() > other.String()
+	})
+
+	return validate
+}